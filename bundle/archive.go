@@ -0,0 +1,123 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Archives are gzip compressed JSON documents. Encrypted archives start with
+// encryptedHeader, followed by the scrypt salt, the AES-GCM nonce and the
+// encrypted gzip stream.
+const (
+	encryptedHeader = "hydra-bundle-encrypted-v1\n"
+	saltLength      = 16
+)
+
+var (
+	ErrPassphraseRequired = errors.New("the bundle is encrypted, please provide the passphrase")
+	ErrInvalidPassphrase  = errors.New("unable to decrypt the bundle, the passphrase is wrong or the bundle is corrupted")
+)
+
+// Encode writes the bundle as an archive to w. If the passphrase is not
+// empty, the archive is encrypted with AES-256-GCM using a key derived from
+// the passphrase.
+func Encode(w io.Writer, b *Bundle, passphrase []byte) error {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(gz).Encode(b); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := gz.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if len(passphrase) == 0 {
+		_, err := buf.WriteTo(w)
+		return errors.WithStack(err)
+	}
+
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return errors.WithStack(err)
+	}
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return errors.WithStack(err)
+	}
+
+	out := append([]byte(encryptedHeader), salt...)
+	out = append(out, nonce...)
+	out = aead.Seal(out, nonce, buf.Bytes(), []byte(encryptedHeader))
+	_, err = w.Write(out)
+	return errors.WithStack(err)
+}
+
+// Decode reads an archive written by Encode. The passphrase is only used if
+// the archive is encrypted.
+func Decode(r io.Reader, passphrase []byte) (*Bundle, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if rest, ok := bytes.CutPrefix(content, []byte(encryptedHeader)); ok {
+		if len(passphrase) == 0 {
+			return nil, errors.WithStack(ErrPassphraseRequired)
+		}
+		if len(rest) < saltLength {
+			return nil, errors.WithStack(ErrInvalidPassphrase)
+		}
+		aead, err := newAEAD(passphrase, rest[:saltLength])
+		if err != nil {
+			return nil, err
+		}
+		rest = rest[saltLength:]
+		if len(rest) < aead.NonceSize() {
+			return nil, errors.WithStack(ErrInvalidPassphrase)
+		}
+		content, err = aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], []byte(encryptedHeader))
+		if err != nil {
+			return nil, errors.WithStack(ErrInvalidPassphrase)
+		}
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the bundle")
+	}
+	defer gz.Close() //nolint:errcheck
+
+	var b Bundle
+	if err := json.NewDecoder(gz).Decode(&b); err != nil {
+		return nil, errors.Wrap(err, "unable to decode the bundle")
+	}
+	return &b, nil
+}
+
+func newAEAD(passphrase, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	return aead, errors.WithStack(err)
+}
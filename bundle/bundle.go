@@ -0,0 +1,217 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/hydra/v2/oauth2/trust"
+	"github.com/ory/hydra/v2/x"
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+	"github.com/ory/x/sqlcon"
+)
+
+// Version is the version of the bundle format. Bundles with a different
+// version can not be imported.
+const Version = 1
+
+type (
+	// Bundle contains the configuration state of a network: its clients, JSON
+	// Web Key Sets, trusted JWT-bearer grant issuers and subject obfuscation
+	// mappings. It does not contain the network ID, so it can be imported into
+	// any network.
+	Bundle struct {
+		Version            int                 `json:"version"`
+		CreatedAt          time.Time           `json:"created_at"`
+		Clients            []Client            `json:"clients"`
+		JSONWebKeySets     []JSONWebKeySet     `json:"json_web_key_sets"`
+		TrustedIssuers     []trust.Grant       `json:"trusted_jwt_grant_issuers"`
+		ObfuscatedSubjects []ObfuscatedSubject `json:"obfuscated_subjects"`
+	}
+
+	// Client is a client including the fields which are not part of its JSON
+	// representation. The secret and rotated secrets are hashed.
+	Client struct {
+		*client.Client
+		RotatedSecrets                    []string       `json:"rotated_secrets,omitempty"`
+		RegistrationAccessTokenSignature  string         `json:"registration_access_token_signature,omitempty"`
+		PasswordGrantAccessTokenLifespan  x.NullDuration `json:"password_grant_access_token_lifespan"`
+		PasswordGrantRefreshTokenLifespan x.NullDuration `json:"password_grant_refresh_token_lifespan"`
+	}
+
+	// JSONWebKeySet contains the keys of a set in plain text. They are
+	// encrypted with the system secret of the instance importing the bundle.
+	JSONWebKeySet struct {
+		Set  string            `json:"set"`
+		Keys []jose.JSONWebKey `json:"keys"`
+	}
+
+	ObfuscatedSubject struct {
+		ClientID          string `json:"client_id"`
+		Subject           string `json:"subject"`
+		SubjectObfuscated string `json:"subject_obfuscated"`
+	}
+
+	// Result counts the imported records.
+	Result struct {
+		Clients            int `json:"clients"`
+		JSONWebKeys        int `json:"json_web_keys"`
+		TrustedIssuers     int `json:"trusted_jwt_grant_issuers"`
+		ObfuscatedSubjects int `json:"obfuscated_subjects"`
+	}
+)
+
+// Export returns the bundle of the context's network.
+func Export(ctx context.Context, r InternalRegistry) (*Bundle, error) {
+	b := &Bundle{Version: Version, CreatedAt: time.Now().UTC().Round(time.Second)}
+
+	opts := []keysetpagination.Option{keysetpagination.WithSize(keysetpagination.DefaultMaxSize)}
+	for {
+		clients, next, err := r.ClientManager().GetClients(ctx, client.Filter{PageOpts: opts})
+		if err != nil {
+			return nil, err
+		}
+		for i := range clients {
+			c := &clients[i]
+			b.Clients = append(b.Clients, Client{
+				Client:                            c,
+				RotatedSecrets:                    c.RotatedSecrets,
+				RegistrationAccessTokenSignature:  c.RegistrationAccessTokenSignature,
+				PasswordGrantAccessTokenLifespan:  c.PasswordGrantAccessTokenLifespan,
+				PasswordGrantRefreshTokenLifespan: c.PasswordGrantRefreshTokenLifespan,
+			})
+		}
+		if next.IsLast() {
+			break
+		}
+		opts = next.ToOptions()
+	}
+
+	sets, err := r.BundleManager().ListJsonWebKeySets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, set := range sets {
+		keys, err := r.KeyManager().GetKeySet(ctx, set)
+		if err != nil {
+			return nil, err
+		}
+		s := JSONWebKeySet{Set: set}
+		for _, k := range keys.Keys {
+			// Keys stored in a hardware security module can not be exported.
+			if _, opaque := k.Key.(jose.OpaqueSigner); !opaque {
+				s.Keys = append(s.Keys, k)
+			}
+		}
+		b.JSONWebKeySets = append(b.JSONWebKeySets, s)
+	}
+
+	opts = []keysetpagination.Option{keysetpagination.WithSize(keysetpagination.DefaultMaxSize)}
+	for {
+		grants, next, err := r.GrantManager().GetGrants(ctx, "", opts...)
+		if err != nil {
+			return nil, err
+		}
+		b.TrustedIssuers = append(b.TrustedIssuers, grants...)
+		if next.IsLast() {
+			break
+		}
+		opts = next.ToOptions()
+	}
+
+	subjects, err := r.BundleManager().ListForcedObfuscatedLoginSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range subjects {
+		b.ObfuscatedSubjects = append(b.ObfuscatedSubjects, ObfuscatedSubject{
+			ClientID:          s.ClientID,
+			Subject:           s.Subject,
+			SubjectObfuscated: s.SubjectObfuscated,
+		})
+	}
+
+	return b, nil
+}
+
+// Import imports the bundle into the context's network in a single
+// transaction. Existing clients, keys and subject obfuscation mappings are
+// replaced, and existing trust relationships are kept, so importing a bundle
+// again does not change anything.
+func Import(ctx context.Context, r InternalRegistry, b *Bundle) (*Result, error) {
+	if b.Version != Version {
+		return nil, errors.Errorf("unsupported bundle version %d, expected version %d", b.Version, Version)
+	}
+
+	var result Result
+	if err := r.Transaction(ctx, func(ctx context.Context) error {
+		result = Result{}
+
+		for _, c := range b.Clients {
+			if c.Client == nil {
+				continue
+			}
+			cl := *c.Client
+			cl.NID = uuid.Nil
+			cl.RotatedSecrets = c.RotatedSecrets
+			cl.RegistrationAccessTokenSignature = c.RegistrationAccessTokenSignature
+			cl.PasswordGrantAccessTokenLifespan = c.PasswordGrantAccessTokenLifespan
+			cl.PasswordGrantRefreshTokenLifespan = c.PasswordGrantRefreshTokenLifespan
+			if err := r.BundleManager().ImportClient(ctx, &cl); err != nil {
+				return errors.WithMessagef(err, "unable to import client %s", cl.ID)
+			}
+			result.Clients++
+		}
+
+		keys := make(map[string]jose.JSONWebKey)
+		for _, s := range b.JSONWebKeySets {
+			for i := range s.Keys {
+				if err := r.KeyManager().UpdateKey(ctx, s.Set, &s.Keys[i]); err != nil {
+					return errors.WithMessagef(err, "unable to import key %s of set %s", s.Keys[i].KeyID, s.Set)
+				}
+				keys[s.Set+"/"+s.Keys[i].KeyID] = s.Keys[i]
+				result.JSONWebKeys++
+			}
+		}
+
+		for _, g := range b.TrustedIssuers {
+			if _, err := r.GrantManager().GetConcreteGrant(ctx, g.ID); err == nil {
+				continue
+			} else if !errors.Is(err, sqlcon.ErrNoRows()) {
+				return err
+			}
+			key, ok := keys[g.PublicKey.Set+"/"+g.PublicKey.KeyID]
+			if !ok && g.JWKSURI == "" {
+				return errors.Errorf("the bundle does not contain the key %s of set %s used by the trust relationship %s", g.PublicKey.KeyID, g.PublicKey.Set, g.ID)
+			}
+			if err := r.GrantManager().CreateGrant(ctx, g, key); err != nil {
+				return errors.WithMessagef(err, "unable to import trust relationship %s", g.ID)
+			}
+			result.TrustedIssuers++
+		}
+
+		for _, s := range b.ObfuscatedSubjects {
+			if err := r.ObfuscatedSubjectManager().CreateForcedObfuscatedLoginSession(ctx, &consent.ForcedObfuscatedLoginSession{
+				ClientID:          s.ClientID,
+				Subject:           s.Subject,
+				SubjectObfuscated: s.SubjectObfuscated,
+			}); err != nil {
+				return errors.WithMessagef(err, "unable to import the obfuscated subject of client %s", s.ClientID)
+			}
+			result.ObfuscatedSubjects++
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,151 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/bundle"
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/oauth2/trust"
+	"github.com/ory/x/configx"
+)
+
+func TestArchive(t *testing.T) {
+	t.Parallel()
+
+	b := &bundle.Bundle{
+		Version:            bundle.Version,
+		ObfuscatedSubjects: []bundle.ObfuscatedSubject{{ClientID: "client", Subject: "alice", SubjectObfuscated: "obfuscated"}},
+	}
+
+	t.Run("case=plain", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, bundle.Encode(&buf, b, nil))
+
+		actual, err := bundle.Decode(&buf, []byte("ignored"))
+		require.NoError(t, err)
+		assert.Equal(t, b, actual)
+	})
+
+	t.Run("case=encrypted", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, bundle.Encode(&buf, b, []byte("passphrase")))
+		assert.NotContains(t, buf.String(), "obfuscated")
+
+		_, err := bundle.Decode(bytes.NewReader(buf.Bytes()), nil)
+		assert.ErrorIs(t, err, bundle.ErrPassphraseRequired)
+
+		_, err = bundle.Decode(bytes.NewReader(buf.Bytes()), []byte("wrong"))
+		assert.ErrorIs(t, err, bundle.ErrInvalidPassphrase)
+
+		actual, err := bundle.Decode(bytes.NewReader(buf.Bytes()), []byte("passphrase"))
+		require.NoError(t, err)
+		assert.Equal(t, b, actual)
+	})
+}
+
+func TestExportImport(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	newRegistry := func(secret string) *driver.RegistrySQL {
+		return testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValue(config.KeyGetSystemSecret, []string{secret})))
+	}
+	source := newRegistry("a-source-system-secret-of-32-chars")
+	target := newRegistry("a-target-system-secret-of-32-chars")
+
+	c := &client.Client{ID: "bundle-client", Secret: "some-secret", Scope: "openid", GrantTypes: []string{"client_credentials"}}
+	require.NoError(t, source.ClientManager().CreateClient(ctx, c))
+
+	_, err := source.KeyManager().GenerateAndPersistKeySet(ctx, "bundle-set", "bundle-key", "ES256", "sig")
+	require.NoError(t, err)
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	grant := trust.Grant{
+		ID:        uuid.Must(uuid.NewV4()),
+		Issuer:    "https://bundle-idp.example.com",
+		Subject:   "alice",
+		Scope:     []string{"read"},
+		PublicKey: trust.PublicKey{Set: "https://bundle-idp.example.com", KeyID: "trust-key"},
+		CreatedAt: time.Now().UTC().Round(time.Second),
+		ExpiresAt: time.Now().Add(time.Hour).UTC().Round(time.Second),
+	}
+	require.NoError(t, source.GrantManager().CreateGrant(ctx, grant, jose.JSONWebKey{Key: &priv.PublicKey, KeyID: "trust-key", Algorithm: "ES256", Use: "sig"}))
+
+	require.NoError(t, source.ObfuscatedSubjectManager().CreateForcedObfuscatedLoginSession(ctx, &consent.ForcedObfuscatedLoginSession{
+		ClientID: c.ID, Subject: "alice", SubjectObfuscated: "obfuscated-alice",
+	}))
+
+	b, err := bundle.Export(ctx, source)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, bundle.Encode(&buf, b, []byte("passphrase")))
+	b, err = bundle.Decode(&buf, []byte("passphrase"))
+	require.NoError(t, err)
+
+	result, err := bundle.Import(ctx, target, b)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Clients)
+	assert.Equal(t, 1, result.TrustedIssuers)
+	assert.Equal(t, 1, result.ObfuscatedSubjects)
+
+	t.Run("case=client secret is kept", func(t *testing.T) {
+		_, err := target.ClientManager().AuthenticateClient(ctx, c.ID, []byte("some-secret"))
+		require.NoError(t, err)
+	})
+
+	t.Run("case=keys are encrypted with the target secret", func(t *testing.T) {
+		expected, err := source.KeyManager().GetKey(ctx, "bundle-set", "bundle-key")
+		require.NoError(t, err)
+		actual, err := target.KeyManager().GetKey(ctx, "bundle-set", "bundle-key")
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("case=trust relationships and obfuscated subjects are imported", func(t *testing.T) {
+		g, err := target.GrantManager().GetConcreteGrant(ctx, grant.ID)
+		require.NoError(t, err)
+		assert.Equal(t, grant.Scope, g.Scope)
+
+		s, err := target.ObfuscatedSubjectManager().GetForcedObfuscatedLoginSession(ctx, c.ID, "obfuscated-alice")
+		require.NoError(t, err)
+		assert.Equal(t, "alice", s.Subject)
+	})
+
+	t.Run("case=importing again is idempotent", func(t *testing.T) {
+		result, err := bundle.Import(ctx, target, b)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Clients)
+		assert.Zero(t, result.TrustedIssuers)
+
+		again, err := bundle.Export(ctx, target)
+		require.NoError(t, err)
+		assert.Len(t, again.Clients, len(b.Clients))
+		assert.Len(t, again.JSONWebKeySets, len(b.JSONWebKeySets))
+		assert.Len(t, again.TrustedIssuers, 1)
+		assert.Len(t, again.ObfuscatedSubjects, 1)
+	})
+
+	t.Run("case=rejects other versions", func(t *testing.T) {
+		_, err := bundle.Import(ctx, target, &bundle.Bundle{Version: bundle.Version + 1})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,22 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"context"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/consent"
+)
+
+type Manager interface {
+	// ListJsonWebKeySets returns the IDs of all JSON Web Key Sets stored in
+	// the database.
+	ListJsonWebKeySets(ctx context.Context) ([]string, error)
+	ListForcedObfuscatedLoginSessions(ctx context.Context) ([]consent.ForcedObfuscatedLoginSession, error)
+	// ImportClient creates the client, or replaces the client with the same
+	// ID. Unlike CreateClient and UpdateClient, it stores the secret and the
+	// rotated secrets as they are, because they are hashed already.
+	ImportClient(ctx context.Context, c *client.Client) error
+}
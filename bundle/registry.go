@@ -0,0 +1,27 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/hydra/v2/oauth2/trust"
+	"github.com/ory/x/logrusx"
+)
+
+type InternalRegistry interface {
+	logrusx.Provider
+	client.ManagerProvider
+	consent.ObfuscatedSubjectManagerProvider
+	jwk.ManagerProvider
+	trust.Registry
+	fosite.Transactional
+	Registry
+}
+
+type Registry interface {
+	BundleManager() Manager
+}
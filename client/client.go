@@ -232,6 +232,24 @@ type Client struct {
 	// as a UTF-8 encoded JSON object using the application/json content-type.
 	UserinfoSignedResponseAlg string `json:"userinfo_signed_response_alg,omitempty" db:"userinfo_signed_response_alg" faker:"len=10"`
 
+	// OpenID Connect Default ACR Values
+	//
+	// Default requested Authentication Context Class Reference values, in order of preference. They are used
+	// when the authorization request does not contain the acr_values parameter.
+	DefaultACRValues sqlxx.StringSliceJSONFormat `json:"default_acr_values,omitempty" db:"default_acr_values" faker:"-"`
+
+	// OpenID Connect Require Auth Time
+	//
+	// Boolean value specifying whether the auth_time Claim is REQUIRED. If true, the login request must be
+	// accepted with a known authentication time for the login and consent challenges to be redeemed.
+	RequireAuthTime bool `json:"require_auth_time,omitempty" db:"require_auth_time" faker:"-"`
+
+	// OpenID Connect Minimum ACR
+	//
+	// The weakest Authentication Context Class Reference which is accepted for this client. The `acr` set when
+	// accepting the login request must be equal to it or ranked higher in `oidc.authentication_context.acr_values_supported`.
+	MinimumACR string `json:"minimum_acr,omitempty" db:"minimum_acr" faker:"-"`
+
 	// OAuth 2.0 Client Creation Date
 	//
 	// CreatedAt returns the timestamp of the client's creation.
@@ -303,6 +321,22 @@ type Client struct {
 	// Setting the strategy here overrides the global setting in `strategies.access_token`.
 	AccessTokenStrategy string `json:"access_token_strategy,omitempty" db:"access_token_strategy" faker:"-"`
 
+	// OAuth 2.0 Refresh Token Strategy
+	//
+	// RefreshTokenStrategy is the strategy used to generate refresh tokens.
+	// Valid options are `opaque` and `jwe`. `jwe` refresh tokens are self-contained and encrypted,
+	// and only their revocation is stored in the database.
+	// Setting the strategy here overrides the global setting in `strategies.refresh_token`.
+	RefreshTokenStrategy string `json:"refresh_token_strategy,omitempty" db:"refresh_token_strategy" faker:"-"`
+
+	// OAuth 2.0 Token Claims Mapper URL
+	//
+	// TokenClaimsMapperURL points to a Jsonnet mapper which is evaluated for every token request
+	// of this client to compute the access token `ext` claims and the ID token claims.
+	// Only `base64://`, `http://`, and `https://` URLs are allowed.
+	// Setting the mapper here overrides the global setting in `oauth2.token_claims_mapper.url`.
+	TokenClaimsMapperURL sqlxx.NullString `json:"token_claims_mapper_url,omitempty" db:"token_claims_mapper_url" faker:"-"`
+
 	// SkipConsent skips the consent screen for this client. This field can only
 	// be set from the admin API.
 	SkipConsent bool `json:"skip_consent" db:"skip_consent" faker:"-"`
@@ -601,6 +635,20 @@ func AccessTokenStrategySource(client fosite.Client) config.AccessTokenStrategyS
 	return nil
 }
 
+func (c *Client) GetRefreshTokenStrategy() config.RefreshTokenStrategyType {
+	// We ignore the error here, because the empty string will default to
+	// the global refresh token strategy.
+	s, _ := config.ToRefreshTokenStrategyType(c.RefreshTokenStrategy)
+	return s
+}
+
+func RefreshTokenStrategySource(client fosite.Client) config.RefreshTokenStrategySource {
+	if source, ok := client.(config.RefreshTokenStrategySource); ok {
+		return source
+	}
+	return nil
+}
+
 func (c *Client) CookieSuffix() string {
 	return CookieSuffix(c)
 }
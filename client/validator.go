@@ -19,6 +19,8 @@ import (
 
 	"github.com/ory/herodot"
 	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/oauth2/scope"
 	"github.com/ory/x/ipx"
 )
 
@@ -41,6 +43,7 @@ func isSupportedAuthTokenSigningAlg(alg string) bool {
 type validatorRegistry interface {
 	httpx.ClientProvider
 	config.Provider
+	scope.Registry
 }
 
 type Validator struct {
@@ -86,6 +89,9 @@ func (v *Validator) Validate(ctx context.Context, c *Client) error {
 		if c.SectorIdentifierURI != "" {
 			values["sector_identifier_uri"] = c.SectorIdentifierURI
 		}
+		if mapper := string(c.TokenClaimsMapperURL); strings.HasPrefix(mapper, "http://") || strings.HasPrefix(mapper, "https://") {
+			values["token_claims_mapper_url"] = mapper
+		}
 
 		for k, v := range c.RequestURIs {
 			if v != "" {
@@ -132,6 +138,19 @@ func (v *Validator) Validate(ctx context.Context, c *Client) error {
 		c.Scope = strings.Join(v.r.Config().DefaultClientScope(ctx), " ")
 	}
 
+	for _, s := range strings.Fields(c.Scope) {
+		if !fosite.IsScopeTemplate(s) {
+			continue
+		}
+		if _, err := fosite.ParseScopeTemplate(s); err != nil {
+			return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Scope '%s' is not a valid scope template.", s).WithDebug(err.Error()))
+		}
+	}
+
+	if err := v.validateRegisteredScopes(ctx, c); err != nil {
+		return err
+	}
+
 	for k, origin := range c.AllowedCORSOrigins {
 		u, err := url.Parse(origin)
 		if err != nil {
@@ -221,6 +240,62 @@ func (v *Validator) Validate(ctx context.Context, c *Client) error {
 		c.AccessTokenStrategy = string(s)
 	}
 
+	if c.RefreshTokenStrategy != "" {
+		s, err := config.ToRefreshTokenStrategyType(c.RefreshTokenStrategy)
+		if err != nil {
+			return errors.WithStack(ErrInvalidClientMetadata.
+				WithHintf("invalid refresh token strategy: %v", err))
+		}
+		// Canonicalize, just in case.
+		c.RefreshTokenStrategy = string(s)
+	}
+
+	if mapper := string(c.TokenClaimsMapperURL); mapper != "" {
+		if !slices.ContainsFunc([]string{"base64://", "https://", "http://"}, func(scheme string) bool {
+			return strings.HasPrefix(mapper, scheme)
+		}) {
+			return errors.WithStack(ErrInvalidClientMetadata.WithHint("Field token_claims_mapper_url must use base64://, https:// or http:// as scheme."))
+		}
+	}
+
+	if supported := v.r.Config().ACRValuesSupported(ctx); len(supported) > 0 {
+		for _, acr := range append(slices.Clone(c.DefaultACRValues), c.MinimumACR) {
+			if acr != "" && !slices.Contains(supported, acr) {
+				return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Authentication Context Class Reference '%s' is not supported by server, only %v are allowed.", acr, supported))
+			}
+		}
+	}
+
+	return nil
+}
+
+// builtinScopes are implemented by Hydra itself, so they can be granted
+// without being registered in the scope registry.
+var builtinScopes = []string{"openid", "offline", "offline_access"}
+
+// validateRegisteredScopes makes sure that the client is only granted
+// built-in scopes, registered scopes or instances of registered scope
+// templates. Any scope can be granted while no scope is registered.
+func (v *Validator) validateRegisteredScopes(ctx context.Context, c *Client) error {
+	registered, err := v.r.ScopeManager().ListScopes(ctx)
+	if err != nil {
+		return err
+	}
+	if len(registered) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(registered))
+	for k, r := range registered {
+		names[k] = r.Name
+	}
+	instanceOf := fosite.NewParameterizedScopeStrategy()
+
+	for _, s := range strings.Fields(c.Scope) {
+		if !slices.Contains(builtinScopes, s) && !slices.Contains(names, s) && !instanceOf(names, s) {
+			return errors.WithStack(ErrInvalidClientMetadata.WithHintf("Scope '%s' is not registered in the scope registry.", s))
+		}
+	}
 	return nil
 }
 
@@ -231,9 +306,15 @@ func (v *Validator) ValidateDynamicRegistration(ctx context.Context, c *Client)
 	if c.AccessTokenStrategy != "" {
 		return errors.WithStack(herodot.ErrBadRequest().WithReasonf("It is not allowed to choose your own access token strategy."))
 	}
+	if c.RefreshTokenStrategy != "" {
+		return errors.WithStack(herodot.ErrBadRequest().WithReasonf("It is not allowed to choose your own refresh token strategy."))
+	}
 	if c.SkipConsent {
 		return errors.WithStack(ErrInvalidRequest.WithDescription(`"skip_consent" cannot be set for dynamic client registration`))
 	}
+	if c.TokenClaimsMapperURL != "" {
+		return errors.WithStack(ErrInvalidRequest.WithDescription(`"token_claims_mapper_url" cannot be set for dynamic client registration`))
+	}
 	if c.SkipLogoutConsent.Bool {
 		return errors.WithStack(ErrInvalidRequest.WithDescription(`"skip_logout_consent" cannot be set for dynamic client registration`))
 	}
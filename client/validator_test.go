@@ -22,6 +22,7 @@ import (
 	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/oauth2/scope"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/configx"
 	"github.com/ory/x/httpx"
@@ -209,6 +210,50 @@ func TestValidate(t *testing.T) {
 			in:        &Client{ID: "foo", SubjectType: "foo"},
 			assertErr: assert.Error,
 		},
+		{
+			in: &Client{ID: "foo", TokenClaimsMapperURL: "base64://e30="},
+			check: func(t *testing.T, c *Client) {
+				assert.EqualValues(t, "base64://e30=", c.TokenClaimsMapperURL)
+			},
+		},
+		{
+			in:        &Client{ID: "foo", TokenClaimsMapperURL: "file:///etc/passwd"},
+			assertErr: assert.Error,
+		},
+		{
+			in: &Client{ID: "foo", RefreshTokenStrategy: "JWE"},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, "jwe", c.RefreshTokenStrategy)
+			},
+		},
+		{
+			in:        &Client{ID: "foo", RefreshTokenStrategy: "jwt"},
+			assertErr: assert.Error,
+		},
+		{
+			in: &Client{ID: "foo", DefaultACRValues: []string{"urn:acr:unknown"}, MinimumACR: "urn:acr:unknown"},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, "urn:acr:unknown", c.MinimumACR)
+			},
+		},
+		{
+			v: func(t *testing.T) *Validator {
+				reg.Config().MustSet(ctx, config.KeyACRValuesSupported, []string{"urn:acr:pwd", "urn:acr:mfa"})
+				return NewValidator(reg)
+			},
+			in: &Client{ID: "foo", DefaultACRValues: []string{"urn:acr:mfa", "urn:acr:pwd"}, MinimumACR: "urn:acr:pwd"},
+			check: func(t *testing.T, c *Client) {
+				assert.Equal(t, "urn:acr:pwd", c.MinimumACR)
+			},
+		},
+		{
+			in:        &Client{ID: "foo", MinimumACR: "urn:acr:unknown"},
+			assertErr: assert.Error,
+		},
+		{
+			in:        &Client{ID: "foo", DefaultACRValues: []string{"urn:acr:unknown"}},
+			assertErr: assert.Error,
+		},
 	} {
 		t.Run(fmt.Sprintf("case=%d", k), func(t *testing.T) {
 			if tc.v == nil {
@@ -337,6 +382,25 @@ func TestValidateIPRanges(t *testing.T) {
 	require.NoError(t, v.ValidateDynamicRegistration(ctx, &Client{RequestURIs: []string{"https://google", "https://localhost:1234"}}))
 }
 
+func TestValidateRegisteredScopes(t *testing.T) {
+	ctx := t.Context()
+	reg := testhelpers.NewRegistryMemory(t)
+	v := NewValidator(reg)
+
+	require.NoError(t, v.Validate(ctx, &Client{Scope: "openid photos.read"}), "any scope is allowed while no scope is registered")
+
+	for _, name := range []string{"photos.read", "transfer:max:{amount:max}"} {
+		require.NoError(t, reg.ScopeManager().CreateScope(ctx, &scope.Scope{Name: name}))
+	}
+	require.NoError(t, v.Validate(ctx, &Client{Scope: "openid photos.read"}))
+	require.NoError(t, v.Validate(ctx, &Client{Scope: "openid offline offline_access"}), "built-in scopes need not be registered")
+	require.ErrorContains(t, v.Validate(ctx, &Client{Scope: "openid photos.write"}), "invalid_client_metadata")
+
+	require.NoError(t, v.Validate(ctx, &Client{Scope: "transfer:max:{amount:max} transfer:max:500"}), "instances of registered templates are allowed")
+	require.ErrorContains(t, v.Validate(ctx, &Client{Scope: "transfer:max:all"}), "invalid_client_metadata")
+	require.ErrorContains(t, v.Validate(ctx, &Client{Scope: "transfer:max:{amount:float}"}), "invalid_client_metadata")
+}
+
 func TestValidateDynamicRegistration(t *testing.T) {
 	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
 		config.KeySubjectTypesSupported: []string{"pairwise", "public"},
@@ -377,6 +441,24 @@ func TestValidateDynamicRegistration(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			in: &Client{
+				ID:                     "foo",
+				PostLogoutRedirectURIs: []string{"https://foo/"},
+				RedirectURIs:           []string{"https://foo/"},
+				TokenClaimsMapperURL:   "base64://e30=",
+			},
+			expectErr: true,
+		},
+		{
+			in: &Client{
+				ID:                     "foo",
+				PostLogoutRedirectURIs: []string{"https://foo/"},
+				RedirectURIs:           []string{"https://foo/"},
+				RefreshTokenStrategy:   "jwe",
+			},
+			expectErr: true,
+		},
 		{
 			in: &Client{
 				ID:                     "foo",
@@ -10,11 +10,13 @@ import (
 type Handler struct {
 	Migration *MigrateHandler
 	Janitor   *JanitorHandler
+	Bundle    *BundleHandler
 }
 
 func NewHandler(dOpts []driver.OptionsModifier) *Handler {
 	return &Handler{
 		Migration: newMigrateHandler(dOpts),
 		Janitor:   newJanitorHandler(dOpts),
+		Bundle:    newBundleHandler(dOpts),
 	}
 }
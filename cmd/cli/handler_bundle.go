@@ -0,0 +1,184 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gofrs/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/bundle"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/tenant"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/contextx"
+	"github.com/ory/x/flagx"
+	"github.com/ory/x/popx"
+)
+
+const (
+	BundleOutput        = "output"
+	BundleTenant        = "tenant"
+	BundlePassphraseEnv = "passphrase-env"
+)
+
+type BundleHandler struct {
+	dOpts []driver.OptionsModifier
+}
+
+func newBundleHandler(dOpts []driver.OptionsModifier) *BundleHandler {
+	return &BundleHandler{
+		dOpts: dOpts,
+	}
+}
+
+// makeDriver returns the driver and a context served by the network selected
+// with the --tenant flag.
+func (h *BundleHandler) makeDriver(cmd *cobra.Command, dsn string) (context.Context, *driver.RegistrySQL, error) {
+	ctx := cmd.Context()
+
+	opts := append([]driver.OptionsModifier{
+		driver.WithConfigOptions(
+			configx.SkipValidation(),
+			configx.WithFlags(cmd.Flags())),
+		driver.DisableValidation(),
+		driver.DisablePreloading(),
+	}, h.dOpts...)
+	if dsn != "" {
+		opts = append(opts, driver.WithConfigOptions(
+			configx.WithValue(config.KeyDSN, dsn),
+		))
+	}
+
+	d, err := driver.New(ctx, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(d.Config().DSN()) == 0 {
+		_, _ = fmt.Fprintln(cmd.ErrOrStderr(), "No DSN provided. Please provide a DSN as the first argument or set the DSN environment variable.")
+		return nil, nil, cmdx.FailSilently(cmd)
+	}
+	if err := popx.VerifyDialect(ctx, d.Persister().Connection(ctx)); err != nil {
+		return nil, nil, err
+	}
+
+	id := flagx.MustGetString(cmd, BundleTenant)
+	if id == "" {
+		return ctx, d, nil
+	}
+
+	t, err := d.TenantManager().GetTenant(ctx, uuid.FromStringOrNil(id))
+	if err != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Unable to find tenant %s: %s\n", id, err)
+		return nil, nil, cmdx.FailSilently(cmd)
+	}
+	c, err := t.NewConfig(ctx, d.Config().Source(contextx.RootContext))
+	if err != nil {
+		return nil, nil, err
+	}
+	return tenant.NewContext(ctx, t, c), d, nil
+}
+
+func passphrase(cmd *cobra.Command) ([]byte, error) {
+	name := flagx.MustGetString(cmd, BundlePassphraseEnv)
+	if name == "" {
+		return nil, nil
+	}
+	v, ok := os.LookupEnv(name)
+	if !ok || v == "" {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "The environment variable %s containing the passphrase is not set.\n", name)
+		return nil, cmdx.FailSilently(cmd)
+	}
+	return []byte(v), nil
+}
+
+func (h *BundleHandler) Export(cmd *cobra.Command, args []string) error {
+	var dsn string
+	if len(args) > 0 {
+		dsn = args[0]
+	}
+
+	pass, err := passphrase(cmd)
+	if err != nil {
+		return err
+	}
+
+	ctx, d, err := h.makeDriver(cmd, dsn)
+	if err != nil {
+		return err
+	}
+
+	b, err := bundle.Export(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = cmd.OutOrStdout()
+	if out := flagx.MustGetString(cmd, BundleOutput); out != "" && out != "-" {
+		f, err := os.OpenFile(out, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600) // #nosec G304
+		if err != nil {
+			return err
+		}
+		defer f.Close() //nolint:errcheck
+		w = f
+	}
+
+	if err := bundle.Encode(w, b, pass); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Exported %d clients, %d JSON Web Key Sets, %d trust relationships and %d obfuscated subjects.\n",
+		len(b.Clients), len(b.JSONWebKeySets), len(b.TrustedIssuers), len(b.ObfuscatedSubjects))
+	return nil
+}
+
+func (h *BundleHandler) Import(cmd *cobra.Command, args []string) error {
+	var dsn string
+	file := args[0]
+	if len(args) > 1 {
+		dsn, file = args[0], args[1]
+	}
+
+	pass, err := passphrase(cmd)
+	if err != nil {
+		return err
+	}
+
+	var r io.Reader = cmd.InOrStdin()
+	if file != "-" {
+		f, err := os.Open(file) // #nosec G304
+		if err != nil {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not open file %s: %s\n", file, err)
+			return cmdx.FailSilently(cmd)
+		}
+		defer f.Close() //nolint:errcheck
+		r = f
+	}
+
+	b, err := bundle.Decode(r, pass)
+	if err != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not read bundle %s: %s\n", file, err)
+		return cmdx.FailSilently(cmd)
+	}
+
+	ctx, d, err := h.makeDriver(cmd, dsn)
+	if err != nil {
+		return err
+	}
+
+	result, err := bundle.Import(ctx, d, b)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Imported %d clients, %d JSON Web Keys, %d trust relationships and %d obfuscated subjects.\n",
+		result.Clients, result.JSONWebKeys, result.TrustedIssuers, result.ObfuscatedSubjects)
+	return nil
+}
@@ -157,9 +157,15 @@ func addRoutine(out io.Writer, p persistence.Persister, names ...string) []clean
 	for _, n := range names {
 		switch n {
 		case OnlyTokens:
+			routines = append(routines, cleanup(out, func(ctx context.Context, notAfter time.Time, _ int, _ int) error {
+				return p.FlushExpiredTokenPartitions(ctx, notAfter)
+			}, "token partitions"))
 			routines = append(routines, cleanup(out, p.FlushInactiveAccessTokens, "access tokens"))
 			routines = append(routines, cleanup(out, p.FlushInactiveRefreshTokens, "refresh tokens"))
 		case OnlyRequests:
+			routines = append(routines, cleanup(out, func(ctx context.Context, notAfter time.Time, _ int, _ int) error {
+				return p.FlushExpiredFlowPartitions(ctx, notAfter)
+			}, "flow partitions"))
 			routines = append(routines, cleanup(out, p.FlushInactiveLoginConsentRequests, "login-consent requests"))
 		case OnlyGrants:
 			routines = append(routines, cleanup(out, p.FlushInactiveGrants, "grants"))
@@ -13,6 +13,7 @@ import (
 	"github.com/ory/hydra/v2/persistence/sql"
 	"github.com/ory/x/cmdx"
 	"github.com/ory/x/configx"
+	"github.com/ory/x/flagx"
 	"github.com/ory/x/popx"
 )
 
@@ -26,7 +27,7 @@ func newMigrateHandler(dOpts []driver.OptionsModifier) *MigrateHandler {
 	}
 }
 
-func (h *MigrateHandler) makeMigrationManager(cmd *cobra.Command, args []string) (*sql.MigrationManager, error) {
+func (h *MigrateHandler) makeDriver(cmd *cobra.Command, args []string) (*driver.RegistrySQL, error) {
 	opts := append([]driver.OptionsModifier{
 		driver.WithConfigOptions(
 			configx.SkipValidation(),
@@ -56,6 +57,14 @@ func (h *MigrateHandler) makeMigrationManager(cmd *cobra.Command, args []string)
 		return nil, err
 	}
 
+	return d, nil
+}
+
+func (h *MigrateHandler) makeMigrationManager(cmd *cobra.Command, args []string) (*sql.MigrationManager, error) {
+	d, err := h.makeDriver(cmd, args)
+	if err != nil {
+		return nil, err
+	}
 	return d.Migrator(), nil
 }
 
@@ -82,3 +91,24 @@ func (h *MigrateHandler) MigrateStatus(cmd *cobra.Command, args []string) error
 	}
 	return popx.MigrateStatus(cmd, p)
 }
+
+func (h *MigrateHandler) MigratePartitions(cmd *cobra.Command, args []string) error {
+	d, err := h.makeDriver(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	if !flagx.MustGetBool(cmd, "yes") && !cmdx.AskForConfirmation(
+		"This converts the token and flow tables to the partitioned storage layout. Make sure that you have a backup of the database. Do you want to continue?",
+		cmd.InOrStdin(), cmd.OutOrStdout()) {
+		_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Aborting.")
+		return nil
+	}
+
+	if err := d.Persister().PartitionTables(cmd.Context(), flagx.MustGetDuration(cmd, "interval")); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Successfully partitioned the token and flow tables.")
+	return nil
+}
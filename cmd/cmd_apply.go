@@ -0,0 +1,153 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+)
+
+const (
+	flagApplyFile          = "file"
+	flagApplyDryRun        = "dry-run"
+	flagApplyPrune         = "prune"
+	flagApplySelector      = "selector"
+	flagApplyUpdateSecrets = "update-secrets"
+)
+
+func NewApplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply -f <file-or-directory> [-f ...]",
+		Short: "Declaratively manage OAuth 2.0 Clients, JSON Web Key Sets and trusted JWT-bearer grant issuers",
+		Args:  cobra.NoArgs,
+		Long: `This command reads YAML or JSON manifests and makes the Ory Hydra Admin API match them. It compares each
+manifest with the current state, prints the resulting plan, and then creates, updates or deletes resources as
+needed. Use --dry-run to only print the plan.
+
+Directories are read non-recursively; all files ending in .yaml, .yml or .json are loaded. Use "-" to read from
+STDIN. A file may contain several YAML documents separated by "---". Each document has the format:
+
+  kind: OAuth2Client
+  # Optional. Reads the client secret from an environment variable or a file.
+  secret_from:
+    env: MY_APP_CLIENT_SECRET
+  spec:
+    client_id: my-app         # required
+    owner: platform
+    metadata:
+      labels:
+        team: identity
+    # ... all other fields of the OAuth 2.0 Client model are allowed here
+  ---
+  kind: JsonWebKeySet
+  # Optional. Matched by --selector when pruning.
+  labels:
+    team: identity
+  # Optional. Reads additional keys from a JSON Web Key Set file.
+  secret_from:
+    file: ./keys/my-set.json
+  spec:
+    set: my-set
+    keys: []
+  ---
+  kind: TrustedJwtGrantIssuer
+  # Optional. Matched by --selector when pruning.
+  labels:
+    team: identity
+  spec:
+    issuer: https://jwt-idp.example.com
+    subject: alice@example.com
+    scope: [read]
+    expires_at: "2030-01-01T00:00:00Z"
+    jwk: { ... }
+
+Files given in secret_from are resolved relative to the manifest. Client secrets are only sent when a client is
+created, unless --update-secrets is set, because the Admin API does not return them and they can not be compared.
+
+Clients are updated in place, which keeps fields that are not part of the manifest. JSON Web Keys are created or
+replaced one by one. Trust relationships are replaced in a single transaction when they change.
+Instead of "jwk", a trust relationship may define "jwks_uri" or "oidc_discovery: true" to trust the JSON Web Key Set
+published by the issuer. The "claim_conditions" and "claims_mapper" fields are compared like the scope.
+
+Resources that are not part of the manifests are only deleted if --prune is set, which requires a --selector:
+
+- OAuth 2.0 Clients are deleted if they match all key/value pairs of the selector. The key "owner" matches the
+  client owner, all other keys match the "labels" object in the client metadata.
+- JSON Web Keys are deleted if they are part of a set defined in a manifest whose labels match all key/value pairs
+  of the selector.
+- Trust relationships are deleted if they belong to an issuer defined in the manifests, and the labels of all
+  manifests of that issuer match all key/value pairs of the selector.`,
+		Example: `{{ .CommandPath }} -f ./identity/ --dry-run
+{{ .CommandPath }} -f ./identity/clients.yaml -f ./identity/trust.yaml
+{{ .CommandPath }} -f ./identity/ --prune --selector owner=platform,team=identity`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			p := &applyPlanner{
+				m:             m,
+				prune:         flagx.MustGetBool(cmd, flagApplyPrune),
+				selector:      flagx.MustGetStringToStringMap(cmd, flagApplySelector),
+				updateSecrets: flagx.MustGetBool(cmd, flagApplyUpdateSecrets),
+			}
+			if p.prune && len(p.selector) == 0 {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s\n\nFlag --%s requires flag --%s.\n", cmd.UsageString(), flagApplyPrune, flagApplySelector)
+				return cmdx.FailSilently(cmd)
+			}
+
+			paths := flagx.MustGetStringSlice(cmd, flagApplyFile)
+			if len(paths) == 0 {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s\n\nPlease provide at least one file or directory using flag --%s.\n", cmd.UsageString(), flagApplyFile)
+				return cmdx.FailSilently(cmd)
+			}
+
+			desired, err := loadApplyManifests(cmd.InOrStdin(), paths)
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not load manifests: %s\n", err)
+				return cmdx.FailSilently(cmd)
+			}
+
+			plan, err := p.plan(cmd.Context(), desired)
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+
+			if flagx.MustGetBool(cmd, flagApplyDryRun) {
+				cmdx.PrintTable(cmd, &outputApplyPlan{changes: plan})
+				return nil
+			}
+
+			failed := make(map[string]error)
+			for i := range plan {
+				if plan[i].apply == nil {
+					continue
+				}
+				if err := plan[i].apply(cmd.Context()); err != nil {
+					plan[i].Error = err.Error()
+					failed[plan[i].Kind+" "+plan[i].ID] = err
+				}
+			}
+
+			cmdx.PrintTable(cmd, &outputApplyPlan{changes: plan})
+			if len(failed) != 0 {
+				cmdx.PrintErrors(cmd, failed)
+				return cmdx.FailSilently(cmd)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceP(flagApplyFile, "f", nil, "A manifest file or a directory containing manifest files. Use \"-\" to read from STDIN. Can be repeated.")
+	cmd.Flags().Bool(flagApplyDryRun, false, "Only print the plan without changing anything.")
+	cmd.Flags().Bool(flagApplyPrune, false, "Delete resources which are not part of the manifests. Requires --selector.")
+	cmd.Flags().StringToString(flagApplySelector, nil, "Only prune resources matching these key/value pairs, for example \"owner=platform,team=identity\".")
+	cmd.Flags().Bool(flagApplyUpdateSecrets, false, "Also set client secrets from the manifests on existing clients.")
+	return cmd
+}
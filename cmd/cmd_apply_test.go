@@ -0,0 +1,183 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/gofrs/uuid"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/cmd"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/sqlcon"
+)
+
+func TestApply(t *testing.T) {
+	ctx := t.Context()
+
+	_, admin, reg := setupRoutes(t, cmd.NewApplyCmd())
+	// Flags keep their values between executions, so each case uses a new command.
+	newCmd := func(t *testing.T) *cobra.Command {
+		c := cmd.NewApplyCmd()
+		cmdx.RegisterHTTPClientFlags(c.Flags())
+		cmdx.RegisterFormatFlags(c.Flags())
+		require.NoError(t, c.Flags().Set(cmdx.FlagFormat, string(cmdx.FormatJSON)))
+		require.NoError(t, c.Flags().Set(cmdx.FlagEndpoint, admin.URL))
+		return c
+	}
+
+	publicJWK := func(t *testing.T, kid string) []byte {
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		jwk, err := json.Marshal(jose.JSONWebKey{Key: &priv.PublicKey, KeyID: kid, Algorithm: "ES256", Use: "sig"})
+		require.NoError(t, err)
+		return jwk
+	}
+	jwk := publicJWK(t, "trust-key")
+	expiresAt := time.Now().Add(time.Hour).UTC().Round(time.Second).Format(time.RFC3339)
+
+	dir := t.TempDir()
+	write := func(t *testing.T, name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+	writeClient := func(t *testing.T, scope string) {
+		write(t, "clients.yaml", fmt.Sprintf(`kind: OAuth2Client
+secret_from:
+  env: APPLY_TEST_CLIENT_SECRET
+spec:
+  client_id: apply-client
+  owner: platform
+  scope: %s
+  grant_types: [client_credentials]
+  metadata:
+    labels:
+      team: identity
+`, scope))
+	}
+	writeTrust := func(t *testing.T, scope string) {
+		write(t, "trust.json", fmt.Sprintf(`{"kind": "TrustedJwtGrantIssuer", "spec": {"issuer": "https://apply-idp.example.com", "subject": "alice", "scope": [%q], "expires_at": %q, "jwk": %s}}`, scope, expiresAt, jwk))
+	}
+	write(t, "keys.yaml", fmt.Sprintf("kind: JsonWebKeySet\nlabels:\n  owner: platform\n  team: identity\nspec:\n  set: apply-set\n  keys:\n    - %s\n", publicJWK(t, "apply-key")))
+	write(t, "README.md", "not a manifest")
+	writeClient(t, "read")
+	writeTrust(t, "read")
+	t.Setenv("APPLY_TEST_CLIENT_SECRET", "some-secret-from-the-environment")
+
+	actions := func(t *testing.T, out string) map[string]string {
+		result := make(map[string]string)
+		for _, c := range gjson.Parse(out).Array() {
+			result[c.Get("kind").String()] = c.Get("action").String()
+		}
+		return result
+	}
+
+	t.Run("case=dry run only prints the plan", func(t *testing.T) {
+		out := cmdx.ExecNoErr(t, newCmd(t), "-f", dir, "--dry-run")
+		assert.Equal(t, map[string]string{"OAuth2Client": "create", "JsonWebKeySet": "create", "TrustedJwtGrantIssuer": "create"}, actions(t, out), out)
+
+		_, err := reg.ClientManager().GetConcreteClient(ctx, "apply-client")
+		assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+	})
+
+	t.Run("case=creates resources", func(t *testing.T) {
+		out := cmdx.ExecNoErr(t, newCmd(t), "-f", dir)
+		assert.Equal(t, map[string]string{"OAuth2Client": "create", "JsonWebKeySet": "create", "TrustedJwtGrantIssuer": "create"}, actions(t, out), out)
+
+		_, err := reg.ClientManager().AuthenticateClient(ctx, "apply-client", []byte("some-secret-from-the-environment"))
+		require.NoError(t, err)
+		_, err = reg.KeyManager().GetKey(ctx, "apply-set", "apply-key")
+		require.NoError(t, err)
+		grants, _, err := reg.GrantManager().GetGrants(ctx, "https://apply-idp.example.com")
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, []string{"read"}, grants[0].Scope)
+	})
+
+	t.Run("case=applying again changes nothing", func(t *testing.T) {
+		out := cmdx.ExecNoErr(t, newCmd(t), "-f", dir)
+		assert.Equal(t, map[string]string{"OAuth2Client": "unchanged", "JsonWebKeySet": "unchanged", "TrustedJwtGrantIssuer": "unchanged"}, actions(t, out), out)
+	})
+
+	t.Run("case=updates changed resources", func(t *testing.T) {
+		before, _, err := reg.GrantManager().GetGrants(ctx, "https://apply-idp.example.com")
+		require.NoError(t, err)
+		require.Len(t, before, 1)
+
+		writeClient(t, "write")
+		writeTrust(t, "write")
+
+		out := cmdx.ExecNoErr(t, newCmd(t), "-f", dir)
+		assert.Equal(t, map[string]string{"OAuth2Client": "update", "JsonWebKeySet": "unchanged", "TrustedJwtGrantIssuer": "replace"}, actions(t, out), out)
+		assert.Equal(t, `["scope"]`, gjson.Get(out, `#(kind=="OAuth2Client").changes`).Raw)
+
+		c, err := reg.ClientManager().GetConcreteClient(ctx, "apply-client")
+		require.NoError(t, err)
+		assert.Equal(t, "write", c.Scope)
+		_, err = reg.ClientManager().AuthenticateClient(ctx, "apply-client", []byte("some-secret-from-the-environment"))
+		require.NoError(t, err, "the secret is kept")
+
+		grants, _, err := reg.GrantManager().GetGrants(ctx, "https://apply-idp.example.com")
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, []string{"write"}, grants[0].Scope)
+		assert.Equal(t, before[0].ID, grants[0].ID, "the trust relationship is replaced in place")
+	})
+
+	t.Run("case=prunes resources matching the selector", func(t *testing.T) {
+		matching := createClient(t, reg, &client.Client{ID: uuid.Must(uuid.NewV4()).String(), Owner: "platform", Metadata: []byte(`{"labels":{"team":"identity"}}`)})
+		other := createClient(t, reg, &client.Client{ID: uuid.Must(uuid.NewV4()).String(), Owner: "platform", Metadata: []byte(`{"labels":{"team":"payments"}}`)})
+		var strayKey jose.JSONWebKey
+		require.NoError(t, json.Unmarshal(publicJWK(t, "stray-key"), &strayKey))
+		require.NoError(t, reg.KeyManager().AddKey(ctx, "apply-set", &strayKey))
+		strayTrust := createTrust(t, reg, "https://apply-idp.example.com")
+
+		cmdx.ExecExpectedErr(t, newCmd(t), "-f", dir, "--prune")
+
+		out := cmdx.ExecNoErr(t, newCmd(t), "-f", dir, "--prune", "--selector", "owner=platform,team=identity")
+		deleted := gjson.Get(out, `#(action=="delete")#.id`).Array()
+		require.Len(t, deleted, 1, out)
+		assert.Equal(t, matching.ID, deleted[0].String())
+
+		_, err := reg.ClientManager().GetConcreteClient(ctx, matching.ID)
+		assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+		_, err = reg.ClientManager().GetConcreteClient(ctx, other.ID)
+		assert.NoError(t, err)
+		_, err = reg.ClientManager().GetConcreteClient(ctx, "apply-client")
+		assert.NoError(t, err)
+
+		_, err = reg.KeyManager().GetKey(ctx, "apply-set", "stray-key")
+		assert.ErrorIs(t, err, sqlcon.ErrNoRows(), "the labels of the key set manifest match the selector")
+		_, err = reg.GrantManager().GetConcreteGrant(ctx, strayTrust.ID)
+		assert.NoError(t, err, "the trust manifest has no labels, so it does not match the selector")
+	})
+
+	t.Run("case=rejects invalid manifests", func(t *testing.T) {
+		invalid := filepath.Join(t.TempDir(), "invalid.yaml")
+
+		for _, content := range []string{
+			"kind: Unknown\nspec: {}\n",
+			"kind: OAuth2Client\nspec:\n  scope: read\n",
+			"kind: OAuth2Client\nsecret_from:\n  env: APPLY_TEST_UNSET_VARIABLE\nspec:\n  client_id: foo\n",
+			"kind: OAuth2Client\nspec:\n  client_id: foo\n---\nkind: OAuth2Client\nspec:\n  client_id: foo\n",
+		} {
+			require.NoError(t, os.WriteFile(invalid, []byte(content), 0600))
+			stderr := cmdx.ExecExpectedErr(t, newCmd(t), "-f", invalid)
+			assert.Contains(t, stderr, "Could not load manifests", content)
+		}
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+)
+
+const (
+	flagTenantName       = "name"
+	flagTenantHost       = "host"
+	flagTenantPathPrefix = "path-prefix"
+	flagTenantConfig     = "config"
+)
+
+func NewCreateTenantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tenant",
+		Aliases: []string{"tenants"},
+		Args:    cobra.NoArgs,
+		Short:   "Create a tenant",
+		Long: `This command creates a tenant. Once multi-tenant mode is enabled, requests to the tenant's hosts or path prefix
+are served by the tenant, using its own OAuth 2.0 Clients, sessions, tokens and JSON Web Keys.`,
+		Example: `{{ .CommandPath }} --name acme --host auth.acme.com \
+	--config urls.self.issuer=https://auth.acme.com/ \
+	--config urls.login=https://login.acme.com/login \
+	--config ttl.access_token=10m`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			var t hydra.Tenant
+			applyTenantFlags(cmd, &t)
+
+			created, _, err := m.TenantAPI.CreateTenant(cmd.Context()).Tenant(t).Execute() //nolint:bodyclose
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+
+			cmdx.PrintRow(cmd, (*outputTenant)(created))
+			return nil
+		},
+	}
+	registerTenantFlags(cmd)
+	return cmd
+}
+
+func registerTenantFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagTenantName, "", "The tenant's unique name.")
+	cmd.Flags().StringSlice(flagTenantHost, nil, "A host the tenant is served on if tenants are resolved by host. Can be repeated.")
+	cmd.Flags().String(flagTenantPathPrefix, "", "The path prefix the tenant is served on if tenants are resolved by path.")
+	cmd.Flags().StringToString(flagTenantConfig, nil, "A configuration override in the form of key=value, for example urls.self.issuer=https://auth.acme.com/. Can be repeated.")
+}
+
+// applyTenantFlags sets the tenant's fields from the flags that were provided.
+func applyTenantFlags(cmd *cobra.Command, t *hydra.Tenant) {
+	if cmd.Flags().Changed(flagTenantName) {
+		t.Name = new(flagx.MustGetString(cmd, flagTenantName))
+	}
+	if cmd.Flags().Changed(flagTenantHost) {
+		t.Hosts = flagx.MustGetStringSlice(cmd, flagTenantHost)
+	}
+	if cmd.Flags().Changed(flagTenantPathPrefix) {
+		t.PathPrefix = new(flagx.MustGetString(cmd, flagTenantPathPrefix))
+	}
+	if cmd.Flags().Changed(flagTenantConfig) {
+		values, _ := cmd.Flags().GetStringToString(flagTenantConfig)
+		config := make(map[string]interface{}, len(values))
+		for k, v := range values {
+			config[k] = v
+		}
+		t.Config = config
+	}
+}
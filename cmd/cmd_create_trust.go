@@ -0,0 +1,225 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/hydra/v2/cmd/cli"
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+	"github.com/ory/x/josex"
+)
+
+const (
+	flagTrustIssuer          = "issuer"
+	flagTrustSubject         = "subject"
+	flagTrustAllowAnySubject = "allow-any-subject"
+	flagTrustScope           = "scope"
+	flagTrustExpiresIn       = "expires-in"
+	flagTrustKeyID           = "kid"
+	flagTrustAlg             = "alg"
+	flagTrustJWKSURI         = "jwks-uri"
+	flagTrustOIDCDiscovery   = "oidc-discovery"
+	flagTrustClaimCondition  = "claim-condition"
+	flagTrustClaimsMapper    = "claims-mapper"
+)
+
+func NewCreateTrustCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "trust <key-file>",
+		Aliases: []string{"trusts", "jwt-bearer-grant"},
+		Args:    cobra.MaximumNArgs(1),
+		Short:   "Trust a JWT-bearer grant issuer",
+		Long: `This command creates a trust relationship with the issuer of JWT assertions, which allows OAuth 2.0 Clients to
+exchange assertions signed by the issuer for access tokens using the JWT-bearer grant (RFC 7523).
+
+The issuer's public key is read from the given file, or from STDIN if no file is given. Supported formats are
+JSON Web Keys and PEM/DER encoded keys or certificates. If a private key is given, only its public key is imported.
+
+Instead of a single key, the trust relationship can be backed by the JSON Web Key Set the issuer publishes, either
+at the URL given with --jwks-uri or at the URL found in the issuer's OpenID Connect Discovery document with
+--oidc-discovery. Keys rotated by the issuer are then trusted without updating the trust relationship.
+
+Assertions can be restricted further with conditions on their claims, and a Jsonnet claims mapper can derive the
+subject, scope and ext claims of the access token from them.`,
+		Example: `{{ .CommandPath }} --issuer https://jwt-idp.example.com --subject alice@example.com --scope read,write ./issuer.pub
+{{ .CommandPath }} --issuer https://jwt-idp.example.com --allow-any-subject --alg ES256 --expires-in 720h ./issuer.pem
+{{ .CommandPath }} --issuer https://kubernetes.default.svc --allow-any-subject --jwks-uri https://kubernetes.default.svc/openid/v1/jwks
+{{ .CommandPath }} --issuer https://token.actions.githubusercontent.com --allow-any-subject --oidc-discovery
+{{ .CommandPath }} --issuer https://token.actions.githubusercontent.com --allow-any-subject --oidc-discovery --claim-condition repository=org/app --claim-condition environment=staging,production --claims-mapper ./github.jsonnet`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			issuer, subject := flagx.MustGetString(cmd, flagTrustIssuer), flagx.MustGetString(cmd, flagTrustSubject)
+			allowAnySubject := flagx.MustGetBool(cmd, flagTrustAllowAnySubject)
+			if issuer == "" || (subject == "") == !allowAnySubject {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s\n\nPlease provide the issuer using flag --%s, and either flag --%s or --%s.\n",
+					cmd.UsageString(), flagTrustIssuer, flagTrustSubject, flagTrustAllowAnySubject)
+				return cmdx.FailSilently(cmd)
+			}
+
+			body := hydra.TrustOAuth2JwtGrantIssuer{
+				Issuer:    issuer,
+				Scope:     flagx.MustGetStringSlice(cmd, flagTrustScope),
+				ExpiresAt: time.Now().Add(flagx.MustGetDuration(cmd, flagTrustExpiresIn)).UTC().Round(time.Second),
+			}
+			if allowAnySubject {
+				body.AllowAnySubject = new(true)
+			} else {
+				body.Subject = new(subject)
+			}
+
+			conditions, err := parseClaimConditions(flagx.MustGetStringArray(cmd, flagTrustClaimCondition))
+			if err != nil {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s\n\n%s\n", cmd.UsageString(), err)
+				return cmdx.FailSilently(cmd)
+			}
+			if len(conditions) > 0 {
+				body.ClaimConditions = &conditions
+			}
+			if path := flagx.MustGetString(cmd, flagTrustClaimsMapper); path != "" {
+				mapper, err := os.ReadFile(path) // #nosec G304
+				if err != nil {
+					_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not read claims mapper %s: %s\n", path, err)
+					return cmdx.FailSilently(cmd)
+				}
+				body.ClaimsMapper = new(string(mapper))
+			}
+
+			jwksURI, discovery := flagx.MustGetString(cmd, flagTrustJWKSURI), flagx.MustGetBool(cmd, flagTrustOIDCDiscovery)
+			switch {
+			case jwksURI != "" && discovery, (jwksURI != "" || discovery) && len(args) > 0:
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s\n\nPlease provide either a key file, flag --%s or flag --%s.\n",
+					cmd.UsageString(), flagTrustJWKSURI, flagTrustOIDCDiscovery)
+				return cmdx.FailSilently(cmd)
+			case jwksURI != "":
+				body.JwksUri = &jwksURI
+			case discovery:
+				body.OidcDiscovery = new(true)
+			default:
+				key, err := readTrustedJSONWebKey(cmd, args)
+				if err != nil {
+					return err
+				}
+				body.Jwk = key
+			}
+
+			grant, _, err := m.OAuth2API.TrustOAuth2JwtGrantIssuer(cmd.Context()).TrustOAuth2JwtGrantIssuer(body).Execute() //nolint:bodyclose
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+
+			cmdx.PrintRow(cmd, (*outputTrustedIssuer)(grant))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagTrustIssuer, "", "The issuer of the JWT assertions, which must match their \"iss\" claim.")
+	cmd.Flags().String(flagTrustSubject, "", "The subject the issuer may issue assertions for, which must match their \"sub\" claim.")
+	cmd.Flags().Bool(flagTrustAllowAnySubject, false, "Allow the issuer to issue assertions for any subject.")
+	cmd.Flags().StringSlice(flagTrustScope, nil, "A scope the issuer may request. Can be repeated or comma separated.")
+	cmd.Flags().Duration(flagTrustExpiresIn, 365*24*time.Hour, "How long the issuer is trusted.")
+	cmd.Flags().String(flagTrustKeyID, "", "Sets the \"kid\" value of the JSON Web Key if the key does not define one itself. Defaults to a random UUID.")
+	cmd.Flags().String(flagTrustAlg, "", "Sets the \"alg\" value of the JSON Web Key if the key does not define one itself. Required when importing PEM/DER encoded data.")
+	cmd.Flags().String(flagTrustJWKSURI, "", "Trust the keys of the JSON Web Key Set published by the issuer at this URL instead of a single key.")
+	cmd.Flags().Bool(flagTrustOIDCDiscovery, false, "Trust the keys of the JSON Web Key Set found in the issuer's OpenID Connect Discovery document instead of a single key.")
+	cmd.Flags().StringArray(flagTrustClaimCondition, nil, "A condition on a claim of the assertions in the form <claim>=<value>[,<value>...]. Nested claims are separated by dots. Can be repeated.")
+	cmd.Flags().String(flagTrustClaimsMapper, "", "The Jsonnet file deriving the subject, scope and ext claims of the access token from the claims of the assertion.")
+	return cmd
+}
+
+// parseClaimConditions parses claim conditions of the form
+// <claim>=<value>[,<value>...]. Conditions on the same claim are merged.
+func parseClaimConditions(raw []string) (map[string][]string, error) {
+	conditions := make(map[string][]string, len(raw))
+	for _, r := range raw {
+		claim, values, ok := strings.Cut(r, "=")
+		if !ok || claim == "" || values == "" {
+			return nil, errors.Errorf("the claim condition %q must have the form <claim>=<value>[,<value>...]", r)
+		}
+		conditions[claim] = append(conditions[claim], strings.Split(values, ",")...)
+	}
+	return conditions, nil
+}
+
+// readTrustedJSONWebKey reads the public JSON Web Key from the file given as
+// argument, or from STDIN.
+func readTrustedJSONWebKey(cmd *cobra.Command, args []string) (*hydra.JsonWebKey, error) {
+	src, in := "STDIN", cmd.InOrStdin()
+	if len(args) == 1 {
+		f, err := os.Open(args[0]) // #nosec G304
+		if err != nil {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not open file %s: %s\n", args[0], err)
+			return nil, cmdx.FailSilently(cmd)
+		}
+		defer f.Close() //nolint:errcheck
+		src, in = args[0], f
+	}
+
+	content, err := io.ReadAll(in)
+	if err != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not read from %s: %s\n", src, err)
+		return nil, cmdx.FailSilently(cmd)
+	}
+
+	key, err := loadTrustedJSONWebKey(content, flagx.MustGetString(cmd, flagTrustKeyID), flagx.MustGetString(cmd, flagTrustAlg))
+	if err != nil {
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Could not load key from %s: %s\n", src, err)
+		return nil, cmdx.FailSilently(cmd)
+	}
+	return key, nil
+}
+
+// loadTrustedJSONWebKey returns the public JSON Web Key from PEM/DER or JSON
+// encoded data.
+func loadTrustedJSONWebKey(content []byte, kid, alg string) (*hydra.JsonWebKey, error) {
+	var key jose.JSONWebKey
+	if pub, pubErr := josex.LoadPublicKey(content); pubErr == nil {
+		key = cli.ToSDKFriendlyJSONWebKey(pub, kid, "sig")
+	} else if priv, privErr := josex.LoadPrivateKey(content); privErr == nil {
+		key = cli.ToSDKFriendlyJSONWebKey(priv, kid, "sig")
+		key = josex.ToPublicKey(&key)
+	} else {
+		return nil, errors.Errorf("could not decode key to public nor private keys: %s; %s", pubErr, privErr)
+	}
+
+	if key.KeyID == "" {
+		key.KeyID = uuid.Must(uuid.NewV4()).String()
+	}
+	if key.Algorithm == "" {
+		key.Algorithm = alg
+	}
+	if key.Algorithm == "" {
+		return nil, errors.Errorf("flag --%s is required when the key does not define the \"alg\" field itself", flagTrustAlg)
+	}
+
+	type jwk hydra.JsonWebKey // opt out of OpenAPI-generated UnmarshalJSON
+	var (
+		buf        bytes.Buffer
+		jsonWebKey jwk
+	)
+	if err := json.NewEncoder(&buf).Encode(key); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := json.NewDecoder(&buf).Decode(&jsonWebKey); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return new(hydra.JsonWebKey(jsonWebKey)), nil
+}
@@ -0,0 +1,140 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/gofrs/uuid"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/hydra/v2/cmd"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/oauth2/trust"
+	"github.com/ory/x/cmdx"
+)
+
+func TestCreateTrust(t *testing.T) {
+	t.Parallel()
+
+	_, admin, reg := setupRoutes(t, cmd.NewCreateTrustCmd())
+	// Flags keep their values between executions, so each case uses a new command.
+	newCmd := func(t *testing.T) *cobra.Command {
+		c := cmd.NewCreateTrustCmd()
+		cmdx.RegisterHTTPClientFlags(c.Flags())
+		cmdx.RegisterFormatFlags(c.Flags())
+		require.NoError(t, c.Flags().Set(cmdx.FlagFormat, string(cmdx.FormatJSON)))
+		require.NoError(t, c.Flags().Set(cmdx.FlagEndpoint, admin.URL))
+		return c
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	dir := t.TempDir()
+
+	t.Run("case=creates trust relationship from a PEM encoded public key", func(t *testing.T) {
+		der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		require.NoError(t, err)
+		path := filepath.Join(dir, "issuer.pub")
+		require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+		actual := gjson.Parse(cmdx.ExecNoErr(t, newCmd(t), "--issuer", "https://jwt-idp.example.com", "--subject", "alice@example.com",
+			"--scope", "read,write", "--alg", "ES256", "--kid", "pem-key", path))
+		assert.Equal(t, "https://jwt-idp.example.com", actual.Get("issuer").String())
+		assert.Equal(t, "alice@example.com", actual.Get("subject").String())
+		assert.Equal(t, []any{"read", "write"}, actual.Get("scope").Value())
+		assert.Equal(t, "pem-key", actual.Get("public_key.kid").String())
+
+		g, err := reg.GrantManager().GetConcreteGrant(t.Context(), uuid.FromStringOrNil(actual.Get("id").String()))
+		require.NoError(t, err)
+		assert.Equal(t, "pem-key", g.PublicKey.KeyID)
+	})
+
+	t.Run("case=imports only the public key of a private JSON Web Key", func(t *testing.T) {
+		raw, err := json.Marshal(jose.JSONWebKey{Key: key, KeyID: "jwk-key", Algorithm: "ES256", Use: "sig"})
+		require.NoError(t, err)
+		path := filepath.Join(dir, "issuer.json")
+		require.NoError(t, os.WriteFile(path, raw, 0o600))
+
+		actual := gjson.Parse(cmdx.ExecNoErr(t, newCmd(t), "--issuer", "https://jwt-idp.example.com", "--allow-any-subject", path))
+		assert.True(t, actual.Get("allow_any_subject").Bool())
+		assert.Equal(t, "jwk-key", actual.Get("public_key.kid").String())
+
+		k, err := reg.KeyManager().GetKey(t.Context(), "https://jwt-idp.example.com", "jwk-key")
+		require.NoError(t, err)
+		require.Len(t, k.Keys, 1)
+		assert.True(t, k.Keys[0].IsPublic())
+	})
+
+	t.Run("case=creates trust relationship backed by a remote JSON Web Key Set", func(t *testing.T) {
+		actual := gjson.Parse(cmdx.ExecNoErr(t, newCmd(t), "--issuer", "https://remote-idp.example.com", "--allow-any-subject",
+			"--jwks-uri", "https://remote-idp.example.com/.well-known/jwks.json"))
+		assert.Equal(t, "https://remote-idp.example.com/.well-known/jwks.json", actual.Get("jwks_uri").String())
+		assert.Empty(t, actual.Get("public_key.kid").String())
+
+		cmdx.ExecExpectedErr(t, newCmd(t), "--issuer", "https://remote-idp.example.com", "--allow-any-subject",
+			"--jwks-uri", "https://remote-idp.example.com/.well-known/jwks.json", "--oidc-discovery")
+		cmdx.ExecExpectedErr(t, newCmd(t), "--issuer", "https://remote-idp.example.com", "--allow-any-subject",
+			"--jwks-uri", "https://remote-idp.example.com/.well-known/jwks.json", filepath.Join(dir, "issuer.json"))
+	})
+
+	t.Run("case=creates trust relationship with claim conditions and a claims mapper", func(t *testing.T) {
+		mapper := filepath.Join(dir, "mapper.jsonnet")
+		require.NoError(t, os.WriteFile(mapper, []byte("{subject: std.extVar('ctx').claims.repository}"), 0o600))
+
+		actual := gjson.Parse(cmdx.ExecNoErr(t, newCmd(t), "--issuer", "https://ci.example.com", "--allow-any-subject",
+			"--jwks-uri", "https://ci.example.com/.well-known/jwks.json", "--claim-condition", "repository=org/app",
+			"--claim-condition", "environment=staging,production", "--claims-mapper", mapper))
+		assert.JSONEq(t, `{"repository": ["org/app"], "environment": ["staging", "production"]}`, actual.Get("claim_conditions").Raw)
+		assert.Equal(t, "{subject: std.extVar('ctx').claims.repository}", actual.Get("claims_mapper").String())
+
+		g, err := reg.GrantManager().GetConcreteGrant(t.Context(), uuid.FromStringOrNil(actual.Get("id").String()))
+		require.NoError(t, err)
+		assert.Equal(t, trust.ClaimConditions{"repository": {"org/app"}, "environment": {"staging", "production"}}, g.ClaimConditions)
+
+		cmdx.ExecExpectedErr(t, newCmd(t), "--issuer", "https://ci.example.com", "--allow-any-subject",
+			"--jwks-uri", "https://ci.example.com/.well-known/jwks.json", "--claim-condition", "repository")
+	})
+
+	t.Run("case=requires either a subject or any subject", func(t *testing.T) {
+		cmdx.ExecExpectedErr(t, newCmd(t), "--issuer", "https://jwt-idp.example.com", filepath.Join(dir, "issuer.json"))
+		cmdx.ExecExpectedErr(t, newCmd(t), "--issuer", "https://jwt-idp.example.com", "--subject", "alice", "--allow-any-subject", filepath.Join(dir, "issuer.json"))
+	})
+
+	t.Run("case=requires the algorithm of PEM encoded keys", func(t *testing.T) {
+		cmdx.ExecExpectedErr(t, newCmd(t), "--issuer", "https://other-idp.example.com", "--subject", "alice", filepath.Join(dir, "issuer.pub"))
+	})
+}
+
+func createTrust(t *testing.T, reg *driver.RegistrySQL, issuer string) trust.Grant {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	g := trust.Grant{
+		ID:        uuid.Must(uuid.NewV4()),
+		Issuer:    issuer,
+		Subject:   "alice@example.com",
+		Scope:     []string{"read"},
+		PublicKey: trust.PublicKey{Set: issuer, KeyID: uuid.Must(uuid.NewV4()).String()},
+		CreatedAt: time.Now().UTC().Round(time.Second),
+		ExpiresAt: time.Now().Add(time.Hour).UTC().Round(time.Second),
+	}
+	require.NoError(t, reg.GrantManager().CreateGrant(t.Context(), g, jose.JSONWebKey{
+		Key: &key.PublicKey, KeyID: g.PublicKey.KeyID, Algorithm: "ES256", Use: "sig",
+	}))
+	return g
+}
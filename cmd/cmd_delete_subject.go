@@ -0,0 +1,40 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+)
+
+func NewDeleteSubjectCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "subject <subject>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Erase all data of a subject",
+		Long: `This command irreversibly deletes all data stored about a subject: its login and consent sessions, flows,
+consent history, pairwise subject identifiers and tokens. The tokens are revoked, and OpenID Connect Back-Channel
+Logout is performed for all of the subject's login sessions.
+
+The command prints the erasure report. Use "--format json" to get the report together with its signed JWT, which can
+be kept as proof of the erasure. The report contains an HMAC of the subject keyed with the system secret instead of the subject itself.`,
+		Example: `{{ .CommandPath }} alice@example.com`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			report, _, err := m.OAuth2API.EraseOAuth2Subject(cmd.Context()).Subject(args[0]).Execute() //nolint:bodyclose
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+
+			cmdx.PrintRow(cmd, (*outputSubjectErasureReport)(report))
+			return nil
+		},
+	}
+}
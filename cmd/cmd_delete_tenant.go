@@ -0,0 +1,56 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+)
+
+func NewDeleteTenantCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "tenant <id-1> [<id-2> ...]",
+		Aliases: []string{"tenants"},
+		Args:    cobra.MinimumNArgs(1),
+		Short:   "Delete one or more tenants by their ID(s)",
+		Long: `This command deletes one or more tenants by their respective IDs.
+
+The tenant's OAuth 2.0 Clients, sessions, tokens and JSON Web Keys are deleted as well.`,
+		Example: `{{ .CommandPath }} <tenant-1> <tenant-2>`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			var (
+				deleted = make([]cmdx.OutputIder, 0, len(args))
+				failed  = make(map[string]error)
+			)
+
+			for _, id := range args {
+				_, err := m.TenantAPI.DeleteTenant(cmd.Context(), id).Execute() //nolint:bodyclose
+				if err != nil {
+					failed[id] = cmdx.PrintOpenAPIError(cmd, err)
+					continue
+				}
+				deleted = append(deleted, cmdx.OutputIder(id))
+			}
+
+			if len(deleted) == 1 {
+				cmdx.PrintRow(cmd, &deleted[0])
+			} else if len(deleted) > 1 {
+				cmdx.PrintTable(cmd, &cmdx.OutputIderCollection{Items: deleted})
+			}
+
+			cmdx.PrintErrors(cmd, failed)
+			if len(failed) != 0 {
+				return cmdx.FailSilently(cmd)
+			}
+			return nil
+		},
+	}
+}
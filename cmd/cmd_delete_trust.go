@@ -0,0 +1,60 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+)
+
+func NewDeleteTrustCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "trust <id-1> [<id-2> ...]",
+		Aliases: []string{"trusts", "jwt-bearer-grant"},
+		Args:    cobra.MinimumNArgs(1),
+		Short:   "Delete one or more trusted JWT-bearer grant issuers by their ID(s)",
+		Long: `This command deletes one or more trust relationships with JWT-bearer grant issuers. Assertions signed by the
+issuer are rejected afterwards, access tokens which have already been issued remain valid.`,
+		Example: `{{ .CommandPath }} <id-1> <id-2>
+
+To delete all trust relationships with an issuer, run:
+
+	{{ .CommandPath }} $({{ .Root.Name }} list trust --issuer https://jwt-idp.example.com --format json | jq -r '.items[].id')`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			var (
+				deleted = make([]cmdx.OutputIder, 0, len(args))
+				failed  = make(map[string]error)
+			)
+
+			for _, id := range args {
+				_, err := m.OAuth2API.DeleteTrustedOAuth2JwtGrantIssuer(cmd.Context(), id).Execute() //nolint:bodyclose
+				if err != nil {
+					failed[id] = cmdx.PrintOpenAPIError(cmd, err)
+					continue
+				}
+				deleted = append(deleted, cmdx.OutputIder(id))
+			}
+
+			if len(deleted) == 1 {
+				cmdx.PrintRow(cmd, &deleted[0])
+			} else if len(deleted) > 1 {
+				cmdx.PrintTable(cmd, &cmdx.OutputIderCollection{Items: deleted})
+			}
+
+			cmdx.PrintErrors(cmd, failed)
+			if len(failed) != 0 {
+				return cmdx.FailSilently(cmd)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/cmd"
+	"github.com/ory/x/assertx"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/sqlcon"
+)
+
+func TestDeleteTrust(t *testing.T) {
+	t.Parallel()
+
+	c := cmd.NewDeleteTrustCmd()
+	reg := setup(t, c)
+
+	t.Run("case=deletes trust relationship", func(t *testing.T) {
+		expected := createTrust(t, reg, "https://jwt-idp.example.com")
+		stdout := cmdx.ExecNoErr(t, c, expected.ID.String())
+		assert.Equal(t, fmt.Sprintf(`"%s"`, expected.ID), strings.TrimSpace(stdout))
+
+		_, err := reg.GrantManager().GetConcreteGrant(t.Context(), expected.ID)
+		assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+	})
+
+	t.Run("case=deletes multiple trust relationships", func(t *testing.T) {
+		expected1 := createTrust(t, reg, "https://jwt-idp.example.com")
+		expected2 := createTrust(t, reg, "https://other-idp.example.com")
+		assertx.EqualAsJSON(t, []string{expected1.ID.String(), expected2.ID.String()}, json.RawMessage(cmdx.ExecNoErr(t, c, expected1.ID.String(), expected2.ID.String())))
+
+		_, err := reg.GrantManager().GetConcreteGrant(t.Context(), expected1.ID)
+		assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+
+		_, err = reg.GrantManager().GetConcreteGrant(t.Context(), expected2.ID)
+		assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+	})
+
+	t.Run("case=one trust relationship deletion fails", func(t *testing.T) {
+		expected := createTrust(t, reg, "https://jwt-idp.example.com")
+		stdout, _, err := cmdx.Exec(t, c, nil, "00000000-0000-0000-0000-000000000000", expected.ID.String())
+		require.Error(t, err)
+		assert.Equal(t, fmt.Sprintf(`"%s"`, expected.ID), strings.TrimSpace(stdout))
+
+		_, err = reg.GrantManager().GetConcreteGrant(t.Context(), expected.ID)
+		assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+	})
+}
@@ -0,0 +1,37 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+)
+
+func NewGetDeviceRequestCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "device-request <user-code>",
+		Aliases: []string{"device-requests"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Get a device authorization request by its user code",
+		Long: `This command looks up the device authorization request of a user code, including the OAuth 2.0 Client which
+initiated it and whether the user code is pending, accepted, or rejected.`,
+		Example: `{{ .CommandPath }} ABCD1234`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			request, _, err := m.OAuth2API.GetOAuth2DeviceRequest(cmd.Context()).UserCode(args[0]).Execute() //nolint:bodyclose
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+
+			cmdx.PrintRow(cmd, (*outputDeviceRequest)(request))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+)
+
+func NewGetLoginRequestCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "login-request <challenge>",
+		Aliases: []string{"login-requests"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Get a login request by its challenge",
+		Long: `This command gets all the details about a login request, which is useful to debug the login flow of a login
+provider. Login requests which have already been handled can not be fetched.`,
+		Example: `{{ .CommandPath }} <challenge>`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			req, _, err := m.OAuth2API.GetOAuth2LoginRequest(cmd.Context()).LoginChallenge(args[0]).Execute() //nolint:bodyclose
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+
+			cmdx.PrintRow(cmd, (*outputLoginRequest)(req))
+			return nil
+		},
+	}
+}
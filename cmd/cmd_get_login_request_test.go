@@ -0,0 +1,66 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/hydra/v2/cmd"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/x/cmdx"
+)
+
+func TestGetLoginRequest(t *testing.T) {
+	t.Parallel()
+
+	c := cmd.NewGetLoginRequestCmd()
+	reg := setup(t, c)
+
+	cl := createClient(t, reg, nil)
+	newFlow := func(state flow.State) *flow.Flow {
+		return &flow.Flow{
+			ID:             uuid.Must(uuid.NewV4()).String(),
+			NID:            reg.Networker().NetworkID(t.Context()),
+			Client:         cl,
+			ClientID:       cl.GetID(),
+			Subject:        "alice@example.com",
+			RequestURL:     "https://example.org/oauth2/auth?client_id=" + cl.GetID(),
+			RequestedScope: []string{"openid", "profile"},
+			RequestedAt:    time.Now(),
+			State:          state,
+		}
+	}
+
+	t.Run("case=gets login request", func(t *testing.T) {
+		challenge, err := newFlow(flow.FlowStateLoginUnused).ToLoginChallenge(t.Context(), reg)
+		require.NoError(t, err)
+
+		actual := gjson.Parse(cmdx.ExecNoErr(t, c, challenge))
+		assert.Equal(t, challenge, actual.Get("challenge").String())
+		assert.Equal(t, "alice@example.com", actual.Get("subject").String())
+		assert.Equal(t, cl.GetID(), actual.Get("client.client_id").String())
+		assert.Empty(t, actual.Get("client.client_secret").String())
+		assert.Equal(t, []any{"openid", "profile"}, actual.Get("requested_scope").Value())
+	})
+
+	t.Run("case=fails for handled login request", func(t *testing.T) {
+		challenge, err := newFlow(flow.FlowStateLoginUsed).ToLoginChallenge(t.Context(), reg)
+		require.NoError(t, err)
+		cmdx.ExecExpectedErr(t, c, challenge)
+	})
+
+	t.Run("case=fails for invalid challenge", func(t *testing.T) {
+		cmdx.ExecExpectedErr(t, c, "i-am-not-a-challenge")
+
+		challenge, err := newFlow(flow.FlowStateConsentUnused).ToConsentChallenge(t.Context(), reg)
+		require.NoError(t, err)
+		cmdx.ExecExpectedErr(t, c, challenge)
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+)
+
+func NewGetPairwiseSubjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pairwise-subject <pairwise-subject>",
+		Aliases: []string{"pairwise-subjects"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Resolve a pairwise subject identifier",
+		Long: `This command resolves a pairwise subject identifier issued to an OAuth 2.0 Client to the subject it stands for.
+Identifiers issued before a migration and identifiers computed with a previous salt are resolved as well.`,
+		Example: `{{ .CommandPath }} --client my-client 9c1c5bc2dba2e0e6fd0e4a95dbd0cf5ccbe7bf0ec2a6b5b0c4e6ea1e0bd5d1d3`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			ps, _, err := m.OAuth2API.GetOAuth2PairwiseSubject(cmd.Context()).
+				ClientId(flagx.MustGetString(cmd, flagConsentClient)).
+				PairwiseSubject(args[0]).
+				Execute() //nolint:bodyclose
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+
+			cmdx.PrintRow(cmd, (*outputPairwiseSubject)(ps))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagConsentClient, "", "The OAuth 2.0 Client the pairwise subject identifier was issued to.")
+	_ = cmd.MarkFlagRequired(flagConsentClient)
+	return cmd
+}
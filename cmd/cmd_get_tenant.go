@@ -0,0 +1,48 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+)
+
+func NewGetTenantCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "tenant <id-1> [<id-2> ...]",
+		Aliases: []string{"tenants"},
+		Args:    cobra.MinimumNArgs(1),
+		Short:   "Get one or more tenants by their ID(s)",
+		Long:    `This command gets all the details about a tenant. You can use this command in combination with jq.`,
+		Example: `To get the tenant's configuration overrides, run:
+
+	{{ .CommandPath }} <your-tenant-id> --format json | jq -r '.config'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			tenants := make([]hydra.Tenant, 0, len(args))
+			for _, id := range args {
+				t, _, err := m.TenantAPI.GetTenant(cmd.Context(), id).Execute() //nolint:bodyclose
+				if err != nil {
+					return cmdx.PrintOpenAPIError(cmd, err)
+				}
+				tenants = append(tenants, *t)
+			}
+
+			if len(tenants) == 1 {
+				cmdx.PrintRow(cmd, (*outputTenant)(&tenants[0]))
+			} else if len(tenants) > 1 {
+				cmdx.PrintTable(cmd, &outputTenantCollection{tenants})
+			}
+
+			return nil
+		},
+	}
+}
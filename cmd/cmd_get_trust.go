@@ -0,0 +1,49 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+)
+
+func NewGetTrustCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "trust <id-1> [<id-2> ...]",
+		Aliases: []string{"trusts", "jwt-bearer-grant"},
+		Args:    cobra.MinimumNArgs(1),
+		Short:   "Get one or more trusted JWT-bearer grant issuers by their ID(s)",
+		Long:    `This command gets all the details about one or more trust relationships with JWT-bearer grant issuers.`,
+		Example: `{{ .CommandPath }} <id-1> <id-2>
+
+To get the scope the issuer may request, run:
+
+	{{ .CommandPath }} <id> --format json | jq -r '.scope[]'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			issuers := make([]hydra.TrustedOAuth2JwtGrantIssuer, 0, len(args))
+			for _, id := range args {
+				issuer, _, err := m.OAuth2API.GetTrustedOAuth2JwtGrantIssuer(cmd.Context(), id).Execute() //nolint:bodyclose
+				if err != nil {
+					return cmdx.PrintOpenAPIError(cmd, err)
+				}
+				issuers = append(issuers, *issuer)
+			}
+
+			if len(issuers) == 1 {
+				cmdx.PrintRow(cmd, (*outputTrustedIssuer)(&issuers[0]))
+			} else if len(issuers) > 1 {
+				cmdx.PrintTable(cmd, &outputTrustedIssuerCollection{issuers})
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/hydra/v2/cmd"
+	"github.com/ory/x/cmdx"
+)
+
+func TestGetTrust(t *testing.T) {
+	t.Parallel()
+
+	c := cmd.NewGetTrustCmd()
+	reg := setup(t, c)
+
+	expected := createTrust(t, reg, "https://jwt-idp.example.com")
+	t.Run("case=gets trust relationship", func(t *testing.T) {
+		actual := gjson.Parse(cmdx.ExecNoErr(t, c, expected.ID.String()))
+		assert.Equal(t, expected.ID.String(), actual.Get("id").String())
+		assert.Equal(t, expected.Issuer, actual.Get("issuer").String())
+		assert.Equal(t, expected.Subject, actual.Get("subject").String())
+		assert.Equal(t, expected.PublicKey.KeyID, actual.Get("public_key.kid").String())
+	})
+
+	t.Run("case=gets multiple trust relationships", func(t *testing.T) {
+		other := createTrust(t, reg, "https://other-idp.example.com")
+		actual := gjson.Parse(cmdx.ExecNoErr(t, c, expected.ID.String(), other.ID.String()))
+		assert.Len(t, actual.Array(), 2, actual.Raw)
+		assert.Equal(t, expected.ID.String(), actual.Get("0.id").String())
+		assert.Equal(t, other.ID.String(), actual.Get("1.id").String())
+	})
+
+	t.Run("case=fails for unknown trust relationship", func(t *testing.T) {
+		cmdx.ExecExpectedErr(t, c, "00000000-0000-0000-0000-000000000000")
+	})
+}
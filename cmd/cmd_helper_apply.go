@@ -0,0 +1,751 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/x/pointerx"
+)
+
+const (
+	applyKindClient        = "OAuth2Client"
+	applyKindJSONWebKeys   = "JsonWebKeySet"
+	applyKindTrustedIssuer = "TrustedJwtGrantIssuer"
+
+	applyActionCreate    = "create"
+	applyActionUpdate    = "update"
+	applyActionReplace   = "replace"
+	applyActionDelete    = "delete"
+	applyActionUnchanged = "unchanged"
+)
+
+type (
+	// applyManifest is a single document of a manifest file.
+	applyManifest struct {
+		Kind       string            `json:"kind"`
+		Labels     map[string]string `json:"labels"`
+		SecretFrom *applySecretRef   `json:"secret_from"`
+		Spec       json.RawMessage   `json:"spec"`
+	}
+	applySecretRef struct {
+		Env  string `json:"env"`
+		File string `json:"file"`
+	}
+
+	// applyState is the desired state read from the manifests.
+	applyState struct {
+		clients []applyClient
+		keySets []applyKeySet
+		trusts  []applyTrust
+	}
+	applyClient struct {
+		fields map[string]any
+		client hydra.OAuth2Client
+	}
+	applyKeySet struct {
+		set    string
+		labels map[string]string
+		keys   []applyKey
+	}
+	applyKey struct {
+		fields map[string]any
+		key    hydra.JsonWebKey
+	}
+	applyTrust struct {
+		jwk    map[string]any
+		labels map[string]string
+		body   hydra.TrustOAuth2JwtGrantIssuer
+	}
+
+	// applyChange is one step of the plan computed by applyPlanner.
+	applyChange struct {
+		Action  string   `json:"action"`
+		Kind    string   `json:"kind"`
+		ID      string   `json:"id"`
+		Changes []string `json:"changes,omitempty"`
+		Error   string   `json:"error,omitempty"`
+
+		apply func(ctx context.Context) error
+	}
+
+	applyPlanner struct {
+		m             *hydra.APIClient
+		prune         bool
+		selector      map[string]string
+		updateSecrets bool
+	}
+)
+
+// loadApplyManifests reads the manifests from the given files and
+// directories, where "-" reads from stdin.
+func loadApplyManifests(stdin io.Reader, paths []string) (*applyState, error) {
+	var files []string
+	for _, path := range paths {
+		if path == "-" {
+			files = append(files, path)
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, e := range entries {
+			switch strings.ToLower(filepath.Ext(e.Name())) {
+			case ".yaml", ".yml", ".json":
+				if !e.IsDir() {
+					files = append(files, filepath.Join(path, e.Name()))
+				}
+			}
+		}
+	}
+
+	state := &applyState{}
+	seen := make(map[string]string)
+	for _, file := range files {
+		var (
+			content []byte
+			err     error
+			dir     = filepath.Dir(file)
+		)
+		if file == "-" {
+			file, dir = "STDIN", "."
+			content, err = io.ReadAll(stdin)
+		} else {
+			content, err = os.ReadFile(file) // #nosec G304
+		}
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		dec := yaml.NewDecoder(bytes.NewReader(content), yaml.UseJSONUnmarshaler())
+		for i := 1; ; i++ {
+			var m applyManifest
+			if err := dec.Decode(&m); errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return nil, errors.Errorf("%s: %s", file, err)
+			}
+			if m.Kind == "" && len(m.Spec) == 0 {
+				continue // empty document
+			}
+
+			kind, id, err := state.add(m, dir)
+			if err != nil {
+				return nil, errors.Errorf("%s: document %d: %s", file, i, err)
+			}
+			if prev, ok := seen[kind+" "+id]; ok {
+				return nil, errors.Errorf("%s: document %d: %s %q is already defined in %s", file, i, kind, id, prev)
+			}
+			seen[kind+" "+id] = file
+		}
+	}
+	return state, nil
+}
+
+// add validates the manifest and adds it to the desired state.
+func (s *applyState) add(m applyManifest, dir string) (kind, id string, err error) {
+	if len(m.Spec) == 0 {
+		return "", "", errors.New("the spec is missing")
+	}
+
+	switch m.Kind {
+	case applyKindClient:
+		if m.Labels != nil {
+			return "", "", errors.Errorf("labels are not supported for kind %s, use the labels in the client metadata instead", m.Kind)
+		}
+		var c applyClient
+		if err := json.Unmarshal(m.Spec, &c.fields); err != nil {
+			return "", "", errors.WithStack(err)
+		}
+		if err := json.Unmarshal(m.Spec, &c.client); err != nil {
+			return "", "", errors.WithStack(err)
+		}
+		if pointerx.Deref(c.client.ClientId) == "" {
+			return "", "", errors.New("the spec must define the client_id")
+		}
+		if m.SecretFrom != nil {
+			secret, err := m.SecretFrom.resolve(dir)
+			if err != nil {
+				return "", "", err
+			}
+			secret = strings.TrimRight(secret, "\r\n")
+			c.client.ClientSecret = new(secret)
+			c.fields["client_secret"] = secret
+		}
+		s.clients = append(s.clients, c)
+		return m.Kind, *c.client.ClientId, nil
+
+	case applyKindJSONWebKeys:
+		var spec struct {
+			Set  string            `json:"set"`
+			Keys []json.RawMessage `json:"keys"`
+		}
+		if err := json.Unmarshal(m.Spec, &spec); err != nil {
+			return "", "", errors.WithStack(err)
+		}
+		if spec.Set == "" {
+			return "", "", errors.New("the spec must define the set")
+		}
+		if m.SecretFrom != nil {
+			content, err := m.SecretFrom.resolve(dir)
+			if err != nil {
+				return "", "", err
+			}
+			var keys struct {
+				Keys []json.RawMessage `json:"keys"`
+			}
+			if err := json.Unmarshal([]byte(content), &keys); err != nil {
+				return "", "", errors.Errorf("could not decode the JSON Web Key Set from secret_from: %s", err)
+			}
+			spec.Keys = append(spec.Keys, keys.Keys...)
+		}
+
+		ks := applyKeySet{set: spec.Set, labels: m.Labels}
+		kids := make(map[string]bool)
+		for _, raw := range spec.Keys {
+			var k applyKey
+			if err := json.Unmarshal(raw, &k.fields); err != nil {
+				return "", "", errors.WithStack(err)
+			}
+			if err := json.Unmarshal(raw, &k.key); err != nil {
+				return "", "", errors.WithStack(err)
+			}
+			if kids[k.key.Kid] {
+				return "", "", errors.Errorf("the key %q is defined more than once", k.key.Kid)
+			}
+			kids[k.key.Kid] = true
+			ks.keys = append(ks.keys, k)
+		}
+		s.keySets = append(s.keySets, ks)
+		return m.Kind, ks.set, nil
+
+	case applyKindTrustedIssuer:
+		if m.SecretFrom != nil {
+			return "", "", errors.Errorf("secret_from is not supported for kind %s", m.Kind)
+		}
+		t := applyTrust{labels: m.Labels}
+		if err := json.Unmarshal(m.Spec, &t.body); err != nil {
+			return "", "", errors.WithStack(err)
+		}
+		if raw := gjson.GetBytes(m.Spec, "jwk").Raw; raw != "" {
+			if err := json.Unmarshal([]byte(raw), &t.jwk); err != nil {
+				return "", "", errors.WithStack(err)
+			}
+		}
+		if (pointerx.Deref(t.body.Subject) == "") == !pointerx.Deref(t.body.AllowAnySubject) {
+			return "", "", errors.New("the spec must define either the subject or allow_any_subject")
+		}
+		s.trusts = append(s.trusts, t)
+		return m.Kind, t.id(), nil
+
+	case "":
+		return "", "", errors.New("the kind is missing")
+	default:
+		return "", "", errors.Errorf("unknown kind %q, expected one of %s, %s, %s", m.Kind, applyKindClient, applyKindJSONWebKeys, applyKindTrustedIssuer)
+	}
+}
+
+// resolve returns the secret referenced by the manifest.
+func (r *applySecretRef) resolve(dir string) (string, error) {
+	switch {
+	case r.Env != "" && r.File != "":
+		return "", errors.New("secret_from must define either env or file")
+	case r.Env != "":
+		v, ok := os.LookupEnv(r.Env)
+		if !ok {
+			return "", errors.Errorf("environment variable %s referenced by secret_from is not set", r.Env)
+		}
+		return v, nil
+	case r.File != "":
+		path := r.File
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		content, err := os.ReadFile(path) // #nosec G304
+		if err != nil {
+			return "", errors.Errorf("could not read file referenced by secret_from: %s", err)
+		}
+		return string(content), nil
+	default:
+		return "", errors.New("secret_from must define either env or file")
+	}
+}
+
+func (t applyTrust) id() string {
+	return trustIdentity(t.body.Issuer, pointerx.Deref(t.body.Subject), pointerx.Deref(t.body.AllowAnySubject), t.body.GetJwk().Kid)
+}
+
+// trustIdentity identifies a trust relationship. Relationships backed by a
+// remote JSON Web Key Set have no key ID, so their identity does not depend on
+// the URL of the key set, which may also be discovered by the server.
+func trustIdentity(issuer, subject string, allowAnySubject bool, kid string) string {
+	if allowAnySubject {
+		subject = "<any>"
+	}
+	if kid == "" {
+		return fmt.Sprintf("%s subject=%s jwks", issuer, subject)
+	}
+	return fmt.Sprintf("%s subject=%s kid=%s", issuer, subject, kid)
+}
+
+// plan compares the desired state with the Admin API. Changes to JSON Web Key
+// Sets come first, deletions last.
+func (p *applyPlanner) plan(ctx context.Context, desired *applyState) ([]applyChange, error) {
+	var plan, deletions []applyChange
+	for _, fn := range []func(context.Context, *applyState) ([]applyChange, error){
+		p.planKeySets,
+		p.planTrusts,
+		p.planClients,
+	} {
+		changes, err := fn(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range changes {
+			if c.Action == applyActionDelete {
+				deletions = append(deletions, c)
+			} else {
+				plan = append(plan, c)
+			}
+		}
+	}
+	return append(plan, deletions...), nil
+}
+
+func (p *applyPlanner) planClients(ctx context.Context, desired *applyState) ([]applyChange, error) {
+	var plan []applyChange
+	declared := make(map[string]bool)
+	for _, d := range desired.clients {
+		id := *d.client.ClientId
+		declared[id] = true
+
+		current, resp, err := p.m.OAuth2API.GetOAuth2Client(ctx, id).Execute() //nolint:bodyclose
+		if isNotFound(resp) {
+			client := d.client
+			plan = append(plan, applyChange{
+				Action: applyActionCreate, Kind: applyKindClient, ID: id,
+				apply: func(ctx context.Context) error {
+					_, _, err := p.m.OAuth2API.CreateOAuth2Client(ctx).OAuth2Client(client).Execute() //nolint:bodyclose
+					return applyError(err)
+				},
+			})
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		var fields map[string]any
+		if err := roundTripJSON(current, &fields); err != nil {
+			return nil, err
+		}
+
+		var patch []hydra.JsonPatch
+		var changed []string
+		for _, k := range sortedKeys(d.fields) {
+			switch {
+			case k == "client_id":
+				continue
+			case k == "client_secret" && !p.updateSecrets:
+				continue
+			case k != "client_secret" && jsonEqual(d.fields[k], fields[k]):
+				continue
+			}
+			patch = append(patch, hydra.JsonPatch{Op: "add", Path: "/" + k, Value: d.fields[k]})
+			changed = append(changed, k)
+		}
+
+		if len(patch) == 0 {
+			plan = append(plan, applyChange{Action: applyActionUnchanged, Kind: applyKindClient, ID: id})
+			continue
+		}
+		plan = append(plan, applyChange{
+			Action: applyActionUpdate, Kind: applyKindClient, ID: id, Changes: changed,
+			apply: func(ctx context.Context) error {
+				_, _, err := p.m.OAuth2API.PatchOAuth2Client(ctx, id).JsonPatch(patch).Execute() //nolint:bodyclose
+				return applyError(err)
+			},
+		})
+	}
+
+	if !p.prune {
+		return plan, nil
+	}
+
+	req := p.m.OAuth2API.ListOAuth2Clients(ctx)
+	if owner, ok := p.selector["owner"]; ok {
+		req = req.Owner(owner)
+	}
+	for pageToken := ""; ; {
+		clients, resp, err := req.PageToken(pageToken).Execute()
+		if err != nil {
+			return nil, err
+		}
+		_ = resp.Body.Close()
+
+		for _, c := range clients {
+			id := pointerx.Deref(c.ClientId)
+			if declared[id] || !p.matchesSelector(c) {
+				continue
+			}
+			plan = append(plan, applyChange{
+				Action: applyActionDelete, Kind: applyKindClient, ID: id,
+				apply: func(ctx context.Context) error {
+					_, err := p.m.OAuth2API.DeleteOAuth2Client(ctx, id).Execute() //nolint:bodyclose
+					return applyError(err)
+				},
+			})
+		}
+
+		if pageToken = getPageToken(resp); pageToken == "" {
+			return plan, nil
+		}
+	}
+}
+
+// matchesLabels returns true if the labels of a manifest contain all key/value
+// pairs of the selector. JSON Web Key Sets and trust relationships have no
+// owner or labels in the Admin API, so the labels of their manifests decide
+// whether they are pruned.
+func (p *applyPlanner) matchesLabels(labels map[string]string) bool {
+	for k, v := range p.selector {
+		if l, ok := labels[k]; !ok || l != v {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesSelector returns true if the client matches all key/value pairs of
+// the selector. The key "owner" matches the client's owner, all other keys
+// match the labels in the client's metadata.
+func (p *applyPlanner) matchesSelector(c hydra.OAuth2Client) bool {
+	labels := gjson.Get(mustJSONString(c.Metadata), "labels")
+	for k, v := range p.selector {
+		if k == "owner" {
+			if pointerx.Deref(c.Owner) != v {
+				return false
+			}
+			continue
+		}
+		if l := labels.Get(gjson.Escape(k)); l.Type != gjson.String || l.String() != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *applyPlanner) planKeySets(ctx context.Context, desired *applyState) ([]applyChange, error) {
+	var plan []applyChange
+	for _, d := range desired.keySets {
+		set := d.set
+
+		current := make(map[string]map[string]any)
+		keys, resp, err := p.m.JwkAPI.GetJsonWebKeySet(ctx, set).Execute() //nolint:bodyclose
+		if err != nil && !isNotFound(resp) {
+			return nil, err
+		}
+		if keys != nil {
+			for _, k := range keys.Keys {
+				var fields map[string]any
+				if err := roundTripJSON(k, &fields); err != nil {
+					return nil, err
+				}
+				current[k.Kid] = fields
+			}
+		}
+
+		var (
+			changed []string
+			steps   []func(ctx context.Context) error
+		)
+		for _, k := range d.keys {
+			key := k.key
+			if fields, ok := current[key.Kid]; ok && containsFields(fields, k.fields) {
+				delete(current, key.Kid)
+				continue
+			} else if ok {
+				changed = append(changed, "~"+key.Kid)
+			} else {
+				changed = append(changed, "+"+key.Kid)
+			}
+			delete(current, key.Kid)
+			steps = append(steps, func(ctx context.Context) error {
+				_, _, err := p.m.JwkAPI.SetJsonWebKey(ctx, set, key.Kid).JsonWebKey(key).Execute() //nolint:bodyclose
+				return applyError(err)
+			})
+		}
+		if p.prune && p.matchesLabels(d.labels) {
+			for _, kid := range sortedKeys(current) {
+				changed = append(changed, "-"+kid)
+				steps = append(steps, func(ctx context.Context) error {
+					_, err := p.m.JwkAPI.DeleteJsonWebKey(ctx, set, kid).Execute() //nolint:bodyclose
+					return applyError(err)
+				})
+			}
+		}
+
+		c := applyChange{Action: applyActionUpdate, Kind: applyKindJSONWebKeys, ID: set, Changes: changed}
+		switch {
+		case keys == nil || len(keys.Keys) == 0:
+			c.Action = applyActionCreate
+		case len(steps) == 0:
+			c.Action = applyActionUnchanged
+		}
+		if len(steps) > 0 {
+			c.apply = func(ctx context.Context) error {
+				for _, step := range steps {
+					if err := step(ctx); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+		}
+		plan = append(plan, c)
+	}
+	return plan, nil
+}
+
+func (p *applyPlanner) planTrusts(ctx context.Context, desired *applyState) ([]applyChange, error) {
+	var (
+		plan     []applyChange
+		issuers  []string
+		byIssuer = make(map[string][]applyTrust)
+	)
+	for _, d := range desired.trusts {
+		if _, ok := byIssuer[d.body.Issuer]; !ok {
+			issuers = append(issuers, d.body.Issuer)
+		}
+		byIssuer[d.body.Issuer] = append(byIssuer[d.body.Issuer], d)
+	}
+
+	for _, issuer := range issuers {
+		current := make(map[string]hydra.TrustedOAuth2JwtGrantIssuer)
+		var order []string
+		req := p.m.OAuth2API.ListTrustedOAuth2JwtGrantIssuers(ctx).Issuer(issuer)
+		for pageToken := ""; ; {
+			grants, resp, err := req.PageToken(pageToken).Execute()
+			if err != nil {
+				return nil, err
+			}
+			_ = resp.Body.Close()
+
+			for _, g := range grants {
+				var kid string
+				if g.PublicKey != nil && g.GetJwksUri() == "" {
+					kid = pointerx.Deref(g.PublicKey.Kid)
+				}
+				id := trustIdentity(issuer, pointerx.Deref(g.Subject), pointerx.Deref(g.AllowAnySubject), kid)
+				current[id] = g
+				order = append(order, id)
+			}
+			if pageToken = getPageToken(resp); pageToken == "" {
+				break
+			}
+		}
+
+		for _, d := range byIssuer[issuer] {
+			id, body := d.id(), d.body
+			create := func(ctx context.Context) error {
+				_, _, err := p.m.OAuth2API.TrustOAuth2JwtGrantIssuer(ctx).TrustOAuth2JwtGrantIssuer(body).Execute() //nolint:bodyclose
+				return applyError(err)
+			}
+
+			g, ok := current[id]
+			if !ok {
+				plan = append(plan, applyChange{Action: applyActionCreate, Kind: applyKindTrustedIssuer, ID: id, apply: create})
+				continue
+			}
+			delete(current, id)
+
+			changed, err := p.trustChanges(ctx, d, g)
+			if err != nil {
+				return nil, err
+			}
+			if len(changed) == 0 {
+				plan = append(plan, applyChange{Action: applyActionUnchanged, Kind: applyKindTrustedIssuer, ID: id})
+				continue
+			}
+
+			// The Admin API replaces the trust relationship in a single
+			// transaction, so the issuer stays trusted while it is replaced.
+			grantID := pointerx.Deref(g.Id)
+			plan = append(plan, applyChange{
+				Action: applyActionReplace, Kind: applyKindTrustedIssuer, ID: id, Changes: changed,
+				apply: func(ctx context.Context) error {
+					_, _, err := p.m.OAuth2API.ReplaceTrustedOAuth2JwtGrantIssuer(ctx, grantID).TrustOAuth2JwtGrantIssuer(body).Execute() //nolint:bodyclose
+					return applyError(err)
+				},
+			})
+		}
+
+		// An issuer is only pruned if all of its manifests match the selector.
+		if !p.prune || slices.ContainsFunc(byIssuer[issuer], func(d applyTrust) bool { return !p.matchesLabels(d.labels) }) {
+			continue
+		}
+		for _, id := range order {
+			g, ok := current[id]
+			if !ok {
+				continue
+			}
+			grantID := pointerx.Deref(g.Id)
+			plan = append(plan, applyChange{
+				Action: applyActionDelete, Kind: applyKindTrustedIssuer, ID: id,
+				apply: func(ctx context.Context) error {
+					_, err := p.m.OAuth2API.DeleteTrustedOAuth2JwtGrantIssuer(ctx, grantID).Execute() //nolint:bodyclose
+					return applyError(err)
+				},
+			})
+		}
+	}
+	return plan, nil
+}
+
+// trustChanges returns the fields in which the trust relationship differs
+// from the manifest.
+func (p *applyPlanner) trustChanges(ctx context.Context, d applyTrust, g hydra.TrustedOAuth2JwtGrantIssuer) ([]string, error) {
+	var changed []string
+
+	want, got := slices.Clone(d.body.Scope), slices.Clone(g.Scope)
+	slices.Sort(want)
+	slices.Sort(got)
+	if !slices.Equal(want, got) {
+		changed = append(changed, "scope")
+	}
+
+	if g.ExpiresAt == nil || !g.ExpiresAt.Equal(d.body.ExpiresAt.UTC().Round(time.Second)) {
+		changed = append(changed, "expires_at")
+	}
+
+	if !maps.EqualFunc(d.body.GetClaimConditions(), g.GetClaimConditions(), slices.Equal[[]string]) {
+		changed = append(changed, "claim_conditions")
+	}
+	if d.body.GetClaimsMapper() != g.GetClaimsMapper() {
+		changed = append(changed, "claims_mapper")
+	}
+
+	if g.GetJwksUri() != "" {
+		// Key sets discovered by the server can not be compared.
+		if uri := d.body.GetJwksUri(); uri != "" && uri != g.GetJwksUri() {
+			changed = append(changed, "jwks_uri")
+		}
+		return changed, nil
+	}
+
+	keys, _, err := p.m.JwkAPI.GetJsonWebKey(ctx, g.PublicKey.GetSet(), g.PublicKey.GetKid()).Execute() //nolint:bodyclose
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	if len(keys.Keys) > 0 {
+		if err := roundTripJSON(keys.Keys[0], &fields); err != nil {
+			return nil, err
+		}
+	}
+	if !containsFields(fields, d.jwk) {
+		changed = append(changed, "jwk")
+	}
+	return changed, nil
+}
+
+// containsFields returns true if all fields of want have the same value in
+// got.
+func containsFields(got, want map[string]any) bool {
+	for k, v := range want {
+		if !jsonEqual(got[k], v) {
+			return false
+		}
+	}
+	return true
+}
+
+// jsonEqual compares two decoded JSON values. Empty values are equal to
+// missing ones, because the API omits them.
+func jsonEqual(a, b any) bool {
+	isEmpty := func(v any) bool {
+		switch v := v.(type) {
+		case nil:
+			return true
+		case string:
+			return v == ""
+		case []any:
+			return len(v) == 0
+		case map[string]any:
+			return len(v) == 0
+		}
+		return false
+	}
+	if isEmpty(a) && isEmpty(b) {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func roundTripJSON(from, to any) error {
+	var b bytes.Buffer
+	if err := json.NewEncoder(&b).Encode(from); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(json.NewDecoder(&b).Decode(to))
+}
+
+func mustJSONString(v any) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func isNotFound(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
+
+// applyError returns the error message from the API response, if any.
+func applyError(err error) error {
+	var be interface{ Body() []byte }
+	if !errors.As(err, &be) {
+		return err
+	}
+	for _, path := range []string{"error.reason", "error.message", "error_description"} {
+		if msg := gjson.GetBytes(be.Body(), path).String(); msg != "" {
+			return errors.New(msg)
+		}
+	}
+	return err
+}
@@ -0,0 +1,39 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cli"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/x/configx"
+)
+
+func NewImportBundleCmd(dOpts []driver.OptionsModifier) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle [database_url] <file>",
+		Short: "Import a bundle created by hydra export",
+		Long: `This command imports a bundle created by "hydra export" directly into the database, in a single transaction.
+Use "-" as the file to read the bundle from STDIN.
+
+The private keys of the bundle are encrypted using the system secret of this deployment. All records are imported
+into the default network, or into the network of the tenant given by --tenant, regardless of the network they were
+exported from.
+
+Importing a bundle is idempotent. Clients, keys and subject obfuscation mappings which exist already are
+replaced, and trust relationships which exist already are kept.
+
+The database connection string (DSN) is read from the first argument, the DSN environment variable or the
+configuration file.`,
+		Example: `export BUNDLE_PASSPHRASE=...
+{{ .CommandPath }} --passphrase-env BUNDLE_PASSPHRASE -c config.yaml hydra.bundle`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: cli.NewHandler(dOpts).Bundle.Import,
+	}
+	cmd.Flags().String(cli.BundleTenant, "", "Import into the network of the tenant with this ID instead of the default network.")
+	cmd.Flags().String(cli.BundlePassphraseEnv, "", "The name of the environment variable containing the passphrase used to decrypt the bundle.")
+	configx.RegisterFlags(cmd.PersistentFlags())
+	return cmd
+}
@@ -59,7 +59,8 @@ The format for the JSON file is:
   }
 ]
 
-Please be aware that this command does not update existing clients. If the client exists already, this command will fail.`,
+Please be aware that this command does not update existing clients. If the client exists already, this command will fail.
+Use "hydra apply" to create or update clients from manifests instead.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			m, _, err := cliclient.NewClient(cmd)
 			if err != nil {
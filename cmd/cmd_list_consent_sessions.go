@@ -0,0 +1,79 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+)
+
+const (
+	flagConsentSubject        = "subject"
+	flagConsentClient         = "client"
+	flagConsentAll            = "all"
+	flagConsentRequestID      = "consent-request-id"
+	flagConsentLoginSessionID = "login-session-id"
+	flagLoginSessionID        = "sid"
+)
+
+func NewListConsentSessionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "consent-sessions",
+		Aliases: []string{"consent-session", "consents"},
+		Short:   "List the consent sessions of a subject",
+		Long:    `This command lists the consent sessions a subject granted to OAuth 2.0 Clients, which have not been revoked.`,
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("{{ .CommandPath }} --%s alice@example.com --%s 10", flagConsentSubject, cmdx.FlagPageSize),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			subject := flagx.MustGetString(cmd, flagConsentSubject)
+			if subject == "" {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s\n\nPlease provide the subject using flag --%s.\n", cmd.UsageString(), flagConsentSubject)
+				return cmdx.FailSilently(cmd)
+			}
+
+			pageToken, pageSize, err := cmdx.ParseTokenPaginationArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := m.OAuth2API.ListOAuth2ConsentSessions(cmd.Context()).Subject(subject).PageSize(int64(pageSize)).PageToken(pageToken)
+			if sid := flagx.MustGetString(cmd, flagConsentLoginSessionID); sid != "" {
+				req = req.LoginSessionId(sid)
+			}
+
+			// nolint:bodyclose
+			list, resp, err := req.Execute()
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+			defer resp.Body.Close() //nolint:errcheck
+
+			collection := outputConsentSessionCollection{sessions: list}
+			interfaceList := make([]interface{}, len(list))
+			for k := range list {
+				interfaceList[k] = interface{}(&list[k])
+			}
+
+			result := &cmdx.PaginatedList{Items: interfaceList, Collection: collection}
+			result.NextPageToken = getPageToken(resp)
+			result.IsLastPage = result.NextPageToken == ""
+			cmdx.PrintTable(cmd, result)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagConsentSubject, "", "The subject whose consent sessions are listed.")
+	cmd.Flags().String(flagConsentLoginSessionID, "", "Only list consent sessions granted during this login session.")
+	cmdx.RegisterTokenPaginationFlags(cmd)
+	return cmd
+}
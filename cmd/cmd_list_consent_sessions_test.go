@@ -0,0 +1,103 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/cmd"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/sqlxx"
+)
+
+func TestListConsentSessions(t *testing.T) {
+	t.Parallel()
+
+	_, admin, reg := setupRoutes(t, cmd.NewListConsentSessionsCmd())
+	// Flags keep their values between executions, so each case uses a new command.
+	newCmd := func(t *testing.T) *cobra.Command {
+		c := cmd.NewListConsentSessionsCmd()
+		cmdx.RegisterHTTPClientFlags(c.Flags())
+		cmdx.RegisterFormatFlags(c.Flags())
+		require.NoError(t, c.Flags().Set(cmdx.FlagFormat, string(cmdx.FormatJSON)))
+		require.NoError(t, c.Flags().Set(cmdx.FlagEndpoint, admin.URL))
+		return c
+	}
+
+	sid := createLoginSession(t, reg, "alice@example.com")
+	expected1 := createConsentSession(t, reg, createClient(t, reg, nil), "alice@example.com", sid)
+	expected2 := createConsentSession(t, reg, createClient(t, reg, nil), "alice@example.com", createLoginSession(t, reg, "alice@example.com"))
+	createConsentSession(t, reg, createClient(t, reg, nil), "bob@example.com", createLoginSession(t, reg, "bob@example.com"))
+
+	t.Run("case=lists the consent sessions of a subject", func(t *testing.T) {
+		actual := gjson.Parse(cmdx.ExecNoErr(t, newCmd(t), "--subject", "alice@example.com"))
+		assert.Len(t, actual.Get("items").Array(), 2, actual.Raw)
+
+		for _, f := range []*flow.Flow{expected1, expected2} {
+			assert.Contains(t, actual.Raw, f.ConsentRequestID.String())
+		}
+	})
+
+	t.Run("case=lists the consent sessions of a login session", func(t *testing.T) {
+		actual := gjson.Parse(cmdx.ExecNoErr(t, newCmd(t), "--subject", "alice@example.com", "--login-session-id", sid))
+		require.Len(t, actual.Get("items").Array(), 1, actual.Raw)
+		assert.Equal(t, expected1.ConsentRequestID.String(), actual.Get("items.0.consent_request_id").String())
+		assert.Equal(t, expected1.ClientID, actual.Get("items.0.consent_request.client.client_id").String())
+	})
+
+	t.Run("case=lists the consent sessions with pagination", func(t *testing.T) {
+		actualFirst := gjson.Parse(cmdx.ExecNoErr(t, newCmd(t), "--subject", "alice@example.com", "--page-size", "1"))
+		assert.Len(t, actualFirst.Get("items").Array(), 1)
+
+		require.NotEmpty(t, actualFirst.Get("next_page_token").String(), actualFirst.Raw)
+		assert.False(t, actualFirst.Get("is_last_page").Bool(), actualFirst.Raw)
+
+		actualSecond := gjson.Parse(cmdx.ExecNoErr(t, newCmd(t), "--subject", "alice@example.com", "--page-size", "1", "--page-token", actualFirst.Get("next_page_token").String()))
+		assert.Len(t, actualSecond.Get("items").Array(), 1)
+		assert.NotEqual(t, actualFirst.Get("items.0.consent_request_id").String(), actualSecond.Get("items.0.consent_request_id").String())
+	})
+
+	t.Run("case=requires the subject", func(t *testing.T) {
+		cmdx.ExecExpectedErr(t, newCmd(t))
+	})
+}
+
+func createLoginSession(t *testing.T, reg *driver.RegistrySQL, subject string) string {
+	s := &flow.LoginSession{
+		ID:              uuid.Must(uuid.NewV4()).String(),
+		Subject:         subject,
+		AuthenticatedAt: sqlxx.NullTime(time.Now().UTC()),
+		Remember:        true,
+	}
+	require.NoError(t, reg.LoginManager().ConfirmLoginSession(t.Context(), s))
+	return s.ID
+}
+
+func createConsentSession(t *testing.T, reg *driver.RegistrySQL, cl *client.Client, subject, sid string) *flow.Flow {
+	f := &flow.Flow{
+		ID:               uuid.Must(uuid.NewV4()).String(),
+		NID:              reg.Networker().NetworkID(t.Context()),
+		Client:           cl,
+		ClientID:         cl.GetID(),
+		Subject:          subject,
+		SessionID:        sqlxx.NullString(sid),
+		State:            flow.FlowStateConsentUsed,
+		ConsentRequestID: sqlxx.NullString(uuid.Must(uuid.NewV4()).String()),
+		ConsentRemember:  true,
+		GrantedScope:     []string{"openid"},
+		RequestedAt:      time.Now(),
+	}
+	require.NoError(t, reg.ConsentManager().CreateConsentSession(t.Context(), f))
+	return f
+}
@@ -0,0 +1,68 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+)
+
+func NewListDeviceRequestsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "device-requests",
+		Aliases: []string{"device-request"},
+		Short:   "List the pending device authorization requests of an OAuth 2.0 Client",
+		Long:    `This command lists the device authorization requests of an OAuth 2.0 Client whose user code is neither accepted, rejected, nor expired.`,
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("{{ .CommandPath }} --%s my-tv-app --%s 10", flagConsentClient, cmdx.FlagPageSize),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientID := flagx.MustGetString(cmd, flagConsentClient)
+			if clientID == "" {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s\n\nPlease provide the OAuth 2.0 Client ID using flag --%s.\n", cmd.UsageString(), flagConsentClient)
+				return cmdx.FailSilently(cmd)
+			}
+
+			pageToken, pageSize, err := cmdx.ParseTokenPaginationArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			// nolint:bodyclose
+			list, resp, err := m.OAuth2API.ListOAuth2PendingDeviceRequests(cmd.Context()).
+				ClientId(clientID).
+				PageSize(int64(pageSize)).
+				PageToken(pageToken).
+				Execute()
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+			defer resp.Body.Close() //nolint:errcheck
+
+			collection := outputDeviceRequestCollection{requests: list}
+			interfaceList := make([]interface{}, len(list))
+			for k := range list {
+				interfaceList[k] = interface{}(&list[k])
+			}
+
+			result := &cmdx.PaginatedList{Items: interfaceList, Collection: collection}
+			result.NextPageToken = getPageToken(resp)
+			result.IsLastPage = result.NextPageToken == ""
+			cmdx.PrintTable(cmd, result)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagConsentClient, "", "The OAuth 2.0 Client whose pending device authorization requests are listed.")
+	cmdx.RegisterTokenPaginationFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,36 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+)
+
+func NewListTenantsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "tenants",
+		Aliases: []string{"tenant"},
+		Short:   "List tenants",
+		Long:    `This command lists all tenants.`,
+		Args:    cobra.NoArgs,
+		Example: `{{ .CommandPath }} --format json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			list, _, err := m.TenantAPI.ListTenants(cmd.Context()).Execute() //nolint:bodyclose
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+
+			cmdx.PrintTable(cmd, &outputTenantCollection{list})
+			return nil
+		},
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+)
+
+func NewListTrustCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "trust",
+		Aliases: []string{"trusts", "jwt-bearer-grants"},
+		Short:   "List trusted JWT-bearer grant issuers",
+		Long:    `This command lists the trust relationships with JWT-bearer grant issuers.`,
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("{{ .CommandPath }} --%s https://jwt-idp.example.com --%s 10", flagTrustIssuer, cmdx.FlagPageSize),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageToken, pageSize, err := cmdx.ParseTokenPaginationArgs(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := m.OAuth2API.ListTrustedOAuth2JwtGrantIssuers(cmd.Context()).PageSize(int64(pageSize)).PageToken(pageToken)
+			if issuer := flagx.MustGetString(cmd, flagTrustIssuer); issuer != "" {
+				req = req.Issuer(issuer)
+			}
+
+			// nolint:bodyclose
+			list, resp, err := req.Execute()
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+			defer resp.Body.Close() //nolint:errcheck
+
+			collection := outputTrustedIssuerCollection{issuers: list}
+			interfaceList := make([]interface{}, len(list))
+			for k := range list {
+				interfaceList[k] = interface{}(&list[k])
+			}
+
+			result := &cmdx.PaginatedList{Items: interfaceList, Collection: collection}
+			result.NextPageToken = getPageToken(resp)
+			result.IsLastPage = result.NextPageToken == ""
+			cmdx.PrintTable(cmd, result)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagTrustIssuer, "", "Only list trust relationships with this issuer.")
+	cmdx.RegisterTokenPaginationFlags(cmd)
+	return cmd
+}
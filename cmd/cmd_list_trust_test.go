@@ -0,0 +1,54 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/hydra/v2/cmd"
+	"github.com/ory/x/cmdx"
+)
+
+func TestListTrust(t *testing.T) {
+	t.Parallel()
+
+	c := cmd.NewListTrustCmd()
+	reg := setup(t, c)
+
+	expected1 := createTrust(t, reg, "https://jwt-idp.example.com")
+	expected2 := createTrust(t, reg, "https://other-idp.example.com")
+
+	t.Run("case=lists both trust relationships", func(t *testing.T) {
+		actual := gjson.Parse(cmdx.ExecNoErr(t, c))
+		assert.Len(t, actual.Get("items").Array(), 2)
+
+		for _, id := range []string{expected1.ID.String(), expected2.ID.String()} {
+			assert.Contains(t, actual.Raw, id)
+		}
+	})
+
+	t.Run("case=lists trust relationships of one issuer", func(t *testing.T) {
+		actual := gjson.Parse(cmdx.ExecNoErr(t, c, "--issuer", expected2.Issuer))
+		require.Len(t, actual.Get("items").Array(), 1, actual.Raw)
+		assert.Equal(t, expected2.ID.String(), actual.Get("items.0.id").String())
+	})
+
+	t.Run("case=lists both trust relationships with pagination", func(t *testing.T) {
+		actualFirst := gjson.Parse(cmdx.ExecNoErr(t, c, "--issuer", "", "--page-size", "1"))
+		assert.Len(t, actualFirst.Get("items").Array(), 1)
+
+		require.NotEmpty(t, actualFirst.Get("next_page_token").String(), actualFirst.Raw)
+		assert.False(t, actualFirst.Get("is_last_page").Bool(), actualFirst.Raw)
+
+		actualSecond := gjson.Parse(cmdx.ExecNoErr(t, c, "--issuer", "", "--page-size", "1", "--page-token", actualFirst.Get("next_page_token").String()))
+		assert.Len(t, actualSecond.Get("items").Array(), 1)
+
+		assert.NotEmpty(t, actualFirst.Get("items.0.id").String())
+		assert.NotEqual(t, actualFirst.Get("items.0.id").String(), actualSecond.Get("items.0.id").String())
+	})
+}
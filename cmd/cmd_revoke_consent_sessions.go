@@ -0,0 +1,72 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+)
+
+func NewRevokeConsentSessionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "consent-sessions",
+		Aliases: []string{"consent-session", "consents"},
+		Args:    cobra.NoArgs,
+		Short:   "Revoke consent sessions",
+		Long: `This command revokes consent sessions of a subject, either those granted to one OAuth 2.0 Client or those granted
+to all OAuth 2.0 Clients, or a single consent session by its consent request ID. The access and refresh tokens
+issued in the revoked consent sessions are revoked as well.`,
+		Example: `{{ .CommandPath }} --subject alice@example.com --client my-client
+{{ .CommandPath }} --subject alice@example.com --all
+{{ .CommandPath }} --consent-request-id 7b1c9c3f-7a0e-4b1c-8d0e-0f0a2e1f6a7d`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			var (
+				subject   = flagx.MustGetString(cmd, flagConsentSubject)
+				client    = flagx.MustGetString(cmd, flagConsentClient)
+				all       = flagx.MustGetBool(cmd, flagConsentAll)
+				requestID = flagx.MustGetString(cmd, flagConsentRequestID)
+			)
+
+			req := m.OAuth2API.RevokeOAuth2ConsentSessions(cmd.Context())
+			var revoked string
+			switch {
+			case requestID != "" && subject == "" && client == "" && !all:
+				req, revoked = req.ConsentRequestId(requestID), requestID
+			case requestID == "" && subject != "" && (client != "") != all:
+				req, revoked = req.Subject(subject), subject
+				if all {
+					req = req.All(true)
+				} else {
+					req = req.Client(client)
+				}
+			default:
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s\n\nPlease provide either flag --%s together with one of --%s or --%s, or only flag --%s.\n",
+					cmd.UsageString(), flagConsentSubject, flagConsentClient, flagConsentAll, flagConsentRequestID)
+				return cmdx.FailSilently(cmd)
+			}
+
+			if _, err := req.Execute(); err != nil { //nolint:bodyclose
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+
+			cmdx.PrintRow(cmd, cmdx.OutputIder(revoked))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagConsentSubject, "", "The subject whose consent sessions are revoked.")
+	cmd.Flags().String(flagConsentClient, "", "Only revoke the consent sessions granted to this OAuth 2.0 Client.")
+	cmd.Flags().Bool(flagConsentAll, false, "Revoke the consent sessions granted to all OAuth 2.0 Clients.")
+	cmd.Flags().String(flagConsentRequestID, "", "Revoke the consent session with this consent request ID.")
+	return cmd
+}
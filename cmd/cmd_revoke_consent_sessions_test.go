@@ -0,0 +1,75 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/cmd"
+	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/x/cmdx"
+)
+
+func TestRevokeConsentSessions(t *testing.T) {
+	t.Parallel()
+
+	_, admin, reg := setupRoutes(t, cmd.NewRevokeConsentSessionsCmd())
+	// Flags keep their values between executions, so each case uses a new command.
+	newCmd := func(t *testing.T) *cobra.Command {
+		c := cmd.NewRevokeConsentSessionsCmd()
+		cmdx.RegisterHTTPClientFlags(c.Flags())
+		cmdx.RegisterFormatFlags(c.Flags())
+		require.NoError(t, c.Flags().Set(cmdx.FlagFormat, string(cmdx.FormatJSON)))
+		require.NoError(t, c.Flags().Set(cmdx.FlagEndpoint, admin.URL))
+		return c
+	}
+
+	t.Run("case=revokes the consent sessions granted to a client", func(t *testing.T) {
+		cl := createClient(t, reg, nil)
+		createConsentSession(t, reg, cl, "alice@example.com", createLoginSession(t, reg, "alice@example.com"))
+		other := createConsentSession(t, reg, createClient(t, reg, nil), "alice@example.com", createLoginSession(t, reg, "alice@example.com"))
+
+		stdout := cmdx.ExecNoErr(t, newCmd(t), "--subject", "alice@example.com", "--client", cl.GetID())
+		assert.Equal(t, `"alice@example.com"`, strings.TrimSpace(stdout))
+
+		actual, _, err := reg.ConsentManager().FindSubjectsGrantedConsentRequests(t.Context(), "alice@example.com")
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+		assert.Equal(t, other.ConsentRequestID, actual[0].ConsentRequestID)
+	})
+
+	t.Run("case=revokes the consent sessions granted to all clients", func(t *testing.T) {
+		createConsentSession(t, reg, createClient(t, reg, nil), "bob@example.com", createLoginSession(t, reg, "bob@example.com"))
+		createConsentSession(t, reg, createClient(t, reg, nil), "bob@example.com", createLoginSession(t, reg, "bob@example.com"))
+
+		stdout := cmdx.ExecNoErr(t, newCmd(t), "--subject", "bob@example.com", "--all")
+		assert.Equal(t, `"bob@example.com"`, strings.TrimSpace(stdout))
+
+		_, _, err := reg.ConsentManager().FindSubjectsGrantedConsentRequests(t.Context(), "bob@example.com")
+		assert.ErrorIs(t, err, consent.ErrNoPreviousConsentFound)
+	})
+
+	t.Run("case=revokes a consent session by its consent request ID", func(t *testing.T) {
+		f := createConsentSession(t, reg, createClient(t, reg, nil), "carol@example.com", createLoginSession(t, reg, "carol@example.com"))
+
+		stdout := cmdx.ExecNoErr(t, newCmd(t), "--consent-request-id", f.ConsentRequestID.String())
+		assert.Equal(t, fmt.Sprintf(`"%s"`, f.ConsentRequestID), strings.TrimSpace(stdout))
+
+		_, _, err := reg.ConsentManager().FindSubjectsGrantedConsentRequests(t.Context(), "carol@example.com")
+		assert.ErrorIs(t, err, consent.ErrNoPreviousConsentFound)
+	})
+
+	t.Run("case=requires a valid combination of flags", func(t *testing.T) {
+		cmdx.ExecExpectedErr(t, newCmd(t))
+		cmdx.ExecExpectedErr(t, newCmd(t), "--subject", "alice@example.com")
+		cmdx.ExecExpectedErr(t, newCmd(t), "--subject", "alice@example.com", "--client", "my-client", "--all")
+		cmdx.ExecExpectedErr(t, newCmd(t), "--subject", "alice@example.com", "--consent-request-id", "7b1c9c3f-7a0e-4b1c-8d0e-0f0a2e1f6a7d")
+	})
+}
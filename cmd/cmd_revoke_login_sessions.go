@@ -0,0 +1,56 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+)
+
+func NewRevokeLoginSessionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "login-sessions",
+		Aliases: []string{"login-session"},
+		Args:    cobra.NoArgs,
+		Short:   "Revoke login sessions",
+		Long: `This command revokes all login sessions of a subject, or a single login session by its ID. Subjects have to log
+in again afterwards. If a single login session is revoked, OpenID Connect Back-Channel Logout is performed for it.`,
+		Example: `{{ .CommandPath }} --subject alice@example.com
+{{ .CommandPath }} --sid 2d0b4a9e-3b55-4c7e-9f7a-4f0e3c6a9b1d`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			subject, sid := flagx.MustGetString(cmd, flagConsentSubject), flagx.MustGetString(cmd, flagLoginSessionID)
+			if (subject == "") == (sid == "") {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "%s\n\nPlease provide either flag --%s or flag --%s.\n", cmd.UsageString(), flagConsentSubject, flagLoginSessionID)
+				return cmdx.FailSilently(cmd)
+			}
+
+			req, revoked := m.OAuth2API.RevokeOAuth2LoginSessions(cmd.Context()), subject
+			if sid != "" {
+				req, revoked = req.Sid(sid), sid
+			} else {
+				req = req.Subject(subject)
+			}
+
+			if _, err := req.Execute(); err != nil { //nolint:bodyclose
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+
+			cmdx.PrintRow(cmd, cmdx.OutputIder(revoked))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagConsentSubject, "", "The subject whose login sessions are revoked.")
+	cmd.Flags().String(flagLoginSessionID, "", "The ID of the login session to revoke.")
+	return cmd
+}
@@ -0,0 +1,67 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/cmd"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/cmdx"
+)
+
+func TestRevokeLoginSessions(t *testing.T) {
+	t.Parallel()
+
+	_, admin, reg := setupRoutes(t, cmd.NewRevokeLoginSessionsCmd())
+	// Flags keep their values between executions, so each case uses a new command.
+	newCmd := func(t *testing.T) *cobra.Command {
+		c := cmd.NewRevokeLoginSessionsCmd()
+		cmdx.RegisterHTTPClientFlags(c.Flags())
+		cmdx.RegisterFormatFlags(c.Flags())
+		require.NoError(t, c.Flags().Set(cmdx.FlagFormat, string(cmdx.FormatJSON)))
+		require.NoError(t, c.Flags().Set(cmdx.FlagEndpoint, admin.URL))
+		return c
+	}
+
+	t.Run("case=revokes the login sessions of a subject", func(t *testing.T) {
+		sid1 := createLoginSession(t, reg, "alice@example.com")
+		sid2 := createLoginSession(t, reg, "alice@example.com")
+		other := createLoginSession(t, reg, "bob@example.com")
+
+		stdout := cmdx.ExecNoErr(t, newCmd(t), "--subject", "alice@example.com")
+		assert.Equal(t, `"alice@example.com"`, strings.TrimSpace(stdout))
+
+		for _, sid := range []string{sid1, sid2} {
+			_, err := reg.LoginManager().GetRememberedLoginSession(t.Context(), sid)
+			assert.ErrorIs(t, err, x.ErrNotFound)
+		}
+		_, err := reg.LoginManager().GetRememberedLoginSession(t.Context(), other)
+		assert.NoError(t, err)
+	})
+
+	t.Run("case=revokes a login session by its ID", func(t *testing.T) {
+		sid := createLoginSession(t, reg, "carol@example.com")
+		other := createLoginSession(t, reg, "carol@example.com")
+
+		stdout := cmdx.ExecNoErr(t, newCmd(t), "--sid", sid)
+		assert.Equal(t, fmt.Sprintf(`"%s"`, sid), strings.TrimSpace(stdout))
+
+		_, err := reg.LoginManager().GetRememberedLoginSession(t.Context(), sid)
+		assert.ErrorIs(t, err, x.ErrNotFound)
+		_, err = reg.LoginManager().GetRememberedLoginSession(t.Context(), other)
+		assert.NoError(t, err)
+	})
+
+	t.Run("case=requires either the subject or the login session ID", func(t *testing.T) {
+		cmdx.ExecExpectedErr(t, newCmd(t))
+		cmdx.ExecExpectedErr(t, newCmd(t), "--subject", "alice@example.com", "--sid", "2d0b4a9e-3b55-4c7e-9f7a-4f0e3c6a9b1d")
+	})
+}
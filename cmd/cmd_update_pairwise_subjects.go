@@ -0,0 +1,68 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+	"github.com/ory/x/flagx"
+)
+
+const (
+	flagPairwisePreviousSubjectType = "previous-subject-type"
+	flagPairwisePreviousSector      = "previous-sector"
+	flagPairwisePreviousSalt        = "previous-salt"
+	flagPairwiseKeepPrevious        = "keep-previous"
+	flagPairwiseDryRun              = "dry-run"
+)
+
+func NewUpdatePairwiseSubjectsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pairwise-subjects <client-id>",
+		Aliases: []string{"pairwise-subject"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Migrate the pairwise subject identifiers of an OAuth 2.0 Client",
+		Long: `This command migrates the pairwise subject identifiers of an OAuth 2.0 Client after its subject type, sector
+identifier URI or redirect URIs changed, or after the pairwise salt was rotated.
+
+Describe how the identifiers were computed before the change with the flags. Per default, the client is issued the
+identifiers computed from its current configuration from then on, and the previous identifiers are recorded so they
+can still be resolved. Use "--keep-previous" to keep issuing the previous identifiers instead, and "--dry-run" to
+review the mapping first.`,
+		Example: `{{ .CommandPath }} my-client --previous-sector old.example.org --dry-run
+{{ .CommandPath }} my-client --previous-subject-type public --keep-previous
+{{ .CommandPath }} my-client --previous-salt --keep-previous`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			migration := hydra.PairwiseSubjectMigration{
+				ClientId:                 args[0],
+				PreviousSubjectType:      new(flagx.MustGetString(cmd, flagPairwisePreviousSubjectType)),
+				PreviousSectorIdentifier: new(flagx.MustGetString(cmd, flagPairwisePreviousSector)),
+				UsePreviousSalt:          new(flagx.MustGetBool(cmd, flagPairwisePreviousSalt)),
+				KeepPreviousIdentifiers:  new(flagx.MustGetBool(cmd, flagPairwiseKeepPrevious)),
+				DryRun:                   new(flagx.MustGetBool(cmd, flagPairwiseDryRun)),
+			}
+			mapping, _, err := m.OAuth2API.MigrateOAuth2PairwiseSubjects(cmd.Context()).PairwiseSubjectMigration(migration).Execute() //nolint:bodyclose
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+
+			cmdx.PrintTable(cmd, &outputPairwiseSubjectCollection{subjects: mapping})
+			return nil
+		},
+	}
+	cmd.Flags().String(flagPairwisePreviousSubjectType, "pairwise", `The subject type the client used before: "public" or "pairwise".`)
+	cmd.Flags().String(flagPairwisePreviousSector, "", "The sector identifier the client used before. Defaults to the current sector identifier.")
+	cmd.Flags().Bool(flagPairwisePreviousSalt, false, "Compute the previous identifiers with the most recent previous salt.")
+	cmd.Flags().Bool(flagPairwiseKeepPrevious, false, "Keep issuing the previous identifiers to the client.")
+	cmd.Flags().Bool(flagPairwiseDryRun, false, "Print the mapping without persisting it.")
+	return cmd
+}
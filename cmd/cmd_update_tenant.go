@@ -0,0 +1,46 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cliclient"
+	"github.com/ory/x/cmdx"
+)
+
+func NewUpdateTenantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tenant <id>",
+		Aliases: []string{"tenants"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Update a tenant",
+		Long: `This command updates a tenant by its ID. Only the fields provided as flags are changed; all other fields keep their current values.
+
+The --config flag replaces all configuration overrides of the tenant.`,
+		Example: `{{ .CommandPath }} <tenant-id> --host auth.acme.com --host login.acme.com`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, _, err := cliclient.NewClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			t, _, err := m.TenantAPI.GetTenant(cmd.Context(), args[0]).Execute() //nolint:bodyclose
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+			applyTenantFlags(cmd, t)
+
+			updated, _, err := m.TenantAPI.SetTenant(cmd.Context(), args[0]).Tenant(*t).Execute() //nolint:bodyclose
+			if err != nil {
+				return cmdx.PrintOpenAPIError(cmd, err)
+			}
+
+			cmdx.PrintRow(cmd, (*outputTenant)(updated))
+			return nil
+		},
+	}
+	registerTenantFlags(cmd)
+	return cmd
+}
@@ -0,0 +1,49 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cli"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/x/configx"
+)
+
+func NewExportCmd(dOpts []driver.OptionsModifier) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [database_url]",
+		Short: "Export clients, keys, trust relationships and subject obfuscation mappings into a bundle",
+		Long: `This command exports the configuration state of a network directly from the database into a bundle, which
+can be imported into another deployment or network using "hydra import bundle". This is useful for region
+migrations and disaster recovery drills.
+
+The bundle contains:
+
+- all OAuth 2.0 Clients, including their hashed secrets and rotated secrets,
+- all JSON Web Key Sets stored in the database, including their private keys,
+- all trust relationships with JWT-bearer grant issuers,
+- all mappings of subjects to pairwise subject identifiers.
+
+The private keys are decrypted using the system secret of this deployment. Because the bundle contains them in
+plain text, it should be encrypted by providing a passphrase in the environment variable named by flag
+--passphrase-env. Keys stored in a hardware security module are not exported.
+
+The bundle is a versioned and gzip compressed JSON document. It does not contain the ID of the network it was
+exported from, so it can be imported into any network. Use --tenant to export the network of a tenant instead of
+the default network.
+
+The database connection string (DSN) is read from the first argument, the DSN environment variable or the
+configuration file.`,
+		Example: `export BUNDLE_PASSPHRASE=...
+{{ .CommandPath }} --passphrase-env BUNDLE_PASSPHRASE -o hydra.bundle -c config.yaml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: cli.NewHandler(dOpts).Bundle.Export,
+	}
+	cmd.Flags().StringP(cli.BundleOutput, "o", "", "The file to write the bundle to. Writes to STDOUT if not set.")
+	cmd.Flags().String(cli.BundleTenant, "", "Export the network of the tenant with this ID instead of the default network.")
+	cmd.Flags().String(cli.BundlePassphraseEnv, "", "The name of the environment variable containing the passphrase used to encrypt the bundle.")
+	configx.RegisterFlags(cmd.PersistentFlags())
+	return cmd
+}
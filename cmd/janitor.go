@@ -59,6 +59,12 @@ Janitor can be used in several ways.
    or any combination of them
 
 		hydra janitor --tokens --requests --grants {database-url}
+
+If the token and flow tables were partitioned using "hydra migrate partitions", the token and request
+cleanups also create upcoming partitions and drop partitions whose rows are all expired.
+
+Instead of running this command separately, the janitor can also run as part of "hydra serve all"
+and "hydra serve admin" by setting "janitor.enabled" in the configuration file.
 `,
 		RunE: cli.NewHandler(dOpts).Janitor.RunE,
 		Args: cli.NewHandler(dOpts).Janitor.Args,
@@ -0,0 +1,48 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ory/hydra/v2/cmd/cli"
+	"github.com/ory/hydra/v2/driver"
+)
+
+func NewMigratePartitionsCmd(dOpts []driver.OptionsModifier) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "partitions [database_url]",
+		Short: "Convert the token and flow tables to the partitioned storage layout",
+		Long: `This command converts the tables storing access tokens, refresh tokens, authorization codes, OpenID Connect
+and PKCE sessions, and the login and consent flows to a storage layout which makes removing expired rows cheap.
+
+On PostgreSQL, the token tables are range-partitioned by the time the token was requested. The existing rows are kept
+in a legacy partition, and new partitions are created ahead of time. Each run of "hydra janitor --tokens" creates
+upcoming partitions and drops those partitions whose tokens are all expired, instead of deleting them row by row. Make
+sure to run the janitor at least once a week, otherwise new tokens are stored in a default partition.
+
+The flow table is split into remembered consent sessions, which are kept until they are revoked, and all other flows,
+which are range-partitioned like the tokens. A flow is dropped with its partition once all tokens issued for it
+expired, by each run of "hydra janitor --requests". The token tables no longer reference the flow table.
+
+On CockroachDB, which can not drop partitions, the tables use row-level TTL instead. Each run of the janitor applies
+changes of the token lifespans to the TTL.
+
+The conversion locks the tables while it runs and builds a new primary key for the existing rows. Run it during a
+maintenance window.
+
+### WARNING ###
+
+Before running this command on an existing database, create a back up!`,
+		Example: `hydra migrate partitions --interval 24h -e`,
+		RunE:    cli.NewHandler(dOpts).Migration.MigratePartitions,
+	}
+
+	cmd.Flags().Duration("interval", 24*time.Hour, "The time range covered by each partition. Must be a multiple of one hour.")
+	cmd.Flags().BoolP("yes", "y", false, "If set all confirmation requests are accepted without user interaction.")
+	cmd.Flags().BoolP("read-from-env", "e", false, "If set, reads the database connection string from the environment variable DSN or config file key dsn.")
+	return cmd
+}
@@ -0,0 +1,32 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"strings"
+)
+
+type outputApplyPlan struct {
+	changes []applyChange
+}
+
+func (outputApplyPlan) Header() []string {
+	return []string{"ACTION", "KIND", "ID", "CHANGES", "ERROR"}
+}
+
+func (p outputApplyPlan) Table() [][]string {
+	rows := make([][]string, len(p.changes))
+	for i, c := range p.changes {
+		rows[i] = []string{c.Action, c.Kind, c.ID, strings.Join(c.Changes, ", "), c.Error}
+	}
+	return rows
+}
+
+func (p outputApplyPlan) Interface() interface{} {
+	return p.changes
+}
+
+func (p outputApplyPlan) Len() int {
+	return len(p.changes)
+}
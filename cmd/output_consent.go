@@ -0,0 +1,271 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ory/x/pointerx"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+)
+
+type (
+	outputConsentSession           hydra.OAuth2ConsentSession
+	outputConsentSessionCollection struct {
+		sessions []hydra.OAuth2ConsentSession
+	}
+	outputLoginRequest              hydra.OAuth2LoginRequest
+	outputConsentRequest            hydra.OAuth2ConsentRequest
+	outputSubjectErasureReport      hydra.SignedSubjectErasureReport
+	outputPairwiseSubject           hydra.PairwiseSubject
+	outputPairwiseSubjectCollection struct {
+		subjects []hydra.PairwiseSubject
+	}
+	outputDeviceRequest           hydra.OAuth2DeviceRequest
+	outputDeviceRequestCollection struct {
+		requests []hydra.OAuth2DeviceRequest
+	}
+)
+
+func (outputConsentSession) Header() []string {
+	return []string{"CONSENT REQUEST ID", "CLIENT ID", "SUBJECT", "GRANTED SCOPE", "GRANTED AUDIENCE", "REMEMBER", "HANDLED AT"}
+}
+
+func (i outputConsentSession) Columns() []string {
+	var clientID, subject, handledAt string
+	if r := i.ConsentRequest; r != nil {
+		subject = pointerx.Deref(r.Subject)
+		if r.Client != nil {
+			clientID = pointerx.Deref(r.Client.ClientId)
+		}
+	}
+	if i.HandledAt != nil {
+		handledAt = i.HandledAt.Format(time.RFC3339)
+	}
+	data := [7]string{
+		pointerx.Deref(i.ConsentRequestId),
+		clientID,
+		subject,
+		strings.Join(i.GrantScope, " "),
+		strings.Join(i.GrantAccessTokenAudience, ", "),
+		fmt.Sprintf("%v", pointerx.Deref(i.Remember)),
+		handledAt,
+	}
+	return data[:]
+}
+
+func (i outputConsentSession) Interface() interface{} {
+	return i
+}
+
+func (outputConsentSessionCollection) Header() []string {
+	return outputConsentSession{}.Header()
+}
+
+func (c outputConsentSessionCollection) Table() [][]string {
+	rows := make([][]string, len(c.sessions))
+	for i, session := range c.sessions {
+		rows[i] = outputConsentSession(session).Columns()
+	}
+	return rows
+}
+
+func (c outputConsentSessionCollection) Interface() interface{} {
+	return c.sessions
+}
+
+func (c outputConsentSessionCollection) Len() int {
+	return len(c.sessions)
+}
+
+func (c outputConsentSessionCollection) IDs() []string {
+	ids := make([]string, len(c.sessions))
+	for i, session := range c.sessions {
+		ids[i] = pointerx.Deref(session.ConsentRequestId)
+	}
+	return ids
+}
+
+func (outputLoginRequest) Header() []string {
+	return []string{"CHALLENGE", "CLIENT ID", "SUBJECT", "SKIP", "REQUESTED SCOPE", "SESSION ID", "REQUEST URL"}
+}
+
+func (i outputLoginRequest) Columns() []string {
+	data := [7]string{
+		i.Challenge,
+		pointerx.Deref(i.Client.ClientId),
+		i.Subject,
+		fmt.Sprintf("%v", i.Skip),
+		strings.Join(i.RequestedScope, " "),
+		pointerx.Deref(i.SessionId),
+		i.RequestUrl,
+	}
+	return data[:]
+}
+
+func (i outputLoginRequest) Interface() interface{} {
+	return i
+}
+
+func (outputConsentRequest) Header() []string {
+	return []string{"CHALLENGE", "CLIENT ID", "SUBJECT", "SKIP", "REQUESTED SCOPE", "LOGIN SESSION ID", "REQUEST URL"}
+}
+
+func (i outputConsentRequest) Columns() []string {
+	var clientID string
+	if i.Client != nil {
+		clientID = pointerx.Deref(i.Client.ClientId)
+	}
+	data := [7]string{
+		i.Challenge,
+		clientID,
+		pointerx.Deref(i.Subject),
+		fmt.Sprintf("%v", pointerx.Deref(i.Skip)),
+		strings.Join(i.RequestedScope, " "),
+		pointerx.Deref(i.LoginSessionId),
+		pointerx.Deref(i.RequestUrl),
+	}
+	return data[:]
+}
+
+func (i outputConsentRequest) Interface() interface{} {
+	return i
+}
+
+func (outputSubjectErasureReport) Header() []string {
+	return []string{"ERASURE ID", "SUBJECT HMAC", "ERASED AT", "DELETED ROWS", "LOGGED OUT SESSIONS", "BACK-CHANNEL LOGOUT CLIENTS"}
+}
+
+func (i outputSubjectErasureReport) Columns() []string {
+	r := pointerx.Deref(i.Report)
+
+	var deleted int64
+	for _, n := range r.GetDeletedRows() {
+		deleted += n
+	}
+	var erasedAt string
+	if r.ErasedAt != nil {
+		erasedAt = r.ErasedAt.Format(time.RFC3339)
+	}
+	data := [6]string{
+		r.GetId(),
+		r.GetSubjectHmac(),
+		erasedAt,
+		fmt.Sprintf("%d", deleted),
+		fmt.Sprintf("%d", r.GetLoggedOutSessions()),
+		strings.Join(r.BackChannelLogoutClients, ", "),
+	}
+	return data[:]
+}
+
+func (i outputSubjectErasureReport) Interface() interface{} {
+	return i
+}
+
+func (outputPairwiseSubject) Header() []string {
+	return []string{"CLIENT ID", "SUBJECT", "PAIRWISE SUBJECT", "PREVIOUS PAIRWISE SUBJECT", "SECTOR IDENTIFIER"}
+}
+
+func (i outputPairwiseSubject) Columns() []string {
+	data := [5]string{
+		pointerx.Deref(i.ClientId),
+		pointerx.Deref(i.Subject),
+		pointerx.Deref(i.PairwiseSubject),
+		pointerx.Deref(i.PreviousPairwiseSubject),
+		pointerx.Deref(i.SectorIdentifier),
+	}
+	return data[:]
+}
+
+func (i outputPairwiseSubject) Interface() interface{} {
+	return i
+}
+
+func (outputPairwiseSubjectCollection) Header() []string {
+	return outputPairwiseSubject{}.Header()
+}
+
+func (c outputPairwiseSubjectCollection) Table() [][]string {
+	rows := make([][]string, len(c.subjects))
+	for i, subject := range c.subjects {
+		rows[i] = outputPairwiseSubject(subject).Columns()
+	}
+	return rows
+}
+
+func (c outputPairwiseSubjectCollection) Interface() interface{} {
+	return c.subjects
+}
+
+func (c outputPairwiseSubjectCollection) Len() int {
+	return len(c.subjects)
+}
+
+func (c outputPairwiseSubjectCollection) IDs() []string {
+	ids := make([]string, len(c.subjects))
+	for i, subject := range c.subjects {
+		ids[i] = pointerx.Deref(subject.PairwiseSubject)
+	}
+	return ids
+}
+
+func (outputDeviceRequest) Header() []string {
+	return []string{"REQUEST ID", "CLIENT ID", "CLIENT NAME", "STATE", "SUBJECT", "REQUESTED SCOPE", "REQUESTED AT", "EXPIRES AT"}
+}
+
+func (i outputDeviceRequest) Columns() []string {
+	var requestedAt, expiresAt string
+	if i.RequestedAt != nil {
+		requestedAt = i.RequestedAt.Format(time.RFC3339)
+	}
+	if i.ExpiresAt != nil {
+		expiresAt = i.ExpiresAt.Format(time.RFC3339)
+	}
+	data := [8]string{
+		pointerx.Deref(i.RequestId),
+		pointerx.Deref(i.ClientId),
+		pointerx.Deref(i.ClientName),
+		pointerx.Deref(i.State),
+		pointerx.Deref(i.Subject),
+		strings.Join(i.RequestedScope, " "),
+		requestedAt,
+		expiresAt,
+	}
+	return data[:]
+}
+
+func (i outputDeviceRequest) Interface() interface{} {
+	return i
+}
+
+func (outputDeviceRequestCollection) Header() []string {
+	return outputDeviceRequest{}.Header()
+}
+
+func (c outputDeviceRequestCollection) Table() [][]string {
+	rows := make([][]string, len(c.requests))
+	for i, request := range c.requests {
+		rows[i] = outputDeviceRequest(request).Columns()
+	}
+	return rows
+}
+
+func (c outputDeviceRequestCollection) Interface() interface{} {
+	return c.requests
+}
+
+func (c outputDeviceRequestCollection) Len() int {
+	return len(c.requests)
+}
+
+func (c outputDeviceRequestCollection) IDs() []string {
+	ids := make([]string, len(c.requests))
+	for i, request := range c.requests {
+		ids[i] = pointerx.Deref(request.RequestId)
+	}
+	return ids
+}
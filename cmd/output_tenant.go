@@ -0,0 +1,71 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ory/x/pointerx"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+)
+
+type (
+	outputTenant           hydra.Tenant
+	outputTenantCollection struct {
+		tenants []hydra.Tenant
+	}
+)
+
+func (outputTenant) Header() []string {
+	return []string{"TENANT ID", "NAME", "HOSTS", "PATH PREFIX", "ISSUER"}
+}
+
+func (i outputTenant) Columns() []string {
+	var issuer string
+	if v, ok := i.Config["urls.self.issuer"]; ok {
+		issuer = fmt.Sprintf("%v", v)
+	}
+	data := [5]string{
+		pointerx.Deref(i.Id),
+		pointerx.Deref(i.Name),
+		strings.Join(i.Hosts, ", "),
+		pointerx.Deref(i.PathPrefix),
+		issuer,
+	}
+	return data[:]
+}
+
+func (i outputTenant) Interface() interface{} {
+	return i
+}
+
+func (outputTenantCollection) Header() []string {
+	return outputTenant{}.Header()
+}
+
+func (c outputTenantCollection) Table() [][]string {
+	rows := make([][]string, len(c.tenants))
+	for i, t := range c.tenants {
+		rows[i] = outputTenant(t).Columns()
+	}
+	return rows
+}
+
+func (c outputTenantCollection) Interface() interface{} {
+	return c.tenants
+}
+
+func (c outputTenantCollection) Len() int {
+	return len(c.tenants)
+}
+
+func (c outputTenantCollection) IDs() []string {
+	ids := make([]string, len(c.tenants))
+	for i, t := range c.tenants {
+		ids[i] = pointerx.Deref(t.Id)
+	}
+	return ids
+}
@@ -0,0 +1,82 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ory/x/pointerx"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+)
+
+type (
+	outputTrustedIssuer           hydra.TrustedOAuth2JwtGrantIssuer
+	outputTrustedIssuerCollection struct {
+		issuers []hydra.TrustedOAuth2JwtGrantIssuer
+	}
+)
+
+func (outputTrustedIssuer) Header() []string {
+	return []string{"ID", "ISSUER", "SUBJECT", "SCOPE", "KEY", "EXPIRES AT"}
+}
+
+func (i outputTrustedIssuer) Columns() []string {
+	subject := pointerx.Deref(i.Subject)
+	if pointerx.Deref(i.AllowAnySubject) {
+		subject = "<any>"
+	}
+	// The key is either the ID of the trusted public key or the URL of the
+	// trusted JSON Web Key Set.
+	key := pointerx.Deref(i.JwksUri)
+	var expiresAt string
+	if i.PublicKey != nil && key == "" {
+		key = pointerx.Deref(i.PublicKey.Kid)
+	}
+	if i.ExpiresAt != nil {
+		expiresAt = i.ExpiresAt.Format(time.RFC3339)
+	}
+	data := [6]string{
+		pointerx.Deref(i.Id),
+		pointerx.Deref(i.Issuer),
+		subject,
+		strings.Join(i.Scope, " "),
+		key,
+		expiresAt,
+	}
+	return data[:]
+}
+
+func (i outputTrustedIssuer) Interface() interface{} {
+	return i
+}
+
+func (outputTrustedIssuerCollection) Header() []string {
+	return outputTrustedIssuer{}.Header()
+}
+
+func (c outputTrustedIssuerCollection) Table() [][]string {
+	rows := make([][]string, len(c.issuers))
+	for i, issuer := range c.issuers {
+		rows[i] = outputTrustedIssuer(issuer).Columns()
+	}
+	return rows
+}
+
+func (c outputTrustedIssuerCollection) Interface() interface{} {
+	return c.issuers
+}
+
+func (c outputTrustedIssuerCollection) Len() int {
+	return len(c.issuers)
+}
+
+func (c outputTrustedIssuerCollection) IDs() []string {
+	ids := make([]string, len(c.issuers))
+	for i, issuer := range c.issuers {
+		ids[i] = pointerx.Deref(issuer.Id)
+	}
+	return ids
+}
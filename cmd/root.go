@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ory/x/cmdx"
+	"github.com/ory/x/jsonnetsecure"
 
 	"github.com/spf13/cobra"
 
@@ -31,12 +32,20 @@ func RegisterCommandRecursive(parent *cobra.Command, opts ...driver.OptionsModif
 	createCmd.AddCommand(
 		NewCreateClientsCommand(),
 		NewCreateJWKSCmd(),
+		NewCreateTenantCmd(),
+		NewCreateTrustCmd(),
 	)
 
 	getCmd := NewGetCmd()
 	getCmd.AddCommand(
 		NewGetClientsCmd(),
 		NewGetJWKSCmd(),
+		NewGetTenantCmd(),
+		NewGetTrustCmd(),
+		NewGetLoginRequestCmd(),
+		NewGetConsentRequestCmd(),
+		NewGetPairwiseSubjectCmd(),
+		NewGetDeviceRequestCmd(),
 	)
 
 	deleteCmd := NewDeleteCmd()
@@ -44,18 +53,32 @@ func RegisterCommandRecursive(parent *cobra.Command, opts ...driver.OptionsModif
 		NewDeleteClientCmd(),
 		NewDeleteJWKSCommand(),
 		NewDeleteAccessTokensCmd(),
+		NewDeleteSubjectCmd(),
+		NewDeleteTenantCmd(),
+		NewDeleteTrustCmd(),
 	)
 
 	listCmd := NewListCmd()
-	listCmd.AddCommand(NewListClientsCmd())
+	listCmd.AddCommand(
+		NewListClientsCmd(),
+		NewListTenantsCmd(),
+		NewListTrustCmd(),
+		NewListConsentSessionsCmd(),
+		NewListDeviceRequestsCmd(),
+	)
 
 	updateCmd := NewUpdateCmd()
-	updateCmd.AddCommand(NewUpdateClientCmd())
+	updateCmd.AddCommand(
+		NewUpdateClientCmd(),
+		NewUpdateTenantCmd(),
+		NewUpdatePairwiseSubjectsCmd(),
+	)
 
 	importCmd := NewImportCmd()
 	importCmd.AddCommand(
 		NewImportClientCmd(),
 		NewKeysImportCmd(),
+		NewImportBundleCmd(opts),
 	)
 
 	performCmd := NewPerformCmd()
@@ -66,7 +89,12 @@ func RegisterCommandRecursive(parent *cobra.Command, opts ...driver.OptionsModif
 	)
 
 	revokeCmd := NewRevokeCmd()
-	revokeCmd.AddCommand(NewRevokeTokenCmd())
+	revokeCmd.AddCommand(
+		NewRevokeTokenCmd(),
+		NewRevokeConsentSessionsCmd(),
+		NewRevokeLoginSessionsCmd(),
+		NewRevokeDeviceRequestCmd(),
+	)
 
 	introspectCmd := NewIntrospectCmd()
 	introspectCmd.AddCommand(NewIntrospectTokenCmd())
@@ -74,6 +102,7 @@ func RegisterCommandRecursive(parent *cobra.Command, opts ...driver.OptionsModif
 	migrateCmd := NewMigrateCmd()
 	migrateCmd.AddCommand(NewMigrateSQLCmd(opts))
 	migrateCmd.AddCommand(NewMigrateStatusCmd(opts))
+	migrateCmd.AddCommand(NewMigratePartitionsCmd(opts))
 
 	serveCmd := NewServeCmd()
 	serveCmd.AddCommand(NewServeAdminCmd(opts))
@@ -81,12 +110,14 @@ func RegisterCommandRecursive(parent *cobra.Command, opts ...driver.OptionsModif
 	serveCmd.AddCommand(NewServeAllCmd(opts))
 
 	parent.AddCommand(
+		NewApplyCmd(),
 		createCmd,
 		getCmd,
 		deleteCmd,
 		listCmd,
 		updateCmd,
 		importCmd,
+		NewExportCmd(opts),
 		performCmd,
 		introspectCmd,
 		revokeCmd,
@@ -94,6 +125,7 @@ func RegisterCommandRecursive(parent *cobra.Command, opts ...driver.OptionsModif
 		serveCmd,
 		NewJanitorCmd(opts),
 		NewVersionCmd(),
+		jsonnetsecure.NewJsonnetCmd(),
 	)
 }
 
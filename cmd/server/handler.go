@@ -40,6 +40,7 @@ import (
 	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/jwk"
 	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/persistence/sql"
 )
 
 func ensureNoMemoryDSN(r *driver.RegistrySQL) {
@@ -126,6 +127,13 @@ var httpMetrics = prometheusx.NewHTTPMetrics("hydra", prometheusx.HTTPPrefix, co
 func adminServer(ctx context.Context, d *driver.RegistrySQL, sqaMetrics *metricsx.Service) (func() error, error) {
 	cfg := d.Config().ServeAdmin(contextx.RootContext)
 
+	// The introspection endpoint is served by the admin API, so its cache
+	// needs to learn about revocations made on other nodes.
+	go d.OAuth2IntrospectionCache().Watch(ctx)
+	go d.TenantResolver().Watch(ctx)
+	go d.BasePersister().WatchReadReplicas(ctx)
+	go d.Janitor().Watch(ctx)
+
 	logger := reqlog.
 		NewMiddlewareFromLogger(d.Logger(),
 			fmt.Sprintf("hydra/admin: %s", d.Config().IssuerURL(ctx).String()))
@@ -140,6 +148,8 @@ func adminServer(ctx context.Context, d *driver.RegistrySQL, sqaMetrics *metrics
 	n := negroni.New(
 		recovery,
 		negroni.HandlerFunc(httprouterx.TrimTrailingSlashNegroni),
+		negroni.HandlerFunc(d.TenantResolver().Middleware),
+		negroni.HandlerFunc(sql.PrimaryPinMiddleware),
 		negroni.HandlerFunc(httprouterx.NoCacheNegroni),
 		negroni.HandlerFunc(httprouterx.AddAdminPrefixIfNotPresentNegroni),
 		negroni.HandlerFunc(semconv.Middleware),
@@ -181,6 +191,9 @@ func adminServer(ctx context.Context, d *driver.RegistrySQL, sqaMetrics *metrics
 func publicServer(ctx context.Context, d *driver.RegistrySQL, sqaMetrics *metricsx.Service) (func() error, error) {
 	cfg := d.Config().ServePublic(contextx.RootContext)
 
+	go d.TenantResolver().Watch(ctx)
+	go d.BasePersister().WatchReadReplicas(ctx)
+
 	logger := reqlog.NewMiddlewareFromLogger(
 		d.Logger(),
 		fmt.Sprintf("hydra/public: %s", d.Config().IssuerURL(ctx).String()),
@@ -196,6 +209,8 @@ func publicServer(ctx context.Context, d *driver.RegistrySQL, sqaMetrics *metric
 	n := negroni.New(
 		recovery,
 		negroni.HandlerFunc(httprouterx.TrimTrailingSlashNegroni),
+		negroni.HandlerFunc(d.TenantResolver().Middleware),
+		negroni.HandlerFunc(sql.PrimaryPinMiddleware),
 		negroni.HandlerFunc(httprouterx.NoCacheNegroni),
 		negroni.HandlerFunc(semconv.Middleware),
 		httpMetrics,
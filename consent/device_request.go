@@ -0,0 +1,89 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package consent
+
+import (
+	"time"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/fosite"
+)
+
+const (
+	DeviceRequestStatePending  = "pending"
+	DeviceRequestStateAccepted = "accepted"
+	DeviceRequestStateRejected = "rejected"
+)
+
+// OAuth 2.0 Device Authorization Request
+//
+// A device authorization request, as initiated by a device at the device authorization endpoint.
+//
+// swagger:model oAuth2DeviceRequest
+type DeviceRequest struct {
+	// RequestID is the ID of the device authorization request.
+	RequestID string `json:"request_id"`
+
+	// ClientID is the OAuth 2.0 Client that initiated the request.
+	ClientID string `json:"client_id"`
+
+	// ClientName is the human-readable name of the OAuth 2.0 Client.
+	ClientName string `json:"client_name,omitempty"`
+
+	// RequestedScope contains the OAuth 2.0 Scope requested by the device.
+	RequestedScope []string `json:"requested_scope"`
+
+	// RequestedAudience contains the access token audience requested by the device.
+	RequestedAudience []string `json:"requested_audience"`
+
+	// State is the state of the user code: `pending`, `accepted`, or `rejected`.
+	State string `json:"state"`
+
+	// Subject is the subject who accepted the user code, if any.
+	Subject string `json:"subject,omitempty"`
+
+	// RequestedAt is the time the device authorization request was made.
+	RequestedAt time.Time `json:"requested_at"`
+
+	// ExpiresAt is the time the user code and device code expire.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// List of OAuth 2.0 Device Authorization Requests
+//
+// swagger:model oAuth2DeviceRequests
+type _ []DeviceRequest
+
+func newDeviceRequest(r fosite.DeviceRequester) *DeviceRequest {
+	d := &DeviceRequest{
+		RequestID:         r.GetID(),
+		ClientID:          r.GetClient().GetID(),
+		RequestedScope:    []string(r.GetRequestedScopes()),
+		RequestedAudience: []string(r.GetRequestedAudience()),
+		RequestedAt:       r.GetRequestedAt(),
+	}
+	if d.RequestedScope == nil {
+		d.RequestedScope = []string{}
+	}
+	if d.RequestedAudience == nil {
+		d.RequestedAudience = []string{}
+	}
+	if c, ok := r.GetClient().(*client.Client); ok {
+		d.ClientName = c.Name
+	}
+	if s := r.GetSession(); s != nil {
+		d.Subject = s.GetSubject()
+		d.ExpiresAt = s.GetExpiresAt(fosite.DeviceCode)
+	}
+
+	switch r.GetUserCodeState() {
+	case fosite.UserCodeAccepted:
+		d.State = DeviceRequestStateAccepted
+	case fosite.UserCodeRejected:
+		d.State = DeviceRequestStateRejected
+	default:
+		d.State = DeviceRequestStatePending
+	}
+	return d
+}
@@ -0,0 +1,94 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package consent
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/fosite/token/jwt"
+)
+
+// Subject Erasure Report
+//
+// The report of a subject erasure. It does not contain the subject itself, only its HMAC, so that it can be
+// kept as proof of the erasure.
+//
+// swagger:model subjectErasureReport
+type SubjectErasureReport struct {
+	// ID identifies the erasure.
+	ID uuid.UUID `json:"id"`
+
+	// SubjectHMAC is the hex encoded HMAC-SHA256 of the erased subject, keyed with the system secret.
+	SubjectHMAC string `json:"subject_hmac"`
+
+	// ErasedAt is the time the subject was erased.
+	ErasedAt time.Time `json:"erased_at"`
+
+	// DeletedRows is the number of deleted rows per table.
+	DeletedRows map[string]int `json:"deleted_rows"`
+
+	// LoggedOutSessions is the number of login sessions which were logged out.
+	LoggedOutSessions int `json:"logged_out_sessions"`
+
+	// BackChannelLogoutClients lists the OAuth 2.0 Clients which were sent a back-channel logout request.
+	BackChannelLogoutClients []string `json:"back_channel_logout_clients"`
+}
+
+// Signed Subject Erasure Report
+//
+// swagger:model signedSubjectErasureReport
+type SignedSubjectErasureReport struct {
+	// Report is the subject erasure report.
+	Report *SubjectErasureReport `json:"report"`
+
+	// JWT is the report signed with the OpenID Connect ID token signing key.
+	JWT string `json:"jwt"`
+}
+
+// NewSubjectErasureReport returns an empty report for erasing the subject.
+// The subject is keyed with the secret, so that it can not be recovered from
+// the report by hashing guessed subjects.
+func NewSubjectErasureReport(secret []byte, subject string) *SubjectErasureReport {
+	return &SubjectErasureReport{
+		ID:                       uuid.Must(uuid.NewV4()),
+		SubjectHMAC:              SubjectHMAC(secret, subject),
+		ErasedAt:                 time.Now().UTC().Truncate(time.Second),
+		DeletedRows:              map[string]int{},
+		BackChannelLogoutClients: []string{},
+	}
+}
+
+// SubjectHMAC returns the hex encoded HMAC-SHA256 of the subject keyed with
+// the secret.
+func SubjectHMAC(secret []byte, subject string) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(subject))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ToMapClaims returns the claims of the signed report. The erasure ID is used
+// as the JWT ID.
+func (r *SubjectErasureReport) ToMapClaims(issuer string) (jwt.MapClaims, error) {
+	raw, err := json.Marshal(r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var claims jwt.MapClaims
+	if err := json.Unmarshal(raw, &claims); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	claims["iss"] = issuer
+	claims["jti"] = r.ID.String()
+	claims["iat"] = r.ErasedAt.Unix()
+	return claims, nil
+}
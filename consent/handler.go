@@ -15,12 +15,14 @@ import (
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/flow"
 	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/token/jwt"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/hydra/v2/x/events"
 	"github.com/ory/x/httprouterx"
 	"github.com/ory/x/otelx"
 	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
 	"github.com/ory/x/pagination/tokenpagination"
+	"github.com/ory/x/sqlcon"
 	"github.com/ory/x/sqlxx"
 	"github.com/ory/x/urlx"
 )
@@ -35,6 +37,7 @@ const (
 	ConsentPath  = "/oauth2/auth/requests/consent"
 	LogoutPath   = "/oauth2/auth/requests/logout"
 	SessionsPath = "/oauth2/auth/sessions"
+	SubjectsPath = "/oauth2/auth/subjects"
 )
 
 func NewHandler(r InternalRegistry) *Handler {
@@ -53,12 +56,22 @@ func (h *Handler) SetRoutes(admin *httprouterx.RouterAdmin) {
 	admin.DELETE(SessionsPath+"/login", h.revokeOAuth2LoginSessions)
 	admin.GET(SessionsPath+"/consent", h.listOAuth2ConsentSessions)
 	admin.DELETE(SessionsPath+"/consent", h.revokeOAuth2ConsentSessions)
+	admin.GET(SessionsPath+"/consent/history", h.listOAuth2ConsentHistory)
+	admin.DELETE(SessionsPath+"/consent/history", h.deleteOAuth2ConsentHistory)
+	admin.GET(SessionsPath+"/consent/receipts", h.listOAuth2ConsentReceipts)
+
+	admin.DELETE(SubjectsPath, h.eraseOAuth2Subject)
+	admin.GET(SubjectsPath+"/pairwise", h.getOAuth2PairwiseSubject)
+	admin.POST(SubjectsPath+"/pairwise/migrations", h.migrateOAuth2PairwiseSubjects)
 
 	admin.GET(LogoutPath, h.getOAuth2LogoutRequest)
 	admin.PUT(LogoutPath+"/accept", h.acceptOAuth2LogoutRequest)
 	admin.PUT(LogoutPath+"/reject", h.rejectOAuth2LogoutRequest)
 
+	admin.GET(DevicePath, h.getOAuth2DeviceRequest)
+	admin.GET(DevicePath+"/pending", h.listOAuth2PendingDeviceRequests)
 	admin.PUT(DevicePath+"/accept", h.acceptUserCodeRequest)
+	admin.PUT(DevicePath+"/reject", h.rejectOAuth2DeviceRequest)
 }
 
 // Revoke OAuth 2.0 Consent Session Parameters
@@ -92,6 +105,13 @@ type _ struct {
 	//
 	// in: query
 	All bool `json:"all"`
+
+	// Revocation Reason
+	//
+	// The reason for the revocation, recorded in the consent history.
+	//
+	// in: query
+	Reason string `json:"reason"`
 }
 
 // swagger:route DELETE /admin/oauth2/auth/sessions/consent oAuth2 revokeOAuth2ConsentSessions
@@ -100,6 +120,7 @@ type _ struct {
 //
 // This endpoint revokes a subject's granted consent sessions and invalidates all
 // associated OAuth 2.0 Access Tokens. You may also only revoke sessions for a specific OAuth 2.0 Client ID.
+// The revocation is recorded in the subject's consent history.
 //
 //	Consumes:
 //	- application/json
@@ -121,25 +142,26 @@ func (h *Handler) revokeOAuth2ConsentSessions(w http.ResponseWriter, r *http.Req
 		clientID         = r.URL.Query().Get("client")
 		consentRequestID = r.URL.Query().Get("consent_request_id")
 		allClients       = r.URL.Query().Get("all") == "true"
+		reason           = r.URL.Query().Get("reason")
 	)
 
 	switch {
 	case consentRequestID != "" && subject == "" && clientID == "":
-		if err := h.r.ConsentManager().RevokeConsentSessionByID(r.Context(), consentRequestID); err != nil && !errors.Is(err, x.ErrNotFound) {
+		if err := h.r.ConsentManager().RevokeConsentSessionByID(r.Context(), consentRequestID, reason); err != nil && !errors.Is(err, x.ErrNotFound) {
 			h.r.Writer().WriteError(w, r, err)
 			return
 		}
 		events.Trace(r.Context(), events.ConsentRevoked, events.WithConsentRequestID(consentRequestID))
 
 	case consentRequestID == "" && subject != "" && clientID != "" && !allClients:
-		if err := h.r.ConsentManager().RevokeSubjectClientConsentSession(r.Context(), subject, clientID); err != nil && !errors.Is(err, x.ErrNotFound) {
+		if err := h.r.ConsentManager().RevokeSubjectClientConsentSession(r.Context(), subject, clientID, reason); err != nil && !errors.Is(err, x.ErrNotFound) {
 			h.r.Writer().WriteError(w, r, err)
 			return
 		}
 		events.Trace(r.Context(), events.ConsentRevoked, events.WithSubject(subject), events.WithClientID(clientID))
 
 	case consentRequestID == "" && subject != "" && clientID == "" && allClients:
-		if err := h.r.ConsentManager().RevokeSubjectConsentSession(r.Context(), subject); err != nil && !errors.Is(err, x.ErrNotFound) {
+		if err := h.r.ConsentManager().RevokeSubjectConsentSession(r.Context(), subject, reason); err != nil && !errors.Is(err, x.ErrNotFound) {
 			h.r.Writer().WriteError(w, r, err)
 			return
 		}
@@ -233,6 +255,204 @@ func (h *Handler) listOAuth2ConsentSessions(w http.ResponseWriter, r *http.Reque
 	h.r.Writer().Write(w, r, sessions)
 }
 
+// List OAuth 2.0 Consent History Parameters
+//
+// swagger:parameters listOAuth2ConsentHistory listOAuth2ConsentReceipts
+type _ struct {
+	tokenpagination.RequestParameters
+
+	// The subject to list the consent history for.
+	//
+	// in: query
+	// required: true
+	Subject string `json:"subject"`
+}
+
+// swagger:route GET /admin/oauth2/auth/sessions/consent/history oAuth2 listOAuth2ConsentHistory
+//
+// # List the OAuth 2.0 Consent History of a Subject
+//
+// This endpoint lists every consent the subject granted, modified or revoked, oldest first. Unlike the
+// consent sessions, the history keeps revoked and expired consents. If the subject is unknown, the
+// endpoint returns an empty JSON array with status code 200 OK.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: consentHistoryEntries
+//	  default: errorOAuth2
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) listOAuth2ConsentHistory(w http.ResponseWriter, r *http.Request) {
+	entries, ok := h.listConsentHistory(w, r)
+	if !ok {
+		return
+	}
+
+	h.r.Writer().Write(w, r, entries)
+}
+
+// swagger:route GET /admin/oauth2/auth/sessions/consent/receipts oAuth2 listOAuth2ConsentReceipts
+//
+// # Export the OAuth 2.0 Consent Receipts of a Subject
+//
+// This endpoint exports the consent history of the subject as consent receipts following the Kantara
+// Initiative Consent Receipt Specification v1.1, one receipt per history entry. Each receipt is signed
+// with the OpenID Connect ID token signing key, whose public key is published at `/.well-known/jwks.json`.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: signedConsentReceipts
+//	  default: errorOAuth2
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) listOAuth2ConsentReceipts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	entries, ok := h.listConsentHistory(w, r)
+	if !ok {
+		return
+	}
+
+	keyID, err := h.r.OpenIDJWTSigner().GetPublicKeyID(ctx)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	var (
+		settings = h.r.Config().ConsentReceipts(ctx)
+		issuer   = h.r.Config().IssuerURL(ctx).String()
+		now      = time.Now().UTC()
+		clients  = make(map[string]*client.Client)
+		receipts = make([]SignedConsentReceipt, 0, len(entries))
+	)
+	for i := range entries {
+		e := &entries[i]
+
+		cl, found := clients[e.ClientID]
+		if !found {
+			cl, err = h.r.ClientManager().GetConcreteClient(ctx, e.ClientID)
+			if errors.Is(err, sqlcon.ErrNoRows()) {
+				// The client was deleted since.
+				cl = nil
+			} else if err != nil {
+				h.r.Writer().WriteError(w, r, err)
+				return
+			}
+			clients[e.ClientID] = cl
+		}
+
+		scopes, err := h.r.ScopeManager().GetScopes(ctx, e.GrantedScope)
+		if err != nil {
+			h.r.Writer().WriteError(w, r, err)
+			return
+		}
+
+		receipt := NewConsentReceipt(e, cl, scopes, settings)
+		claims, err := receipt.ToMapClaims(issuer, now)
+		if err != nil {
+			h.r.Writer().WriteError(w, r, err)
+			return
+		}
+
+		token, _, err := h.r.OpenIDJWTSigner().Generate(ctx, claims, &jwt.Headers{
+			Extra: map[string]interface{}{"kid": keyID},
+		})
+		if err != nil {
+			h.r.Writer().WriteError(w, r, err)
+			return
+		}
+
+		receipts = append(receipts, SignedConsentReceipt{Receipt: receipt, JWT: token})
+	}
+
+	h.r.Writer().Write(w, r, receipts)
+}
+
+// listConsentHistory writes the pagination headers and returns the requested
+// page of the subject's consent history. It writes the error and returns false
+// if the request is invalid.
+func (h *Handler) listConsentHistory(w http.ResponseWriter, r *http.Request) ([]ConsentHistoryEntry, bool) {
+	subject := r.URL.Query().Get("subject")
+	if subject == "" {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithHint(`Query parameter 'subject' is not defined but should have been.`)))
+		return nil, false
+	}
+
+	pageKeys := h.r.Config().GetPaginationEncryptionKeys(r.Context())
+	pageOpts, err := keysetpagination.ParseQueryParams(pageKeys, r.URL.Query())
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithWrap(err).WithHintf("Unable to parse pagination parameters: %s", err)))
+		return nil, false
+	}
+
+	entries, nextPage, err := h.r.ConsentManager().ListSubjectConsentHistory(r.Context(), subject, pageOpts...)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return nil, false
+	}
+	if entries == nil {
+		entries = []ConsentHistoryEntry{}
+	}
+
+	keysetpagination.SetLinkHeader(w, pageKeys, r.URL, nextPage)
+	return entries, true
+}
+
+// Delete OAuth 2.0 Consent History Parameters
+//
+// swagger:parameters deleteOAuth2ConsentHistory
+type _ struct {
+	// The subject to delete the consent history for.
+	//
+	// in: query
+	// required: true
+	Subject string `json:"subject"`
+}
+
+// swagger:route DELETE /admin/oauth2/auth/sessions/consent/history oAuth2 deleteOAuth2ConsentHistory
+//
+// # Delete the OAuth 2.0 Consent History of a Subject
+//
+// This endpoint irreversibly deletes the consent history of the subject, for example when the subject's
+// personal data is erased. It does not revoke the subject's consent sessions.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  204: emptyResponse
+//	  default: errorOAuth2
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) deleteOAuth2ConsentHistory(w http.ResponseWriter, r *http.Request) {
+	subject := r.URL.Query().Get("subject")
+	if subject == "" {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithHint(`Query parameter 'subject' is not defined but should have been.`)))
+		return
+	}
+
+	if err := h.r.ConsentManager().DeleteSubjectConsentHistory(r.Context(), subject); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // Revoke OAuth 2.0 Consent Login Sessions Parameters
 //
 // swagger:parameters revokeOAuth2LoginSessions
@@ -308,6 +528,211 @@ func (h *Handler) revokeOAuth2LoginSessions(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// Erase OAuth 2.0 Subject Parameters
+//
+// swagger:parameters eraseOAuth2Subject
+type _ struct {
+	// The subject to erase.
+	//
+	// in: query
+	// required: true
+	Subject string `json:"subject"`
+}
+
+// swagger:route DELETE /admin/oauth2/auth/subjects oAuth2 eraseOAuth2Subject
+//
+// # Erase all Data of an OAuth 2.0 Subject
+//
+// This endpoint irreversibly deletes all data stored about the subject in a single transaction: its login and
+// consent sessions, flows, consent history, pairwise subject identifiers, and all tokens issued to it, including
+// tokens issued to its pairwise identifiers. The tokens are revoked. OpenID Connect Back-channel logout is performed
+// for all of the subject's login sessions.
+//
+// The response contains a report of the erasure, which is signed with the OpenID Connect ID token signing key
+// whose public key is published at `/.well-known/jwks.json`. The report contains an HMAC of the subject keyed with the system secret
+// instead of the subject itself, so that it can be kept as proof of the erasure.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: signedSubjectErasureReport
+//	  default: errorOAuth2
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) eraseOAuth2Subject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	subject := r.URL.Query().Get("subject")
+	if subject == "" {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithHint(`Query parameter 'subject' is not defined but should have been.`)))
+		return
+	}
+
+	keyID, err := h.r.OpenIDJWTSigner().GetPublicKeyID(ctx)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	report, err := h.r.ConsentStrategy().HandleSubjectErasure(ctx, subject)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	events.Trace(ctx, events.SubjectErased, events.WithSubjectErasure(report.ID.String(), report.SubjectHMAC))
+
+	claims, err := report.ToMapClaims(h.r.Config().IssuerURL(ctx).String())
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	token, _, err := h.r.OpenIDJWTSigner().Generate(ctx, claims, &jwt.Headers{
+		Extra: map[string]interface{}{"kid": keyID},
+	})
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, &SignedSubjectErasureReport{Report: report, JWT: token})
+}
+
+// Get OAuth 2.0 Pairwise Subject Parameters
+//
+// swagger:parameters getOAuth2PairwiseSubject
+type _ struct {
+	// The OAuth 2.0 Client the pairwise subject identifier was issued to.
+	//
+	// in: query
+	// required: true
+	ClientID string `json:"client_id"`
+
+	// The pairwise subject identifier to resolve.
+	//
+	// in: query
+	// required: true
+	PairwiseSubject string `json:"pairwise_subject"`
+}
+
+// swagger:route GET /admin/oauth2/auth/subjects/pairwise oAuth2 getOAuth2PairwiseSubject
+//
+// # Resolve an OAuth 2.0 Pairwise Subject Identifier
+//
+// This endpoint returns the subject a pairwise subject identifier issued to an OAuth 2.0 Client stands for. Both the
+// current and the previous identifiers recorded by a migration are resolved, as are identifiers computed with one of
+// the salts configured at `oidc.subject_identifiers.pairwise.previous_salts`.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: pairwiseSubject
+//	  default: errorOAuth2
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) getOAuth2PairwiseSubject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	clientID := r.URL.Query().Get("client_id")
+	if clientID == "" {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithHint(`Query parameter 'client_id' is not defined but should have been.`)))
+		return
+	}
+	pairwiseSubject := r.URL.Query().Get("pairwise_subject")
+	if pairwiseSubject == "" {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithHint(`Query parameter 'pairwise_subject' is not defined but should have been.`)))
+		return
+	}
+
+	c, err := h.r.ClientManager().GetConcreteClient(ctx, clientID)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	ps, err := resolvePairwiseSubject(ctx, h.r, c, pairwiseSubject)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, ps)
+}
+
+// Migrate OAuth 2.0 Pairwise Subjects Parameters
+//
+// swagger:parameters migrateOAuth2PairwiseSubjects
+type _ struct {
+	// in: body
+	// required: true
+	Body PairwiseSubjectMigration
+}
+
+// swagger:route POST /admin/oauth2/auth/subjects/pairwise/migrations oAuth2 migrateOAuth2PairwiseSubjects
+//
+// # Migrate the Pairwise Subject Identifiers of an OAuth 2.0 Client
+//
+// Changing the subject type, the sector identifier URI, or the redirect URIs of an OAuth 2.0 Client, or rotating the
+// pairwise salt, changes the subject identifiers computed for the client. Identifiers already issued are kept, but
+// subjects which never used the client since are issued new ones.
+//
+// This endpoint computes, for all subjects which used the client, the identifier issued before the change and the
+// identifier computed from the client's current configuration. Per default, the client is issued the current
+// identifiers from then on, and the previous identifiers are recorded so they can still be resolved and used as
+// `id_token_hint`. With `keep_previous_identifiers`, the client keeps being issued the previous identifiers instead.
+//
+// Use `dry_run` to review the mapping before persisting it.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: pairwiseSubjects
+//	  default: errorOAuth2
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) migrateOAuth2PairwiseSubjects(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var m PairwiseSubjectMigration
+	if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithWrap(err).WithHintf("Unable to decode body because: %s", err)))
+		return
+	}
+	if m.ClientID == "" {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithHint(`Field 'client_id' must be set.`)))
+		return
+	}
+
+	c, err := h.r.ClientManager().GetConcreteClient(ctx, m.ClientID)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	mapping, err := migratePairwiseSubjects(ctx, h.r, c, &m)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, mapping)
+}
+
 // Get OAuth 2.0 Login Request
 //
 // swagger:parameters getOAuth2LoginRequest
@@ -497,6 +922,7 @@ func (h *Handler) acceptOAuth2LoginRequest(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	flowDecisions.WithLabelValues(metricsFlowLogin, metricsDecisionAccepted).Inc()
 	events.Trace(ctx, events.LoginAccepted, events.WithClientID(f.Client.GetID()), events.WithSubject(payload.Subject))
 	h.r.Writer().Write(w, r, &flow.OAuth2RedirectTo{
 		RedirectTo: urlx.SetQuery(ru, url.Values{"login_verifier": {verifier}}).String(),
@@ -592,6 +1018,7 @@ func (h *Handler) rejectOAuth2LoginRequest(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	flowDecisions.WithLabelValues(metricsFlowLogin, metricsDecisionRejected).Inc()
 	events.Trace(ctx, events.LoginRejected, events.WithClientID(f.Client.GetID()), events.WithSubject(f.Subject))
 
 	h.r.Writer().Write(w, r, &flow.OAuth2RedirectTo{
@@ -670,6 +1097,15 @@ func (h *Handler) getOAuth2ConsentRequest(w http.ResponseWriter, r *http.Request
 	// Transform flow to the existing API format.
 	req := f.GetConsentRequest(challenge)
 	req.Client.Secret = ""
+	req.RequestedScopeMetadata, err = h.r.ScopeManager().GetScopes(ctx, req.RequestedScope)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	req.RequestedScopeParameters = fosite.ScopeParameters(
+		append(h.r.Config().GetScopeTemplates(ctx), fosite.ParseScopeTemplates(req.Client.GetScopes())...),
+		req.RequestedScope,
+	)
 	h.r.Writer().Write(w, r, req)
 }
 
@@ -765,6 +1201,7 @@ func (h *Handler) acceptOAuth2ConsentRequest(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	flowDecisions.WithLabelValues(metricsFlowConsent, metricsDecisionAccepted).Inc()
 	events.Trace(ctx, events.ConsentAccepted, events.WithClientID(f.Client.GetID()), events.WithSubject(f.Subject))
 	h.r.Writer().Write(w, r, &flow.OAuth2RedirectTo{
 		RedirectTo: urlx.SetQuery(ru, url.Values{"consent_verifier": {verifier}}).String(),
@@ -862,6 +1299,7 @@ func (h *Handler) rejectOAuth2ConsentRequest(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	flowDecisions.WithLabelValues(metricsFlowConsent, metricsDecisionRejected).Inc()
 	events.Trace(ctx, events.ConsentRejected, events.WithClientID(f.Client.GetID()), events.WithSubject(f.Subject))
 
 	h.r.Writer().Write(w, r, &flow.OAuth2RedirectTo{
@@ -1118,8 +1556,185 @@ func (h *Handler) acceptUserCodeRequest(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	flowDecisions.WithLabelValues(metricsFlowDevice, metricsDecisionAccepted).Inc()
 	events.Trace(ctx, events.DeviceUserCodeAccepted, events.WithClientID(userCodeRequest.GetClient().GetID()))
 	h.r.Writer().Write(w, r, &flow.OAuth2RedirectTo{
 		RedirectTo: urlx.SetQuery(ru, url.Values{"device_verifier": {verifier}, "client_id": {userCodeRequest.GetClient().GetID()}}).String(),
 	})
 }
+
+// Get OAuth 2.0 Device Request Parameters
+//
+// swagger:parameters getOAuth2DeviceRequest rejectOAuth2DeviceRequest
+type _ struct {
+	// The user code shown to the end-user by the device.
+	//
+	// in: query
+	// required: true
+	UserCode string `json:"user_code"`
+}
+
+// swagger:route GET /admin/oauth2/auth/requests/device oAuth2 getOAuth2DeviceRequest
+//
+// # Get an OAuth 2.0 Device Authorization Request
+//
+// This endpoint returns the device authorization request of a user code, including the OAuth 2.0 Client which
+// initiated it and whether the user code is still pending, was accepted, or was rejected. Expired requests are
+// returned until they are flushed.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: oAuth2DeviceRequest
+//	  default: errorOAuth2
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) getOAuth2DeviceRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userCodeSignature, ok := h.userCodeSignatureFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	req, err := h.r.OAuth2Storage().GetDeviceAuthRequest(ctx, userCodeSignature, nil)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, newDeviceRequest(req))
+}
+
+// swagger:route PUT /admin/oauth2/auth/requests/device/reject oAuth2 rejectOAuth2DeviceRequest
+//
+// # Reject an OAuth 2.0 Device Authorization Request
+//
+// This endpoint denies a pending device authorization request. The user code can no longer be used, and the device
+// polling the token endpoint receives an `access_denied` error. User codes which were already accepted can not be
+// rejected; revoke the consent session instead.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: oAuth2DeviceRequest
+//	  default: errorOAuth2
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-high
+func (h *Handler) rejectOAuth2DeviceRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userCodeSignature, ok := h.userCodeSignatureFromQuery(w, r)
+	if !ok {
+		return
+	}
+
+	req, err := h.r.OAuth2Storage().GetDeviceAuthRequest(ctx, userCodeSignature, nil)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	if req.GetUserCodeState() != fosite.UserCodeUnused {
+		h.r.Writer().WriteError(w, r, errors.WithStack(x.ErrConflict.WithHint("The 'user_code' has already been used.")))
+		return
+	}
+
+	if err := h.r.OAuth2Storage().RejectDeviceAuthRequest(ctx, userCodeSignature); errors.Is(err, x.ErrNotFound) {
+		// The user code was used in the meantime.
+		h.r.Writer().WriteError(w, r, errors.WithStack(x.ErrConflict.WithHint("The 'user_code' has already been used.")))
+		return
+	} else if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	req.SetUserCodeState(fosite.UserCodeRejected)
+
+	flowDecisions.WithLabelValues(metricsFlowDevice, metricsDecisionRejected).Inc()
+	events.Trace(ctx, events.DeviceUserCodeRejected, events.WithClientID(req.GetClient().GetID()))
+	h.r.Writer().Write(w, r, newDeviceRequest(req))
+}
+
+func (h *Handler) userCodeSignatureFromQuery(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userCode := r.URL.Query().Get("user_code")
+	if userCode == "" {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithHint(`Query parameter 'user_code' is not defined but should have been.`)))
+		return "", false
+	}
+
+	signature, err := h.r.UserCodeStrategy().UserCodeSignature(r.Context(), userCode)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, fosite.ErrServerError.WithWrap(err).WithHint(`The 'user_code' signature could not be computed.`))
+		return "", false
+	}
+	return signature, true
+}
+
+// List OAuth 2.0 Pending Device Requests Parameters
+//
+// swagger:parameters listOAuth2PendingDeviceRequests
+type _ struct {
+	tokenpagination.RequestParameters
+
+	// The OAuth 2.0 Client to list the pending device authorization requests for.
+	//
+	// in: query
+	// required: true
+	ClientID string `json:"client_id"`
+}
+
+// swagger:route GET /admin/oauth2/auth/requests/device/pending oAuth2 listOAuth2PendingDeviceRequests
+//
+// # List Pending OAuth 2.0 Device Authorization Requests of a Client
+//
+// This endpoint lists the device authorization requests of an OAuth 2.0 Client whose user code has neither been
+// accepted nor rejected and has not expired yet, oldest first.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: oAuth2DeviceRequests
+//	  default: errorOAuth2
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) listOAuth2PendingDeviceRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	clientID := r.URL.Query().Get("client_id")
+	if clientID == "" {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithHint(`Query parameter 'client_id' is not defined but should have been.`)))
+		return
+	}
+
+	pageKeys := h.r.Config().GetPaginationEncryptionKeys(ctx)
+	pageOpts, err := keysetpagination.ParseQueryParams(pageKeys, r.URL.Query())
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrInvalidRequest.WithWrap(err).WithHintf("Unable to parse pagination parameters: %s", err)))
+		return
+	}
+
+	requests, nextPage, err := h.r.OAuth2Storage().ListPendingDeviceAuthRequests(ctx, clientID, pageOpts...)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	result := make([]*DeviceRequest, len(requests))
+	for i := range requests {
+		result[i] = newDeviceRequest(requests[i])
+	}
+
+	keysetpagination.SetLinkHeader(w, pageKeys, r.URL, nextPage)
+	h.r.Writer().Write(w, r, result)
+}
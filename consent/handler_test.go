@@ -17,14 +17,20 @@ import (
 	hydra "github.com/ory/hydra-client-go/v2"
 	"github.com/ory/hydra/v2/client"
 	. "github.com/ory/hydra/v2/consent"
+	consenttest "github.com/ory/hydra/v2/consent/test"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/flow"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/internal/testhelpers"
 	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/oauth2/scope"
 	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/configx"
 	"github.com/ory/x/httprouterx"
 	"github.com/ory/x/ioutilx"
 	"github.com/ory/x/sqlxx"
+	"github.com/ory/x/uuidx"
 )
 
 func TestGetLogoutRequest(t *testing.T) {
@@ -222,6 +228,44 @@ func TestGetConsentRequest(t *testing.T) {
 		assert.NotNil(t, result.Client)
 	})
 
+	t.Run("registered scope metadata", func(t *testing.T) {
+		require.NoError(t, reg.ScopeManager().CreateScope(t.Context(), &scope.Scope{
+			Name:         "photos.read",
+			DisplayNames: scope.DisplayNames{"en": "View your photos"},
+		}))
+
+		f := *f
+		f.RequestedScope = []string{"openid", "photos.read"}
+		challenge, err := f.ToConsentChallenge(t.Context(), reg)
+		require.NoError(t, err)
+
+		resp, err := ts.Client().Get(ts.URL + "/admin" + ConsentPath + "?challenge=" + challenge)
+		require.NoError(t, err)
+		require.EqualValues(t, http.StatusOK, resp.StatusCode)
+
+		var result flow.OAuth2ConsentRequest
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		require.Len(t, result.RequestedScopeMetadata, 1)
+		assert.Equal(t, "photos.read", result.RequestedScopeMetadata[0].Name)
+		assert.Equal(t, "View your photos", result.RequestedScopeMetadata[0].DisplayNames["en"])
+	})
+
+	t.Run("scope parameters", func(t *testing.T) {
+		f := *f
+		f.Client = &client.Client{ID: cl.ID, Scope: "openid read:account:{id:int}"}
+		f.RequestedScope = []string{"openid", "read:account:42"}
+		challenge, err := f.ToConsentChallenge(t.Context(), reg)
+		require.NoError(t, err)
+
+		resp, err := ts.Client().Get(ts.URL + "/admin" + ConsentPath + "?challenge=" + challenge)
+		require.NoError(t, err)
+		require.EqualValues(t, http.StatusOK, resp.StatusCode)
+
+		var result flow.OAuth2ConsentRequest
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, map[string]map[string]string{"read:account:42": {"id": "42"}}, result.RequestedScopeParameters)
+	})
+
 	t.Run("handled flow", func(t *testing.T) {
 		f.State = flow.FlowStateConsentUnused
 		require.NoError(t, f.InvalidateConsentRequest())
@@ -480,3 +524,434 @@ func TestAcceptCodeDeviceRequest(t *testing.T) {
 		assert.EqualValues(t, http.StatusOK, resp.StatusCode)
 	})
 }
+
+func TestConsentHistory(t *testing.T) {
+	t.Parallel()
+
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyConsentReceipts + ".jurisdiction":    "DE",
+		config.KeyConsentReceipts + ".controller.name": "ACME Corp",
+	})))
+
+	h := NewHandler(reg)
+	r := httprouterx.NewRouterAdminWithPrefix()
+	h.SetRoutes(r)
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	require.NoError(t, reg.ScopeManager().CreateScope(t.Context(), &scope.Scope{
+		Name:        "scope_a",
+		Description: "Read your profile",
+		Sensitivity: scope.SensitivityHigh,
+	}))
+
+	f := consenttest.MockConsentFlow(true, 3600, false)
+	f.NID = reg.Persister().NetworkID(t.Context())
+	f.Client.Name = "test client name"
+	require.NoError(t, reg.ClientManager().CreateClient(t.Context(), f.Client))
+	require.NoError(t, reg.ConsentManager().CreateConsentSession(t.Context(), f))
+
+	req, err := http.NewRequest(http.MethodDelete, ts.URL+"/admin"+SessionsPath+"/consent?subject="+f.Subject+"&all=true&reason=moved+away", nil)
+	require.NoError(t, err)
+	resp, err := ts.Client().Do(req)
+	require.NoError(t, err)
+	require.EqualValues(t, http.StatusNoContent, resp.StatusCode)
+
+	t.Run("list history", func(t *testing.T) {
+		resp, err := ts.Client().Get(ts.URL + "/admin" + SessionsPath + "/consent/history?subject=" + f.Subject)
+		require.NoError(t, err)
+		require.EqualValues(t, http.StatusOK, resp.StatusCode)
+
+		var result []ConsentHistoryEntry
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		require.Len(t, result, 2)
+		assert.ElementsMatch(t, []string{ConsentEventGranted, ConsentEventRevoked}, []string{result[0].Event, result[1].Event})
+		for _, e := range result {
+			if e.Event == ConsentEventRevoked {
+				assert.Equal(t, "moved away", e.RevocationReason)
+			}
+		}
+	})
+
+	t.Run("export receipts", func(t *testing.T) {
+		resp, err := ts.Client().Get(ts.URL + "/admin" + SessionsPath + "/consent/receipts?subject=" + f.Subject)
+		require.NoError(t, err)
+		require.EqualValues(t, http.StatusOK, resp.StatusCode)
+
+		var result []SignedConsentReceipt
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		require.Len(t, result, 2)
+
+		for _, receipt := range result {
+			assert.Equal(t, ConsentReceiptVersion, receipt.Receipt.Version)
+			assert.Equal(t, "DE", receipt.Receipt.Jurisdiction)
+			assert.Equal(t, f.Subject, receipt.Receipt.PIIPrincipalID)
+			assert.Equal(t, "ACME Corp", receipt.Receipt.PIIControllers[0].PIIController)
+			assert.Equal(t, "test client name", receipt.Receipt.Services[0].Service)
+			assert.True(t, receipt.Receipt.Sensitive)
+			assert.Equal(t, []string{"scope_a"}, receipt.Receipt.SPICat)
+			require.Len(t, receipt.Receipt.Services[0].Purposes, 2)
+			assert.Equal(t, "Read your profile", receipt.Receipt.Services[0].Purposes[0].Purpose)
+
+			_, err := reg.OpenIDJWTSigner().Validate(t.Context(), receipt.JWT)
+			require.NoError(t, err)
+			token, err := reg.OpenIDJWTSigner().Decode(t.Context(), receipt.JWT)
+			require.NoError(t, err)
+			assert.Equal(t, f.Subject, token.Claims["sub"])
+			assert.Equal(t, receipt.Receipt.ConsentReceiptID, token.Claims["jti"])
+		}
+	})
+
+	t.Run("missing subject", func(t *testing.T) {
+		resp, err := ts.Client().Get(ts.URL + "/admin" + SessionsPath + "/consent/history")
+		require.NoError(t, err)
+		assert.EqualValues(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("delete history", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodDelete, ts.URL+"/admin"+SessionsPath+"/consent/history?subject="+f.Subject, nil)
+		require.NoError(t, err)
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		require.EqualValues(t, http.StatusNoContent, resp.StatusCode)
+
+		resp, err = ts.Client().Get(ts.URL + "/admin" + SessionsPath + "/consent/history?subject=" + f.Subject)
+		require.NoError(t, err)
+		require.EqualValues(t, http.StatusOK, resp.StatusCode)
+		assert.JSONEq(t, "[]", string(ioutilx.MustReadAll(resp.Body)))
+	})
+}
+
+func TestEraseSubject(t *testing.T) {
+	t.Parallel()
+
+	reg := testhelpers.NewRegistryMemory(t)
+
+	h := NewHandler(reg)
+	r := httprouterx.NewRouterAdminWithPrefix()
+	h.SetRoutes(r)
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	backChannel := make(chan string, 1)
+	backChannelServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		backChannel <- r.PostForm.Get("logout_token")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer backChannelServer.Close()
+
+	f := consenttest.MockConsentFlow(true, 3600, false)
+	f.NID = reg.Persister().NetworkID(t.Context())
+	f.Client.BackChannelLogoutURI = backChannelServer.URL
+	require.NoError(t, reg.ClientManager().CreateClient(t.Context(), f.Client))
+
+	ls := &flow.LoginSession{
+		ID:              uuidx.NewV4().String(),
+		Subject:         f.Subject,
+		AuthenticatedAt: sqlxx.NullTime(time.Now().UTC()),
+		Remember:        true,
+	}
+	require.NoError(t, reg.LoginManager().ConfirmLoginSession(t.Context(), ls))
+	f.SessionID = sqlxx.NullString(ls.ID)
+	require.NoError(t, reg.ConsentManager().CreateConsentSession(t.Context(), f))
+
+	other := consenttest.MockConsentFlow(true, 3600, false)
+	other.NID = f.NID
+	require.NoError(t, reg.ClientManager().CreateClient(t.Context(), other.Client))
+	require.NoError(t, reg.ConsentManager().CreateConsentSession(t.Context(), other))
+
+	t.Run("missing subject", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodDelete, ts.URL+"/admin"+SubjectsPath, nil)
+		require.NoError(t, err)
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		assert.EqualValues(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("erase", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodDelete, ts.URL+"/admin"+SubjectsPath+"?subject="+f.Subject, nil)
+		require.NoError(t, err)
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		require.EqualValues(t, http.StatusOK, resp.StatusCode)
+
+		var result SignedSubjectErasureReport
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		secret, err := reg.Config().GetGlobalSecret(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, SubjectHMAC(secret, f.Subject), result.Report.SubjectHMAC)
+		assert.NotEqual(t, SubjectHMAC([]byte("another secret"), f.Subject), result.Report.SubjectHMAC)
+		assert.Equal(t, 1, result.Report.LoggedOutSessions)
+		assert.Equal(t, []string{f.Client.GetID()}, result.Report.BackChannelLogoutClients)
+		assert.Equal(t, 1, result.Report.DeletedRows[flow.Flow{}.TableName()])
+		assert.Equal(t, 1, result.Report.DeletedRows[flow.LoginSession{}.TableName()])
+		assert.Equal(t, 1, result.Report.DeletedRows[ConsentHistoryEntry{}.TableName()])
+
+		_, err = reg.OpenIDJWTSigner().Validate(t.Context(), result.JWT)
+		require.NoError(t, err)
+		token, err := reg.OpenIDJWTSigner().Decode(t.Context(), result.JWT)
+		require.NoError(t, err)
+		assert.Equal(t, result.Report.ID.String(), token.Claims["jti"])
+		assert.Equal(t, result.Report.SubjectHMAC, token.Claims["subject_hmac"])
+		assert.NotContains(t, result.JWT, f.Subject)
+
+		select {
+		case logoutToken := <-backChannel:
+			claims, err := reg.OpenIDJWTSigner().Decode(t.Context(), logoutToken)
+			require.NoError(t, err)
+			assert.Equal(t, ls.ID, claims.Claims["sid"])
+		case <-time.After(10 * time.Second):
+			t.Fatal("back-channel logout was not executed")
+		}
+
+		_, err = reg.LoginManager().GetRememberedLoginSession(t.Context(), ls.ID)
+		assert.ErrorIs(t, err, x.ErrNotFound)
+
+		_, _, err = reg.ConsentManager().FindSubjectsGrantedConsentRequests(t.Context(), f.Subject)
+		assert.ErrorIs(t, err, ErrNoPreviousConsentFound)
+
+		history, _, err := reg.ConsentManager().ListSubjectConsentHistory(t.Context(), f.Subject)
+		require.NoError(t, err)
+		assert.Empty(t, history)
+	})
+
+	t.Run("other subjects are kept", func(t *testing.T) {
+		sessions, _, err := reg.ConsentManager().FindSubjectsGrantedConsentRequests(t.Context(), other.Subject)
+		require.NoError(t, err)
+		assert.Len(t, sessions, 1)
+	})
+}
+
+func TestPairwiseSubjects(t *testing.T) {
+	t.Parallel()
+
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeySubjectTypesSupported:                   []string{"public", "pairwise"},
+		config.KeySubjectIdentifierAlgorithmSalt:          "current-salt-0000",
+		config.KeySubjectIdentifierAlgorithmPreviousSalts: []string{"previous-salt-0000"},
+	})))
+
+	h := NewHandler(reg)
+	r := httprouterx.NewRouterAdminWithPrefix()
+	h.SetRoutes(r)
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	f := consenttest.MockConsentFlow(true, 3600, false)
+	f.NID = reg.Persister().NetworkID(t.Context())
+	f.Client.SubjectType = "pairwise"
+	f.Client.RedirectURIs = []string{"https://old.example.org/callback"}
+	require.NoError(t, reg.ClientManager().CreateClient(t.Context(), f.Client))
+	require.NoError(t, reg.ConsentManager().CreateConsentSession(t.Context(), f))
+
+	resolve := func(t *testing.T, pairwiseSubject string) (*http.Response, PairwiseSubject) {
+		resp, err := ts.Client().Get(ts.URL + "/admin" + SubjectsPath + "/pairwise?client_id=" + f.Client.GetID() + "&pairwise_subject=" + pairwiseSubject)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var ps PairwiseSubject
+		if resp.StatusCode == http.StatusOK {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&ps))
+		}
+		return resp, ps
+	}
+
+	migrate := func(t *testing.T, m PairwiseSubjectMigration) (*http.Response, []PairwiseSubject) {
+		body, err := json.Marshal(m)
+		require.NoError(t, err)
+		resp, err := ts.Client().Post(ts.URL+"/admin"+SubjectsPath+"/pairwise/migrations", "application/json", bytes.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var mapping []PairwiseSubject
+		if resp.StatusCode == http.StatusOK {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&mapping))
+		}
+		return resp, mapping
+	}
+
+	issued, err := reg.ConsentStrategy().ObfuscateSubjectIdentifier(t.Context(), f.Client, f.Subject, "")
+	require.NoError(t, err)
+	require.NotEqual(t, f.Subject, issued)
+
+	t.Run("case=identifier is pinned", func(t *testing.T) {
+		f.Client.RedirectURIs = []string{"https://new.example.org/callback"}
+		require.NoError(t, reg.ClientManager().UpdateClient(t.Context(), f.Client))
+
+		again, err := reg.ConsentStrategy().ObfuscateSubjectIdentifier(t.Context(), f.Client, f.Subject, "")
+		require.NoError(t, err)
+		assert.Equal(t, issued, again)
+	})
+
+	t.Run("case=resolves issued identifier", func(t *testing.T) {
+		resp, ps := resolve(t, issued)
+		require.EqualValues(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, f.Subject, ps.Subject)
+		assert.Equal(t, "old.example.org", ps.SectorIdentifier)
+	})
+
+	t.Run("case=unknown identifier is not found", func(t *testing.T) {
+		resp, _ := resolve(t, uuidx.NewV4().String())
+		assert.EqualValues(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("case=rejects public clients", func(t *testing.T) {
+		other := consenttest.MockConsentFlow(true, 3600, false)
+		require.NoError(t, reg.ClientManager().CreateClient(t.Context(), other.Client))
+
+		resp, _ := migrate(t, PairwiseSubjectMigration{ClientID: other.Client.GetID()})
+		assert.EqualValues(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("case=dry run does not persist", func(t *testing.T) {
+		resp, mapping := migrate(t, PairwiseSubjectMigration{ClientID: f.Client.GetID(), PreviousSectorIdentifier: "old.example.org", DryRun: true})
+		require.EqualValues(t, http.StatusOK, resp.StatusCode)
+		require.Len(t, mapping, 1)
+		assert.Equal(t, issued, mapping[0].PreviousPairwiseSubject)
+		assert.Equal(t, "new.example.org", mapping[0].SectorIdentifier)
+
+		again, err := reg.ConsentStrategy().ObfuscateSubjectIdentifier(t.Context(), f.Client, f.Subject, "")
+		require.NoError(t, err)
+		assert.Equal(t, issued, again)
+	})
+
+	t.Run("case=migrates to the current sector", func(t *testing.T) {
+		resp, mapping := migrate(t, PairwiseSubjectMigration{ClientID: f.Client.GetID(), PreviousSectorIdentifier: "old.example.org"})
+		require.EqualValues(t, http.StatusOK, resp.StatusCode)
+		require.Len(t, mapping, 1)
+
+		current, err := reg.ConsentStrategy().ObfuscateSubjectIdentifier(t.Context(), f.Client, f.Subject, "")
+		require.NoError(t, err)
+		assert.Equal(t, mapping[0].PairwiseSubject, current)
+		assert.NotEqual(t, issued, current)
+
+		for _, id := range []string{issued, current} {
+			resp, ps := resolve(t, id)
+			require.EqualValues(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, f.Subject, ps.Subject)
+			assert.Equal(t, current, ps.PairwiseSubject)
+		}
+	})
+
+	t.Run("case=resolves identifiers of the previous salt", func(t *testing.T) {
+		reg.Config().MustSet(t.Context(), config.KeySubjectIdentifierAlgorithmSalt, "rotated-salt-0000")
+		reg.Config().MustSet(t.Context(), config.KeySubjectIdentifierAlgorithmPreviousSalts, []string{"current-salt-0000"})
+
+		resp, mapping := migrate(t, PairwiseSubjectMigration{ClientID: f.Client.GetID(), UsePreviousSalt: true, KeepPreviousIdentifiers: true})
+		require.EqualValues(t, http.StatusOK, resp.StatusCode)
+		require.Len(t, mapping, 1)
+		assert.Empty(t, mapping[0].PreviousPairwiseSubject)
+
+		current, err := reg.ConsentStrategy().ObfuscateSubjectIdentifier(t.Context(), f.Client, f.Subject, "")
+		require.NoError(t, err)
+		assert.Equal(t, mapping[0].PairwiseSubject, current)
+	})
+}
+
+func TestDeviceRequests(t *testing.T) {
+	t.Parallel()
+
+	reg := testhelpers.NewRegistryMemory(t)
+
+	h := NewHandler(reg)
+	r := httprouterx.NewRouterAdminWithPrefix()
+	h.SetRoutes(r)
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	cl := &client.Client{ID: uuidx.NewV4().String(), Name: "Living Room TV"}
+	require.NoError(t, reg.ClientManager().CreateClient(t.Context(), cl))
+
+	createDeviceRequest := func(t *testing.T, expiresAt time.Time) string {
+		deviceRequest := fosite.NewDeviceRequest()
+		deviceRequest.ID = uuidx.NewV4().String()
+		deviceRequest.Client = cl
+		deviceRequest.RequestedAt = time.Now().UTC()
+		deviceRequest.RequestedScope = fosite.Arguments{"offline_access"}
+		deviceRequest.SetSession(oauth2.NewTestSession(t, ""))
+		deviceRequest.Session.SetExpiresAt(fosite.DeviceCode, expiresAt)
+		deviceRequest.Session.SetExpiresAt(fosite.UserCode, expiresAt)
+
+		_, deviceCodeSig, err := reg.DeviceCodeStrategy().GenerateDeviceCode(t.Context())
+		require.NoError(t, err)
+		userCode, sig, err := reg.UserCodeStrategy().GenerateUserCode(t.Context())
+		require.NoError(t, err)
+		require.NoError(t, reg.OAuth2Storage().CreateDeviceAuthSession(t.Context(), deviceCodeSig, sig, deviceRequest))
+		return userCode
+	}
+
+	do := func(t *testing.T, method, path string) (*http.Response, []byte) {
+		req, err := http.NewRequest(method, ts.URL+"/admin"+DevicePath+path, nil)
+		require.NoError(t, err)
+		resp, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp, ioutilx.MustReadAll(resp.Body)
+	}
+
+	userCode := createDeviceRequest(t, time.Now().Add(time.Hour).UTC())
+	_ = createDeviceRequest(t, time.Now().Add(-time.Hour).UTC())
+
+	t.Run("case=gets device request by user code", func(t *testing.T) {
+		resp, body := do(t, http.MethodGet, "?user_code="+userCode)
+		require.EqualValues(t, http.StatusOK, resp.StatusCode, "%s", body)
+
+		var dr DeviceRequest
+		require.NoError(t, json.Unmarshal(body, &dr))
+		assert.Equal(t, cl.GetID(), dr.ClientID)
+		assert.Equal(t, "Living Room TV", dr.ClientName)
+		assert.Equal(t, []string{"offline_access"}, dr.RequestedScope)
+		assert.Equal(t, DeviceRequestStatePending, dr.State)
+	})
+
+	t.Run("case=lists only pending device requests", func(t *testing.T) {
+		resp, body := do(t, http.MethodGet, "/pending?client_id="+cl.GetID())
+		require.EqualValues(t, http.StatusOK, resp.StatusCode, "%s", body)
+
+		var list []DeviceRequest
+		require.NoError(t, json.Unmarshal(body, &list))
+		require.Len(t, list, 1)
+		assert.Equal(t, DeviceRequestStatePending, list[0].State)
+	})
+
+	t.Run("case=rejects device request", func(t *testing.T) {
+		resp, body := do(t, http.MethodPut, "/reject?user_code="+userCode)
+		require.EqualValues(t, http.StatusOK, resp.StatusCode, "%s", body)
+
+		var dr DeviceRequest
+		require.NoError(t, json.Unmarshal(body, &dr))
+		assert.Equal(t, DeviceRequestStateRejected, dr.State)
+
+		resp, body = do(t, http.MethodGet, "?user_code="+userCode)
+		require.EqualValues(t, http.StatusOK, resp.StatusCode, "%s", body)
+		require.NoError(t, json.Unmarshal(body, &dr))
+		assert.Equal(t, DeviceRequestStateRejected, dr.State)
+
+		resp, body = do(t, http.MethodGet, "/pending?client_id="+cl.GetID())
+		require.EqualValues(t, http.StatusOK, resp.StatusCode, "%s", body)
+		assert.JSONEq(t, "[]", string(body))
+	})
+
+	t.Run("case=can not reject a used user code", func(t *testing.T) {
+		resp, _ := do(t, http.MethodPut, "/reject?user_code="+userCode)
+		assert.EqualValues(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("case=unknown user code is not found", func(t *testing.T) {
+		unknown, _, err := reg.UserCodeStrategy().GenerateUserCode(t.Context())
+		require.NoError(t, err)
+
+		resp, _ := do(t, http.MethodGet, "?user_code="+unknown)
+		assert.EqualValues(t, http.StatusNotFound, resp.StatusCode)
+		resp, _ = do(t, http.MethodPut, "/reject?user_code="+unknown)
+		assert.EqualValues(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("case=requires parameters", func(t *testing.T) {
+		resp, _ := do(t, http.MethodGet, "")
+		assert.EqualValues(t, http.StatusBadRequest, resp.StatusCode)
+		resp, _ = do(t, http.MethodGet, "/pending")
+		assert.EqualValues(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
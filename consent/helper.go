@@ -5,9 +5,14 @@ package consent
 
 import (
 	"net/url"
+	"slices"
 	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/flow"
 	"github.com/ory/hydra/v2/fosite"
 )
 
@@ -46,3 +51,41 @@ func caseInsensitiveFilterParam(q url.Values, key string) url.Values {
 	}
 	return query
 }
+
+// validateAuthenticationContext makes sure that the authentication of the flow
+// satisfies the policies of the client. The ranking orders the known
+// authentication context class references from the weakest to the strongest.
+// If enforceACRValues is set, the acr must also satisfy one of the requested
+// acr_values.
+func validateAuthenticationContext(ranking []string, enforceACRValues bool, f *flow.Flow) error {
+	if f.Client == nil {
+		return nil
+	}
+
+	if f.Client.RequireAuthTime && time.Time(f.LoginAuthenticatedAt).IsZero() {
+		return errors.WithStack(fosite.ErrUnmetAuthenticationRequirements.WithHint("The OAuth 2.0 Client requires the time of the authentication but it is unknown."))
+	}
+
+	if f.Client.MinimumACR != "" && !fosite.ACRSatisfies(ranking, f.ACR, f.Client.MinimumACR) {
+		return errors.WithStack(fosite.ErrUnmetAuthenticationRequirements.WithHintf("The OAuth 2.0 Client requires an authentication context class reference of at least '%s' but got '%s'.", f.Client.MinimumACR, f.ACR))
+	}
+
+	if !enforceACRValues || f.OpenIDConnectContext == nil || len(f.OpenIDConnectContext.ACRValues) == 0 {
+		return nil
+	}
+
+	requested := f.OpenIDConnectContext.ACRValues
+	if !acrSatisfiesAny(ranking, f.ACR, requested) {
+		return errors.WithStack(fosite.ErrUnmetAuthenticationRequirements.WithHintf("The authentication context class reference '%s' does not satisfy any of the requested acr_values '%s'.", f.ACR, strings.Join(requested, " ")))
+	}
+
+	return nil
+}
+
+// acrSatisfiesAny reports whether the authentication context class reference
+// satisfies at least one of the requested acr_values.
+func acrSatisfiesAny(ranking []string, acr string, acrValues []string) bool {
+	return slices.ContainsFunc(acrValues, func(required string) bool {
+		return fosite.ACRSatisfies(ranking, acr, required)
+	})
+}
@@ -20,7 +20,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/flow"
 	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/x/sqlxx"
 )
 
 func TestSanitizeClient(t *testing.T) {
@@ -64,6 +66,55 @@ func TestMatchScopes(t *testing.T) {
 	}
 }
 
+func TestValidateAuthenticationContext(t *testing.T) {
+	ranking := []string{"pwd", "otp", "mfa"}
+	now := sqlxx.NullTime(time.Now())
+	for k, tc := range []struct {
+		client      *client.Client
+		acr         string
+		acrValues   []string
+		authTime    sqlxx.NullTime
+		enforce     bool
+		expectError bool
+	}{
+		{client: &client.Client{}, acr: ""},
+		{client: &client.Client{RequireAuthTime: true}, authTime: now},
+		{client: &client.Client{RequireAuthTime: true}, expectError: true},
+		{client: &client.Client{MinimumACR: "otp"}, acr: "otp"},
+		{client: &client.Client{MinimumACR: "otp"}, acr: "mfa"},
+		{client: &client.Client{MinimumACR: "otp"}, acr: "pwd", expectError: true},
+		{client: &client.Client{MinimumACR: "otp"}, acr: "unknown", expectError: true},
+		{client: &client.Client{}, acr: "pwd", acrValues: []string{"mfa"}},
+		{client: &client.Client{}, acr: "pwd", acrValues: []string{"mfa"}, enforce: true, expectError: true},
+		{client: &client.Client{}, acr: "mfa", acrValues: []string{"otp"}, enforce: true},
+		{client: &client.Client{}, acr: "otp", acrValues: []string{"mfa", "otp"}, enforce: true},
+		{client: &client.Client{}, acr: "pwd", enforce: true},
+	} {
+		t.Run(fmt.Sprintf("case=%d", k), func(t *testing.T) {
+			err := validateAuthenticationContext(ranking, tc.enforce, &flow.Flow{
+				Client:               tc.client,
+				ACR:                  tc.acr,
+				LoginAuthenticatedAt: tc.authTime,
+				OpenIDConnectContext: &flow.OAuth2ConsentRequestOpenIDConnectContext{ACRValues: tc.acrValues},
+			})
+			if tc.expectError {
+				assert.ErrorIs(t, err, fosite.ErrUnmetAuthenticationRequirements)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestACRSatisfiesAny(t *testing.T) {
+	ranking := []string{"pwd", "otp", "mfa"}
+	assert.True(t, acrSatisfiesAny(ranking, "mfa", []string{"otp"}))
+	assert.True(t, acrSatisfiesAny(ranking, "otp", []string{"mfa", "otp"}))
+	assert.False(t, acrSatisfiesAny(ranking, "pwd", []string{"mfa", "otp"}))
+	assert.False(t, acrSatisfiesAny(ranking, "", []string{"pwd"}))
+	assert.False(t, acrSatisfiesAny(ranking, "mfa", nil))
+}
+
 func TestValidateCsrfSession(t *testing.T) {
 	const name = "oauth2_authentication_csrf"
 
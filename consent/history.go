@@ -0,0 +1,112 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package consent
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/x/pointerx"
+	"github.com/ory/x/sqlxx"
+)
+
+const (
+	// ConsentEventGranted is recorded when a subject grants consent to a
+	// client for the first time, or again after revoking it.
+	ConsentEventGranted = "granted"
+	// ConsentEventModified is recorded when a subject grants consent to a
+	// client which still holds an earlier consent.
+	ConsentEventModified = "modified"
+	// ConsentEventRevoked is recorded when a consent is revoked.
+	ConsentEventRevoked = "revoked"
+)
+
+// Consent History Entry
+//
+// An immutable record of a consent being granted, modified or revoked.
+//
+// swagger:model consentHistoryEntry
+type ConsentHistoryEntry struct {
+	// ID identifies the entry. It is used as the consent receipt ID.
+	ID  uuid.UUID `json:"id" db:"id"`
+	NID uuid.UUID `json:"-" db:"nid"`
+
+	// Event is one of `granted`, `modified` or `revoked`.
+	Event string `json:"event" db:"event"`
+
+	// Subject is the subject who granted the consent.
+	Subject string `json:"subject" db:"subject"`
+
+	// ClientID is the OAuth 2.0 Client the consent was granted to.
+	ClientID string `json:"client_id" db:"client_id"`
+
+	// ConsentRequestID is the consent request the consent was granted in.
+	ConsentRequestID string `json:"consent_request_id" db:"consent_request_id"`
+
+	// GrantedScope is the scope granted by the consent.
+	GrantedScope sqlxx.StringSliceJSONFormat `json:"granted_scope" db:"granted_scope"`
+
+	// GrantedAudience is the access token audience granted by the consent.
+	GrantedAudience sqlxx.StringSliceJSONFormat `json:"granted_audience" db:"granted_audience"`
+
+	// Remember is true if the consent is remembered for subsequent requests.
+	Remember bool `json:"remember" db:"remember"`
+
+	// RememberFor is how long the consent is remembered in seconds. Zero means forever.
+	RememberFor int `json:"remember_for" db:"remember_for"`
+
+	// ConsentedAt is the time the consent was granted.
+	ConsentedAt time.Time `json:"consented_at" db:"consented_at"`
+
+	// ExpiresAt is the time the remembered consent expires, if it expires.
+	ExpiresAt sqlxx.NullTime `json:"expires_at,omitempty" db:"expires_at"`
+
+	// RevocationReason is the reason given when the consent was revoked.
+	RevocationReason string `json:"revocation_reason,omitempty" db:"revocation_reason"`
+
+	// CreatedAt is the time the event was recorded.
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// List of Consent History Entries
+//
+// swagger:model consentHistoryEntries
+type _ []ConsentHistoryEntry
+
+func (ConsentHistoryEntry) TableName() string {
+	return "hydra_oauth2_consent_history"
+}
+
+// NewConsentHistoryEntry records the consent granted in the flow.
+func NewConsentHistoryEntry(f *flow.Flow, event string, now time.Time) *ConsentHistoryEntry {
+	e := &ConsentHistoryEntry{
+		ID:               uuid.Must(uuid.NewV4()),
+		NID:              f.NID,
+		Event:            event,
+		Subject:          f.Subject,
+		ClientID:         f.ClientID,
+		ConsentRequestID: f.ConsentRequestID.String(),
+		GrantedScope:     f.GrantedScope,
+		GrantedAudience:  f.GrantedAudience,
+		Remember:         f.ConsentRemember,
+		RememberFor:      pointerx.Deref(f.ConsentRememberFor),
+		ConsentedAt:      time.Time(f.ConsentHandledAt).UTC(),
+		CreatedAt:        now.UTC(),
+	}
+	if e.ConsentedAt.IsZero() {
+		e.ConsentedAt = e.CreatedAt
+	}
+	if e.Remember && e.RememberFor > 0 {
+		e.ExpiresAt = sqlxx.NullTime(e.ConsentedAt.Add(time.Duration(e.RememberFor) * time.Second))
+	}
+	if e.GrantedScope == nil {
+		e.GrantedScope = sqlxx.StringSliceJSONFormat{}
+	}
+	if e.GrantedAudience == nil {
+		e.GrantedAudience = sqlxx.StringSliceJSONFormat{}
+	}
+	return e
+}
@@ -26,15 +26,26 @@ func (ForcedObfuscatedLoginSession) TableName() string {
 
 type (
 	Manager interface {
-		RevokeSubjectConsentSession(ctx context.Context, subject string) error
-		RevokeSubjectClientConsentSession(ctx context.Context, subject, client string) error
-		RevokeConsentSessionByID(ctx context.Context, consentRequestID string) error
+		// RevokeSubjectConsentSession, RevokeSubjectClientConsentSession and
+		// RevokeConsentSessionByID record the revocation and the reason in the
+		// consent history.
+		RevokeSubjectConsentSession(ctx context.Context, subject, reason string) error
+		RevokeSubjectClientConsentSession(ctx context.Context, subject, client, reason string) error
+		RevokeConsentSessionByID(ctx context.Context, consentRequestID, reason string) error
 
 		CreateConsentSession(ctx context.Context, f *flow.Flow) error
 		FindGrantedAndRememberedConsentRequest(ctx context.Context, client, subject string) (*flow.Flow, error)
 		FindSubjectsGrantedConsentRequests(ctx context.Context, subject string, pageOpts ...keysetpagination.Option) ([]flow.Flow, *keysetpagination.Paginator, error)
 		FindSubjectsSessionGrantedConsentRequests(ctx context.Context, subject, sid string, pageOpts ...keysetpagination.Option) ([]flow.Flow, *keysetpagination.Paginator, error)
 
+		// ListSubjectConsentHistory returns the consent history of the
+		// subject, oldest first. Unlike granted consent requests, the history
+		// keeps revoked and expired consents.
+		ListSubjectConsentHistory(ctx context.Context, subject string, pageOpts ...keysetpagination.Option) ([]ConsentHistoryEntry, *keysetpagination.Paginator, error)
+		// DeleteSubjectConsentHistory removes the consent history of the
+		// subject. It is no error if the subject has no history.
+		DeleteSubjectConsentHistory(ctx context.Context, subject string) error
+
 		// ListClientsWithLogoutURLsForSubjectAndSID returns the clients for
 		// which to call front-channel and back-channel logout endpoints when
 		// the subject logs out of the session with ID sid.
@@ -91,6 +102,41 @@ type (
 		// see the interface documentation.
 		VerifyAndInvalidateLogoutRequest(ctx context.Context, verifier string) (*flow.LogoutRequest, error)
 	}
+	// PairwiseSubjectManager persists the pairwise subject identifiers issued
+	// to clients.
+	PairwiseSubjectManager interface {
+		// GetPairwiseSubject returns the identifier issued to the client for
+		// the subject, or x.ErrNotFound.
+		GetPairwiseSubject(ctx context.Context, clientID, subject string) (*PairwiseSubject, error)
+
+		// FindPairwiseSubject returns the identifier issued to the client
+		// which is, or was before a migration, the given pairwise subject.
+		FindPairwiseSubject(ctx context.Context, clientID, pairwiseSubject string) (*PairwiseSubject, error)
+
+		// CreatePairwiseSubject pins the identifier. It returns
+		// sqlcon.ErrUniqueViolation if an identifier is pinned already.
+		CreatePairwiseSubject(ctx context.Context, ps *PairwiseSubject) error
+
+		// SetPairwiseSubjects replaces the identifiers of the client for the
+		// subjects in a single transaction.
+		SetPairwiseSubjects(ctx context.Context, clientID string, ps []PairwiseSubject) error
+
+		// ListClientSubjects returns all subjects which logged in to the
+		// client or were issued an identifier by it.
+		ListClientSubjects(ctx context.Context, clientID string) ([]string, error)
+	}
+	// SubjectEraser removes all data stored about a subject.
+	SubjectEraser interface {
+		// ListSubjectLoginSessionIDs returns the IDs of all login sessions of
+		// the subject.
+		ListSubjectLoginSessionIDs(ctx context.Context, subject string) ([]string, error)
+
+		// EraseSubject deletes all rows referencing the subject, including
+		// rows referencing one of its pairwise identifiers, in a single
+		// transaction. Tokens of the subject are revoked. It returns the
+		// number of deleted rows per table.
+		EraseSubject(ctx context.Context, subject string) (deleted map[string]int, err error)
+	}
 
 	ManagerProvider interface {
 		ConsentManager() Manager
@@ -104,4 +150,10 @@ type (
 	LogoutManagerProvider interface {
 		LogoutManager() LogoutManager
 	}
+	PairwiseSubjectManagerProvider interface {
+		PairwiseSubjectManager() PairwiseSubjectManager
+	}
+	SubjectEraserProvider interface {
+		SubjectEraser() SubjectEraser
+	}
 )
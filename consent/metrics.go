@@ -0,0 +1,40 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package consent
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	flowsStarted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "hydra",
+		Subsystem: "flow",
+		Name:      "started_total",
+		Help:      "Number of login, consent and device flows forwarded to the respective UI.",
+	}, []string{"flow"})
+	flowDecisions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "hydra",
+		Subsystem: "flow",
+		Name:      "decisions_total",
+		Help:      "Number of login, consent and device requests accepted or rejected by the respective UI.",
+	}, []string{"flow", "decision"})
+	flowsCompleted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "hydra",
+		Subsystem: "flow",
+		Name:      "completed_total",
+		Help:      "Number of login, consent and device flows whose verifier was redeemed successfully. Flows which were started but not completed have been abandoned or failed.",
+	}, []string{"flow"})
+)
+
+// Flows and decisions reported by the hydra_flow_* metrics.
+const (
+	metricsFlowLogin   = "login"
+	metricsFlowConsent = "consent"
+	metricsFlowDevice  = "device"
+
+	metricsDecisionAccepted = "accepted"
+	metricsDecisionRejected = "rejected"
+)
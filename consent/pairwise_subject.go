@@ -0,0 +1,225 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package consent
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/sqlcon"
+)
+
+// Pairwise Subject
+//
+// The pairwise subject identifier issued to an OAuth 2.0 Client for a subject. Once issued, the identifier is kept,
+// even if the client's sector identifier or the salt changes.
+//
+// swagger:model pairwiseSubject
+type PairwiseSubject struct {
+	NID uuid.UUID `json:"-" db:"nid"`
+
+	// ClientID is the OAuth 2.0 Client the identifier is issued to.
+	ClientID string `json:"client_id" db:"client_id"`
+
+	// Subject is the subject the identifier stands for.
+	Subject string `json:"subject" db:"subject"`
+
+	// PairwiseSubject is the identifier issued to the client as `sub`.
+	PairwiseSubject string `json:"pairwise_subject" db:"pairwise_subject"`
+
+	// PreviousPairwiseSubject is the identifier issued to the client before a migration, if it differs.
+	PreviousPairwiseSubject string `json:"previous_pairwise_subject,omitempty" db:"previous_pairwise_subject"`
+
+	// SectorIdentifier is the sector identifier the identifier was computed for.
+	SectorIdentifier string `json:"sector_identifier" db:"sector_identifier"`
+
+	// CreatedAt is the time the identifier was first issued or migrated.
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+func (PairwiseSubject) TableName() string {
+	return "hydra_oauth2_pairwise_subject"
+}
+
+// List of Pairwise Subjects
+//
+// swagger:model pairwiseSubjects
+type _ []PairwiseSubject
+
+// Pairwise Subject Migration
+//
+// Describes how the subject identifiers of an OAuth 2.0 Client were computed before its subject type, sector
+// identifier or the salt changed.
+//
+// swagger:model pairwiseSubjectMigration
+type PairwiseSubjectMigration struct {
+	// ClientID is the OAuth 2.0 Client to migrate. It must use the `pairwise` subject type.
+	//
+	// required: true
+	ClientID string `json:"client_id"`
+
+	// PreviousSubjectType is the subject type the client used before: `public` or `pairwise`. Defaults to `pairwise`.
+	PreviousSubjectType string `json:"previous_subject_type"`
+
+	// PreviousSectorIdentifier is the sector identifier the client used before: its `sector_identifier_uri`, or the
+	// host of its only redirect URI. Defaults to the current sector identifier.
+	PreviousSectorIdentifier string `json:"previous_sector_identifier"`
+
+	// UsePreviousSalt computes the previous identifiers with the most recent of the salts configured at
+	// `oidc.subject_identifiers.pairwise.previous_salts` instead of the current salt.
+	UsePreviousSalt bool `json:"use_previous_salt"`
+
+	// KeepPreviousIdentifiers keeps issuing the previous identifiers to the client. Otherwise, the client is issued
+	// the identifiers computed from its current configuration, and the previous identifiers are recorded.
+	KeepPreviousIdentifiers bool `json:"keep_previous_identifiers"`
+
+	// DryRun returns the mapping without persisting it.
+	DryRun bool `json:"dry_run"`
+}
+
+// pinPairwiseSubject returns the pairwise subject identifier issued to the
+// client for the subject. If none was issued yet, it is computed from the
+// client's current sector identifier and the current salt, and persisted.
+func pinPairwiseSubject(ctx context.Context, r InternalRegistry, c *client.Client, subject string) (*PairwiseSubject, error) {
+	ps, err := r.PairwiseSubjectManager().GetPairwiseSubject(ctx, c.GetID(), subject)
+	if err == nil {
+		return ps, nil
+	} else if !errors.Is(err, x.ErrNotFound) {
+		return nil, err
+	}
+
+	sector, err := pairwiseSectorIdentifier(c)
+	if err != nil {
+		return nil, err
+	}
+
+	ps = &PairwiseSubject{
+		ClientID:         c.GetID(),
+		Subject:          subject,
+		PairwiseSubject:  pairwiseHash(r.Config().SubjectIdentifierAlgorithmSalt(ctx), subject, sector),
+		SectorIdentifier: sector,
+		CreatedAt:        time.Now().UTC().Truncate(time.Second),
+	}
+	if err := r.PairwiseSubjectManager().CreatePairwiseSubject(ctx, ps); errors.Is(err, sqlcon.ErrUniqueViolation()) {
+		// The identifier was pinned concurrently.
+		return r.PairwiseSubjectManager().GetPairwiseSubject(ctx, c.GetID(), subject)
+	} else if err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// resolvePairwiseSubject returns the subject the pairwise subject identifier
+// issued to the client stands for. Identifiers which were never pinned are
+// looked up by computing the identifiers of the client's subjects with the
+// current and all previous salts.
+func resolvePairwiseSubject(ctx context.Context, r InternalRegistry, c *client.Client, pairwiseSubject string) (*PairwiseSubject, error) {
+	ps, err := r.PairwiseSubjectManager().FindPairwiseSubject(ctx, c.GetID(), pairwiseSubject)
+	if err == nil {
+		return ps, nil
+	} else if !errors.Is(err, x.ErrNotFound) {
+		return nil, err
+	}
+
+	sector, err := pairwiseSectorIdentifier(c)
+	if err != nil {
+		return nil, err
+	}
+
+	subjects, err := r.PairwiseSubjectManager().ListClientSubjects(ctx, c.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	salts := append([]string{r.Config().SubjectIdentifierAlgorithmSalt(ctx)}, r.Config().SubjectIdentifierAlgorithmPreviousSalts(ctx)...)
+	for _, subject := range subjects {
+		for _, salt := range salts {
+			if pairwiseHash(salt, subject, sector) == pairwiseSubject {
+				return &PairwiseSubject{
+					ClientID:         c.GetID(),
+					Subject:          subject,
+					PairwiseSubject:  pairwiseSubject,
+					SectorIdentifier: sector,
+				}, nil
+			}
+		}
+	}
+
+	return nil, errors.WithStack(x.ErrNotFound)
+}
+
+// migratePairwiseSubjects records the mapping of the previous to the current
+// subject identifiers for all subjects which used the client.
+func migratePairwiseSubjects(ctx context.Context, r InternalRegistry, c *client.Client, m *PairwiseSubjectMigration) ([]PairwiseSubject, error) {
+	if c.SubjectType != "pairwise" {
+		return nil, errors.WithStack(fosite.ErrInvalidRequest.WithHintf("OAuth 2.0 Client %s does not use the pairwise subject type.", c.GetID()))
+	}
+
+	sector, err := pairwiseSectorIdentifier(c)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := r.Config().SubjectIdentifierAlgorithmSalt(ctx)
+	previousSalt, previousSector := salt, sector
+	if m.UsePreviousSalt {
+		previous := r.Config().SubjectIdentifierAlgorithmPreviousSalts(ctx)
+		if len(previous) == 0 {
+			return nil, errors.WithStack(fosite.ErrInvalidRequest.WithHint("No previous salt is configured at oidc.subject_identifiers.pairwise.previous_salts."))
+		}
+		previousSalt = previous[0]
+	}
+	if m.PreviousSectorIdentifier != "" {
+		previousSector = m.PreviousSectorIdentifier
+	}
+
+	previousIdentifier := func(subject string) string {
+		return pairwiseHash(previousSalt, subject, previousSector)
+	}
+	switch m.PreviousSubjectType {
+	case "", "pairwise":
+	case "public":
+		previousSector = ""
+		previousIdentifier = func(subject string) string { return subject }
+	default:
+		return nil, errors.WithStack(fosite.ErrInvalidRequest.WithHintf("Previous subject type %s is not supported, only public and pairwise are allowed.", m.PreviousSubjectType))
+	}
+
+	subjects, err := r.PairwiseSubjectManager().ListClientSubjects(ctx, c.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	mapping := make([]PairwiseSubject, 0, len(subjects))
+	for _, subject := range subjects {
+		ps := PairwiseSubject{
+			ClientID:         c.GetID(),
+			Subject:          subject,
+			PairwiseSubject:  pairwiseHash(salt, subject, sector),
+			SectorIdentifier: sector,
+			CreatedAt:        now,
+		}
+		if previous := previousIdentifier(subject); m.KeepPreviousIdentifiers {
+			ps.PairwiseSubject, ps.SectorIdentifier = previous, previousSector
+		} else if previous != ps.PairwiseSubject {
+			ps.PreviousPairwiseSubject = previous
+		}
+		mapping = append(mapping, ps)
+	}
+
+	if m.DryRun {
+		return mapping, nil
+	}
+	if err := r.PairwiseSubjectManager().SetPairwiseSubjects(ctx, c.GetID(), mapping); err != nil {
+		return nil, err
+	}
+	return mapping, nil
+}
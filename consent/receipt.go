@@ -0,0 +1,258 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package consent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite/token/jwt"
+	"github.com/ory/hydra/v2/oauth2/scope"
+)
+
+// ConsentReceiptVersion is the version of the Kantara Initiative Consent
+// Receipt Specification the receipts follow.
+const ConsentReceiptVersion = "KI-CR-v1.1.0"
+
+// Consent Receipt
+//
+// A consent receipt as defined by the Kantara Initiative Consent Receipt Specification v1.1. Each receipt
+// documents one entry of the consent history.
+//
+// swagger:model consentReceipt
+type ConsentReceipt struct {
+	// Version is the version of the consent receipt specification.
+	Version string `json:"version"`
+
+	// Jurisdiction is the jurisdiction(s) applicable to the consent.
+	Jurisdiction string `json:"jurisdiction"`
+
+	// ConsentTimestamp is the time the consent was granted as Unix time.
+	ConsentTimestamp int64 `json:"consentTimestamp"`
+
+	// CollectionMethod describes how the consent was collected.
+	CollectionMethod string `json:"collectionMethod"`
+
+	// ConsentReceiptID identifies the receipt. It is the ID of the consent history entry.
+	ConsentReceiptID string `json:"consentReceiptID"`
+
+	// Language is the language of the receipt.
+	Language string `json:"language"`
+
+	// PIIPrincipalID is the subject who granted the consent.
+	PIIPrincipalID string `json:"piiPrincipalId"`
+
+	// PIIControllers are the data controllers the consent was given to.
+	PIIControllers []ConsentReceiptPIIController `json:"piiControllers"`
+
+	// PolicyURL is the URL of the privacy policy applicable to the consent.
+	PolicyURL string `json:"policyUrl"`
+
+	// Services are the services the consent was given for.
+	Services []ConsentReceiptService `json:"services"`
+
+	// Sensitive is true if a scope registered with high sensitivity was granted.
+	Sensitive bool `json:"sensitive"`
+
+	// SPICat lists the high sensitivity scopes which were granted.
+	SPICat []string `json:"spiCat"`
+
+	// Event is the consent history event the receipt documents: `granted`, `modified` or `revoked`.
+	Event string `json:"event"`
+
+	// ConsentRequestID is the consent request the consent was granted in.
+	ConsentRequestID string `json:"consentRequestID"`
+
+	// RevocationReason is the reason given when the consent was revoked.
+	RevocationReason string `json:"revocationReason,omitempty"`
+}
+
+// Consent Receipt PII Controller
+//
+// swagger:model consentReceiptPIIController
+type ConsentReceiptPIIController struct {
+	// PIIController is the name of the data controller.
+	PIIController string `json:"piiController"`
+
+	// OnBehalf is true if the controller collects the consent on behalf of another controller.
+	OnBehalf bool `json:"onBehalf"`
+
+	// Contact is the contact person or department.
+	Contact string `json:"contact"`
+
+	// Address is the postal address of the controller.
+	Address string `json:"address"`
+
+	// Email is the email address of the contact.
+	Email string `json:"email"`
+
+	// Phone is the phone number of the contact.
+	Phone string `json:"phone"`
+
+	// PIIControllerURL is the URL of the controller.
+	PIIControllerURL string `json:"piiControllerUrl,omitempty"`
+}
+
+// Consent Receipt Service
+//
+// swagger:model consentReceiptService
+type ConsentReceiptService struct {
+	// Service is the name of the OAuth 2.0 Client, or its ID if it has no name.
+	Service string `json:"service"`
+
+	// Purposes are the granted scopes.
+	Purposes []ConsentReceiptPurpose `json:"purposes"`
+}
+
+// Consent Receipt Purpose
+//
+// swagger:model consentReceiptPurpose
+type ConsentReceiptPurpose struct {
+	// Purpose is the description of the scope, or the scope itself if it is not registered.
+	Purpose string `json:"purpose"`
+
+	// PurposeCategory is the scope.
+	PurposeCategory []string `json:"purposeCategory"`
+
+	// ConsentType is always `EXPLICIT`.
+	ConsentType string `json:"consentType"`
+
+	// PIICategory is the scope.
+	PIICategory []string `json:"piiCategory"`
+
+	// PrimaryPurpose is always true.
+	PrimaryPurpose bool `json:"primaryPurpose"`
+
+	// Termination describes when the consent ends.
+	Termination string `json:"termination"`
+
+	// ThirdPartyDisclosure is true if an access token audience was granted.
+	ThirdPartyDisclosure bool `json:"thirdPartyDisclosure"`
+
+	// ThirdPartyName lists the granted access token audience.
+	ThirdPartyName string `json:"thirdPartyName,omitempty"`
+}
+
+// List of Signed Consent Receipts
+//
+// swagger:model signedConsentReceipts
+type _ []SignedConsentReceipt
+
+// Signed Consent Receipt
+//
+// swagger:model signedConsentReceipt
+type SignedConsentReceipt struct {
+	// Receipt is the consent receipt.
+	Receipt *ConsentReceipt `json:"receipt"`
+
+	// JWT is the consent receipt signed with the OpenID Connect ID token signing key.
+	JWT string `json:"jwt"`
+}
+
+// NewConsentReceipt documents the consent history entry. The client is nil if
+// it was deleted, and the scopes are the registered scopes among the granted
+// ones.
+func NewConsentReceipt(e *ConsentHistoryEntry, cl *client.Client, scopes []scope.Scope, c *config.ConsentReceiptsConfig) *ConsentReceipt {
+	service := e.ClientID
+	if cl != nil && cl.Name != "" {
+		service = cl.Name
+	}
+
+	var termination string
+	switch {
+	case e.Event == ConsentEventRevoked:
+		termination = fmt.Sprintf("Revoked at %s.", e.CreatedAt.UTC().Format(time.RFC3339))
+		if e.RevocationReason != "" {
+			termination = fmt.Sprintf("Revoked at %s: %s", e.CreatedAt.UTC().Format(time.RFC3339), e.RevocationReason)
+		}
+	case !time.Time(e.ExpiresAt).IsZero():
+		termination = fmt.Sprintf("Expires at %s or when revoked.", time.Time(e.ExpiresAt).UTC().Format(time.RFC3339))
+	default:
+		termination = "When revoked."
+	}
+
+	registered := make(map[string]scope.Scope, len(scopes))
+	for _, s := range scopes {
+		registered[s.Name] = s
+	}
+
+	r := &ConsentReceipt{
+		Version:          ConsentReceiptVersion,
+		Jurisdiction:     c.Jurisdiction,
+		ConsentTimestamp: e.ConsentedAt.Unix(),
+		CollectionMethod: "OAuth 2.0 consent request",
+		ConsentReceiptID: e.ID.String(),
+		Language:         "en",
+		PIIPrincipalID:   e.Subject,
+		PIIControllers: []ConsentReceiptPIIController{{
+			PIIController:    c.Controller.Name,
+			Contact:          c.Controller.Contact,
+			Address:          c.Controller.Address,
+			Email:            c.Controller.Email,
+			Phone:            c.Controller.Phone,
+			PIIControllerURL: c.Controller.URL,
+		}},
+		PolicyURL:        c.PolicyURL,
+		Services:         []ConsentReceiptService{{Service: service, Purposes: []ConsentReceiptPurpose{}}},
+		SPICat:           []string{},
+		Event:            e.Event,
+		ConsentRequestID: e.ConsentRequestID,
+		RevocationReason: e.RevocationReason,
+	}
+	if r.PolicyURL == "" && cl != nil {
+		r.PolicyURL = cl.PolicyURI
+	}
+
+	for _, name := range e.GrantedScope {
+		purpose := name
+		if s, ok := registered[name]; ok {
+			if s.Description != "" {
+				purpose = s.Description
+			}
+			if s.Sensitivity == scope.SensitivityHigh {
+				r.Sensitive = true
+				r.SPICat = append(r.SPICat, name)
+			}
+		}
+
+		r.Services[0].Purposes = append(r.Services[0].Purposes, ConsentReceiptPurpose{
+			Purpose:              purpose,
+			PurposeCategory:      []string{name},
+			ConsentType:          "EXPLICIT",
+			PIICategory:          []string{name},
+			PrimaryPurpose:       true,
+			Termination:          termination,
+			ThirdPartyDisclosure: len(e.GrantedAudience) > 0,
+			ThirdPartyName:       strings.Join(e.GrantedAudience, " "),
+		})
+	}
+
+	return r
+}
+
+// ToMapClaims returns the claims of the signed receipt. The receipt ID is used
+// as the JWT ID and the subject as the JWT subject.
+func (r *ConsentReceipt) ToMapClaims(issuer string, issuedAt time.Time) (jwt.MapClaims, error) {
+	raw, err := json.Marshal(r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var claims jwt.MapClaims
+	if err := json.Unmarshal(raw, &claims); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	claims["iss"] = issuer
+	claims["sub"] = r.PIIPrincipalID
+	claims["jti"] = r.ConsentReceiptID
+	claims["iat"] = issuedAt.Unix()
+	return claims, nil
+}
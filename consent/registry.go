@@ -8,6 +8,7 @@ import (
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/fosite/handler/openid"
 	"github.com/ory/hydra/v2/internal/kratos"
+	"github.com/ory/hydra/v2/oauth2/scope"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/httpx"
 	"github.com/ory/x/logrusx"
@@ -24,6 +25,7 @@ type InternalRegistry interface {
 	kratos.Provider
 	Registry
 	client.Registry
+	scope.Registry
 
 	FlowCipher() *aead.XChaCha20Poly1305
 	OAuth2Storage() x.FositeStorer
@@ -35,6 +37,8 @@ type Registry interface {
 	ObfuscatedSubjectManagerProvider
 	LoginManagerProvider
 	LogoutManagerProvider
+	PairwiseSubjectManagerProvider
+	SubjectEraserProvider
 
 	ConsentStrategy() Strategy
 }
@@ -27,5 +27,6 @@ type Strategy interface {
 	) (*flow.Flow, error)
 	HandleOpenIDConnectLogout(ctx context.Context, w http.ResponseWriter, r *http.Request) (*flow.LogoutResult, error)
 	HandleHeadlessLogout(ctx context.Context, w http.ResponseWriter, r *http.Request, sid string) error
+	HandleSubjectErasure(ctx context.Context, subject string) (*SubjectErasureReport, error)
 	ObfuscateSubjectIdentifier(ctx context.Context, cl fosite.Client, subject, forcedIdentifier string) (string, error)
 }
@@ -70,7 +70,16 @@ func (s *defaultStrategy) matchesValueFromSession(ctx context.Context, c fosite.
 		forcedObfuscatedUserID = s.SubjectObfuscated
 	}
 
-	if hintSubject != sessionSubject && hintSubject != obfuscatedUserID && hintSubject != forcedObfuscatedUserID {
+	if hintSubject == sessionSubject || hintSubject == obfuscatedUserID || hintSubject == forcedObfuscatedUserID {
+		return nil
+	}
+
+	// The hint may carry the identifier issued before a pairwise subject migration.
+	if ps, err := s.r.PairwiseSubjectManager().FindPairwiseSubject(ctx, c.GetID(), hintSubject); errors.Is(err, x.ErrNotFound) {
+		return ErrHintDoesNotMatchAuthentication
+	} else if err != nil {
+		return err
+	} else if ps.Subject != sessionSubject {
 		return ErrHintDoesNotMatchAuthentication
 	}
 
@@ -206,16 +215,30 @@ func (s *defaultStrategy) forwardAuthenticationRequest(
 	subject := ""
 	authenticatedAt := time.Time{}
 
+	cl := sanitizeClientFromRequest(ar)
+
+	acrValues := stringsx.Splitx(ar.GetRequestForm().Get("acr_values"), " ")
+	if len(acrValues) == 0 {
+		acrValues = cl.DefaultACRValues
+	}
+
+	stepUp := false
 	if session != nil {
 		sessionID = session.ID
-		skip = true
 		subject = session.Subject
 		authenticatedAt = time.Time(session.AuthenticatedAt)
+
+		// The remembered authentication can only be reused if it satisfies the requested acr_values.
+		// Otherwise, the End-User has to authenticate again (step-up authentication).
+		stepUp = len(acrValues) > 0 && !acrSatisfiesAny(s.r.Config().ACRValuesSupported(ctx), session.ACR, acrValues)
+		skip = !stepUp
 	}
 
 	// Let's validate that prompt is actually not "none" if we can't skip authentication
 	prompt := stringsx.Splitx(ar.GetRequestForm().Get("prompt"), " ")
-	if slices.Contains(prompt, "none") && !skip {
+	if slices.Contains(prompt, "none") && stepUp {
+		return errors.WithStack(fosite.ErrLoginRequired.WithHint(`Prompt 'none' was requested, but the existing login session does not satisfy the requested acr_values.`))
+	} else if slices.Contains(prompt, "none") && !skip {
 		return errors.WithStack(fosite.ErrLoginRequired.WithHint(`Prompt 'none' was requested, but no existing login session was found.`))
 	}
 
@@ -243,8 +266,10 @@ func (s *defaultStrategy) forwardAuthenticationRequest(
 		idTokenHintClaims = claims
 	}
 
-	// Set the session
-	cl := sanitizeClientFromRequest(ar)
+	claimsRequest, err := flow.ParseClaimsRequest(ar.GetRequestForm().Get("claims"))
+	if err != nil {
+		return err
+	}
 
 	if f == nil {
 		// Regular grant
@@ -256,10 +281,11 @@ func (s *defaultStrategy) forwardAuthenticationRequest(
 			Subject:           subject,
 			OpenIDConnectContext: &flow.OAuth2ConsentRequestOpenIDConnectContext{
 				IDTokenHintClaims: idTokenHintClaims,
-				ACRValues:         stringsx.Splitx(ar.GetRequestForm().Get("acr_values"), " "),
+				ACRValues:         acrValues,
 				UILocales:         stringsx.Splitx(ar.GetRequestForm().Get("ui_locales"), " "),
 				Display:           ar.GetRequestForm().Get("display"),
 				LoginHint:         ar.GetRequestForm().Get("login_hint"),
+				Claims:            claimsRequest,
 			},
 			Client:               cl,
 			ClientID:             cl.ID,
@@ -313,6 +339,7 @@ func (s *defaultStrategy) forwardAuthenticationRequest(
 
 	authURL.RawQuery = query.Encode()
 
+	flowsStarted.WithLabelValues(metricsFlowLogin).Inc()
 	http.Redirect(w, r, authURL.String(), http.StatusFound)
 
 	// generate the verifier
@@ -366,6 +393,11 @@ func (s *defaultStrategy) verifyAuthentication(
 ) (_ *flow.Flow, err error) {
 	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("").Start(ctx, "DefaultStrategy.verifyAuthentication")
 	defer otelx.End(span, &err)
+	defer func() {
+		if err == nil {
+			flowsCompleted.WithLabelValues(metricsFlowLogin).Inc()
+		}
+	}()
 
 	f, err := flow.DecodeAndInvalidateLoginVerifier(ctx, s.r, verifier)
 	if err != nil {
@@ -434,6 +466,10 @@ func (s *defaultStrategy) verifyAuthentication(
 		return nil, err
 	}
 
+	if err := validateAuthenticationContext(s.r.Config().ACRValuesSupported(ctx), s.r.Config().EnforceACRValues(ctx), f); err != nil {
+		return nil, err
+	}
+
 	if f.ForceSubjectIdentifier != "" {
 		if err := s.r.ObfuscatedSubjectManager().CreateForcedObfuscatedLoginSession(ctx, &ForcedObfuscatedLoginSession{
 			Subject:           f.Subject,
@@ -463,6 +499,7 @@ func (s *defaultStrategy) verifyAuthentication(
 			IdentityProviderSessionID: f.IdentityProviderSessionID,
 			Remember:                  f.LoginRemember,
 			ExpiresAt:                 sqlxx.NullTime(time.Now().Add(rememberFor).UTC()),
+			ACR:                       f.ACR,
 		}); err != nil {
 			if errors.Is(err, sqlcon.ErrUniqueViolation()) {
 				return nil, errors.WithStack(fosite.ErrAccessDenied.WithHint("The login verifier has already been used."))
@@ -612,6 +649,7 @@ func (s *defaultStrategy) forwardConsentRequest(
 		return errors.WithStack(err)
 	}
 
+	flowsStarted.WithLabelValues(metricsFlowConsent).Inc()
 	http.Redirect(
 		w, r,
 		urlx.SetQuery(s.r.Config().ConsentURL(ctx), url.Values{"consent_challenge": {consentChallenge}}).String(),
@@ -625,6 +663,11 @@ func (s *defaultStrategy) forwardConsentRequest(
 func (s *defaultStrategy) verifyConsent(ctx context.Context, _ http.ResponseWriter, r *http.Request, verifier string) (_ *flow.Flow, err error) {
 	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("").Start(ctx, "DefaultStrategy.verifyConsent")
 	defer otelx.End(span, &err)
+	defer func() {
+		if err == nil {
+			flowsCompleted.WithLabelValues(metricsFlowConsent).Inc()
+		}
+	}()
 
 	f, err := flow.DecodeAndInvalidateConsentVerifier(ctx, s.r, verifier)
 	if errors.Is(err, sqlcon.ErrNoRows()) {
@@ -640,6 +683,10 @@ func (s *defaultStrategy) verifyConsent(ctx context.Context, _ http.ResponseWrit
 		return nil, errors.WithStack(f.ConsentError.ToRFCError())
 	}
 
+	if err := validateAuthenticationContext(s.r.Config().ACRValuesSupported(ctx), s.r.Config().EnforceACRValues(ctx), f); err != nil {
+		return nil, err
+	}
+
 	if err := s.r.ConsentManager().CreateConsentSession(ctx, f); errors.Is(err, sqlcon.ErrUniqueViolation()) {
 		return nil, errors.WithStack(fosite.ErrAccessDenied.WithHint("The consent verifier has already been used."))
 	} else if errors.Is(err, sqlcon.ErrNoRows()) {
@@ -1140,6 +1187,66 @@ func (s *defaultStrategy) HandleHeadlessLogout(ctx context.Context, _ http.Respo
 	return nil
 }
 
+// HandleSubjectErasure deletes all data stored about the subject and
+// executes the back-channel logout of all its login sessions.
+func (s *defaultStrategy) HandleSubjectErasure(ctx context.Context, subject string) (_ *SubjectErasureReport, err error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("").Start(ctx, "DefaultStrategy.HandleSubjectErasure")
+	defer otelx.End(span, &err)
+
+	sids, err := s.r.SubjectEraser().ListSubjectLoginSessionIDs(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	// As with the headless logout, the client lists must be read before the
+	// sessions are deleted.
+	clients := make(map[string][]client.Client, len(sids))
+	for _, sid := range sids {
+		_, cs, err := s.r.ConsentManager().ListClientsWithLogoutURLsForSubjectAndSID(ctx, subject, sid)
+		if err != nil {
+			return nil, err
+		}
+		clients[sid] = cs
+	}
+
+	secret, err := s.r.Config().GetGlobalSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report := NewSubjectErasureReport(secret, subject)
+	report.DeletedRows, err = s.r.SubjectEraser().EraseSubject(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	// The subject has been erased at this point, so failing to notify the
+	// clients must not fail the request. The report lists only the clients
+	// which were notified.
+	for _, sid := range sids {
+		if err := s.executeBackChannelLogout(ctx, clients[sid], sid); err != nil {
+			s.r.Logger().
+				WithError(err).
+				WithField("erasure_id", report.ID.String()).
+				Error("Unable to execute the back-channel logout of an erased subject")
+			continue
+		}
+		for _, c := range clients[sid] {
+			if !slices.Contains(report.BackChannelLogoutClients, c.GetID()) {
+				report.BackChannelLogoutClients = append(report.BackChannelLogoutClients, c.GetID())
+			}
+		}
+	}
+	slices.Sort(report.BackChannelLogoutClients)
+	report.LoggedOutSessions = len(sids)
+
+	s.r.Logger().
+		WithField("erasure_id", report.ID.String()).
+		WithField("subject_hmac", report.SubjectHMAC).
+		Info("Subject erased")
+
+	return report, nil
+}
+
 func (s *defaultStrategy) HandleOAuth2AuthorizationRequest(
 	ctx context.Context,
 	w http.ResponseWriter,
@@ -1254,8 +1361,11 @@ func (s *defaultStrategy) ObfuscateSubjectIdentifier(ctx context.Context, cl fos
 			return forcedIdentifier, nil
 		}
 
-		salt := s.r.Config().SubjectIdentifierAlgorithmSalt(ctx)
-		return pairwiseObfuscate(salt, subject, c)
+		ps, err := pinPairwiseSubject(ctx, s.r, c, subject)
+		if err != nil {
+			return "", err
+		}
+		return ps.PairwiseSubject, nil
 	} else if !ok {
 		return "", errors.New("Unable to type assert OAuth 2.0 Client to *client.Client")
 	}
@@ -1307,6 +1417,7 @@ func (s *defaultStrategy) forwardDeviceRequest(ctx context.Context, w http.Respo
 		query.Add("user_code", r.URL.Query().Get("user_code"))
 	}
 
+	flowsStarted.WithLabelValues(metricsFlowDevice).Inc()
 	http.Redirect(
 		w,
 		r,
@@ -1321,6 +1432,11 @@ func (s *defaultStrategy) forwardDeviceRequest(ctx context.Context, w http.Respo
 func (s *defaultStrategy) verifyDevice(ctx context.Context, _ http.ResponseWriter, r *http.Request, verifier string) (_ *flow.Flow, err error) {
 	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("").Start(ctx, "DefaultStrategy.verifyDevice")
 	defer otelx.End(span, &err)
+	defer func() {
+		if err == nil {
+			flowsCompleted.WithLabelValues(metricsFlowDevice).Inc()
+		}
+	}()
 
 	f, err := flow.DecodeAndInvalidateDeviceVerifier(ctx, s.r, verifier)
 	if err != nil {
@@ -15,24 +15,36 @@ import (
 )
 
 func pairwiseObfuscate(salt, subject string, client *client.Client) (string, error) {
-	// sub = SHA-256 ( sector_identifier || local_account_id || salt ).
-	var id string
+	sector, err := pairwiseSectorIdentifier(client)
+	if err != nil {
+		return "", err
+	}
+	return pairwiseHash(salt, subject, sector), nil
+}
+
+// pairwiseSectorIdentifier returns the sector identifier of the client: its
+// sector_identifier_uri, or the host of its only redirect URI.
+func pairwiseSectorIdentifier(client *client.Client) (string, error) {
 	if len(client.SectorIdentifierURI) == 0 && len(client.RedirectURIs) > 1 {
 		return "", errors.WithStack(fosite.ErrInvalidRequest.WithHintf("OAuth 2.0 Client %s has multiple redirect_uris but no sector_identifier_uri was set which is not allowed when performing using subject type pairwise. Please reconfigure the OAuth 2.0 client properly.", client.GetID()))
 	} else if len(client.SectorIdentifierURI) == 0 && len(client.RedirectURIs) == 0 {
 		return "", errors.WithStack(fosite.ErrInvalidRequest.WithHintf("OAuth 2.0 Client %s neither specifies a sector_identifier_uri nor a redirect_uri which is not allowed when performing using subject type pairwise. Please reconfigure the OAuth 2.0 client properly.", client.GetID()))
 	} else if len(client.SectorIdentifierURI) > 0 {
-		id = client.SectorIdentifierURI
-	} else {
-		redirectURL, err := url.Parse(client.RedirectURIs[0])
-		if err != nil {
-			return "", errors.WithStack(err)
-		}
-		id = redirectURL.Host
+		return client.SectorIdentifierURI, nil
 	}
+
+	redirectURL, err := url.Parse(client.RedirectURIs[0])
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return redirectURL.Host, nil
+}
+
+func pairwiseHash(salt, subject, sector string) string {
+	// sub = SHA-256 ( sector_identifier || local_account_id || salt ).
 	h := sha256.New()
-	h.Write([]byte(id))
+	h.Write([]byte(sector))
 	h.Write([]byte(subject))
 	h.Write([]byte(salt))
-	return fmt.Sprintf("%x", h.Sum(make([]byte, 0, sha256.Size))), nil
+	return fmt.Sprintf("%x", h.Sum(make([]byte, 0, sha256.Size)))
 }
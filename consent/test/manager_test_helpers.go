@@ -23,6 +23,7 @@ import (
 	"github.com/ory/hydra/v2/oauth2"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/contextx"
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
 	"github.com/ory/x/sqlcon"
 	"github.com/ory/x/sqlxx"
 	"github.com/ory/x/uuidx"
@@ -267,13 +268,13 @@ func ConsentManagerTests(t *testing.T, deps Deps, m consent.Manager, loginManage
 		}
 		revokeFuncs := []func(*testing.T, tc){
 			func(t *testing.T, c tc) {
-				require.NoError(t, m.RevokeSubjectConsentSession(t.Context(), c.f.Subject))
+				require.NoError(t, m.RevokeSubjectConsentSession(t.Context(), c.f.Subject, ""))
 			},
 			func(t *testing.T, c tc) {
-				require.NoError(t, m.RevokeSubjectClientConsentSession(t.Context(), c.f.Subject, c.f.Client.ID))
+				require.NoError(t, m.RevokeSubjectClientConsentSession(t.Context(), c.f.Subject, c.f.Client.ID, ""))
 			},
 			func(t *testing.T, c tc) {
-				require.NoError(t, m.RevokeConsentSessionByID(t.Context(), c.f.ConsentRequestID.String()))
+				require.NoError(t, m.RevokeConsentSessionByID(t.Context(), c.f.ConsentRequestID.String(), ""))
 			},
 		}
 		tcs := make([]tc, 2*len(revokeFuncs))
@@ -318,11 +319,71 @@ func ConsentManagerTests(t *testing.T, deps Deps, m consent.Manager, loginManage
 		}
 
 		t.Run("unknown subject/client return no error", func(t *testing.T) {
-			require.NoError(t, m.RevokeSubjectConsentSession(t.Context(), "i-do-not-exist"))
-			require.NoError(t, m.RevokeSubjectClientConsentSession(t.Context(), "i-do-not-exist", "i-do-not-exist"))
+			require.NoError(t, m.RevokeSubjectConsentSession(t.Context(), "i-do-not-exist", ""))
+			require.NoError(t, m.RevokeSubjectClientConsentSession(t.Context(), "i-do-not-exist", "i-do-not-exist", ""))
 		})
 	})
 
+	t.Run("case=consent history", func(t *testing.T) {
+		granted := MockConsentFlow(true, 3600, false)
+		granted.NID = deps.Networker().NetworkID(t.Context())
+		require.NoError(t, clientManager.CreateClient(t.Context(), granted.Client))
+		require.NoError(t, m.CreateConsentSession(t.Context(), granted))
+
+		modified := MockConsentFlow(false, 0, false)
+		modified.NID = granted.NID
+		modified.Client = granted.Client
+		modified.Subject = granted.Subject
+		modified.GrantedScope = []string{"scope_a"}
+		require.NoError(t, m.CreateConsentSession(t.Context(), modified))
+
+		skipped := MockConsentFlow(false, 0, true)
+		skipped.NID = granted.NID
+		skipped.Client = granted.Client
+		skipped.Subject = granted.Subject
+		require.NoError(t, m.CreateConsentSession(t.Context(), skipped))
+
+		require.NoError(t, m.RevokeSubjectClientConsentSession(t.Context(), granted.Subject, granted.Client.ID, "user request"))
+
+		entries, nextPage, err := m.ListSubjectConsentHistory(t.Context(), granted.Subject)
+		require.NoError(t, err)
+		assert.True(t, nextPage.IsLast())
+		require.Len(t, entries, 4)
+
+		events := make(map[string][]consent.ConsentHistoryEntry)
+		for _, e := range entries {
+			assert.Equal(t, granted.Subject, e.Subject)
+			assert.Equal(t, granted.Client.ID, e.ClientID)
+			events[e.Event] = append(events[e.Event], e)
+		}
+		require.Len(t, events[consent.ConsentEventGranted], 1)
+		require.Len(t, events[consent.ConsentEventModified], 1)
+		require.Len(t, events[consent.ConsentEventRevoked], 2)
+
+		assert.Equal(t, granted.ConsentRequestID.String(), events[consent.ConsentEventGranted][0].ConsentRequestID)
+		assert.True(t, events[consent.ConsentEventGranted][0].Remember)
+		assert.NotZero(t, events[consent.ConsentEventGranted][0].ExpiresAt)
+		assert.Equal(t, []string{"scope_a"}, []string(events[consent.ConsentEventModified][0].GrantedScope))
+		for _, e := range events[consent.ConsentEventRevoked] {
+			assert.Equal(t, "user request", e.RevocationReason)
+		}
+
+		entries, nextPage, err = m.ListSubjectConsentHistory(t.Context(), granted.Subject, keysetpagination.WithSize(3))
+		require.NoError(t, err)
+		assert.Len(t, entries, 3)
+		assert.False(t, nextPage.IsLast())
+
+		entries, _, err = m.ListSubjectConsentHistory(t.Context(), granted.Subject, nextPage.ToOptions()...)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+
+		require.NoError(t, m.DeleteSubjectConsentHistory(t.Context(), granted.Subject))
+		entries, _, err = m.ListSubjectConsentHistory(t.Context(), granted.Subject)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+		require.NoError(t, m.DeleteSubjectConsentHistory(t.Context(), "i-do-not-exist"))
+	})
+
 	t.Run("case=list consents", func(t *testing.T) {
 		flows := make([]*flow.Flow, 2)
 		for i := range flows {
@@ -10,6 +10,7 @@ import (
 	"math"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -53,6 +54,7 @@ const (
 	KeySubjectTypesSupported                     = "oidc.subject_identifiers.supported_types"
 	KeyDefaultClientScope                        = "oidc.dynamic_client_registration.default_scope"
 	KeyDSN                                       = "dsn"
+	KeyDSNReadReplicas                           = "dsn_read_replicas"
 	KeyClientHTTPNoPrivateIPRanges               = "clients.http.disallow_private_ip_ranges"
 	KeyClientHTTPPrivateIPExceptionURLs          = "clients.http.private_ip_exception_urls"
 	KeyHasherAlgorithm                           = "oauth2.hashers.algorithm"
@@ -77,6 +79,7 @@ const (
 	KeyDeviceAndUserCodeLifespan                 = "ttl.device_user_code"
 	KeyAuthenticationSessionLifespan             = "ttl.authentication_session"
 	KeyScopeStrategy                             = "strategies.scope"
+	KeyScopeTemplates                            = "strategies.scope_templates"
 	KeyGetCookieSecrets                          = "secrets.cookie"
 	KeyGetSystemSecret                           = "secrets.system"
 	KeyPaginationSecrets                         = "secrets.pagination"
@@ -95,10 +98,14 @@ const (
 	KeyIdentityProviderPublicURL                 = "urls.identity_provider.publicUrl"
 	KeyIdentityProviderHeaders                   = "urls.identity_provider.headers"
 	KeyAccessTokenStrategy                       = "strategies.access_token"
+	KeyRefreshTokenStrategy                      = "strategies.refresh_token"
 	KeyJWTScopeClaimStrategy                     = "strategies.jwt.scope_claim"
 	KeyDBIgnoreUnknownTableColumns               = "db.ignore_unknown_table_columns"
 	KeySubjectIdentifierAlgorithmSalt            = "oidc.subject_identifiers.pairwise.salt"
+	KeySubjectIdentifierAlgorithmPreviousSalts   = "oidc.subject_identifiers.pairwise.previous_salts"
 	KeyPublicAllowDynamicRegistration            = "oidc.dynamic_client_registration.enabled"
+	KeyACRValuesSupported                        = "oidc.authentication_context.acr_values_supported"
+	KeyEnforceACRValues                          = "oidc.authentication_context.enforce_acr_values"
 	KeyDeviceAuthTokenPollingInterval            = "oauth2.device_authorization.token_polling_interval" // #nosec G101
 	KeyDeviceAuthUserCodeEntropyPreset           = "oauth2.device_authorization.user_code.entropy_preset"
 	KeyDeviceAuthUserCodeLength                  = "oauth2.device_authorization.user_code.length"
@@ -119,8 +126,33 @@ const (
 	KeyOAuth2GrantJWTIssuedDateOptional          = "oauth2.grant.jwt.iat_optional"
 	KeyOAuth2GrantJWTMaxDuration                 = "oauth2.grant.jwt.max_ttl"
 	KeyOAuth2GrantJWTOmitAssertionAudience       = "oauth2.grant.jwt.omit_assertion_audience"
-	KeyRefreshTokenHook                          = "oauth2.refresh_token_hook" // #nosec G101
-	KeyTokenHook                                 = "oauth2.token_hook"         // #nosec G101
+	KeyOAuth2GrantJWTClaimsMapperTimeout         = "oauth2.grant.jwt.claims_mapper_timeout"
+	KeyRefreshTokenHook                          = "oauth2.refresh_token_hook"          // #nosec G101
+	KeyTokenHook                                 = "oauth2.token_hook"                  // #nosec G101
+	KeyTokenClaimsMapperURL                      = "oauth2.token_claims_mapper.url"     // #nosec G101
+	KeyTokenClaimsMapperTimeout                  = "oauth2.token_claims_mapper.timeout" // #nosec G101
+	KeyTokenClaimsMapperMemoryLimit              = "oauth2.token_claims_mapper.memory_limit"
+	KeyUserinfoHook                              = "oauth2.userinfo_hook"
+	KeyIntrospectionCacheEnabled                 = "oauth2.introspection.cache.enabled"
+	KeyIntrospectionCacheMaxItems                = "oauth2.introspection.cache.max_items"
+	KeyIntrospectionCacheTTL                     = "oauth2.introspection.cache.ttl"
+	KeyIntrospectionCacheRevocationPollInterval  = "oauth2.introspection.cache.revocation_poll_interval"
+	KeyConsentReceipts                           = "oauth2.consent_receipts"
+	KeyMultitenancyEnabled                       = "multitenancy.enabled"
+	KeyMultitenancyResolveBy                     = "multitenancy.resolve_by"
+	KeyMultitenancyRefreshInterval               = "multitenancy.refresh_interval"
+	KeyJanitorEnabled                            = "janitor.enabled"
+	KeyJanitorInterval                           = "janitor.interval"
+	KeyJanitorKeepIfYounger                      = "janitor.keep_if_younger"
+	KeyJanitorLimit                              = "janitor.limit"
+	KeyJanitorBatchSize                          = "janitor.batch_size"
+	KeyJanitorTokens                             = "janitor.tokens"
+	KeyJanitorRequests                           = "janitor.requests"
+	KeyJanitorGrants                             = "janitor.grants"
+	KeyMessageBundles                            = "i18n.bundles"
+	KeyErrorTemplateURL                          = "templates.error"
+	KeyFormPostTemplateURL                       = "templates.form_post"
+	KeyFallbackTemplateURL                       = "templates.fallback"
 	KeyDevelopmentMode                           = "dev"
 	KeyFeatureFlagsLegacyAllowInsecureOrigins    = "feature_flags.legacy_allow_insecure_origins"
 )
@@ -237,7 +269,7 @@ func (p *DefaultProvider) IsDevelopmentMode(ctx context.Context) bool {
 }
 
 func (p *DefaultProvider) WellKnownKeys(ctx context.Context, include ...string) []string {
-	include = append(include, x.OAuth2JWTKeyName, x.OpenIDConnectKeyName)
+	include = append(include, x.OAuth2JWTKeyName, x.OpenIDConnectKeyName, x.OAuth2IntrospectionKeyName)
 	return stringslice.Unique(append(p.getProvider(ctx).Strings(KeyWellKnownKeys), include...))
 }
 
@@ -327,6 +359,12 @@ func (p *DefaultProvider) DSN() string {
 	return ""
 }
 
+// DSNReadReplicas returns the data source names of the read replicas of the
+// database configured by DSN.
+func (p *DefaultProvider) DSNReadReplicas() []string {
+	return p.getProvider(contextx.RootContext).Strings(KeyDSNReadReplicas)
+}
+
 func (p *DefaultProvider) EncryptSessionData(ctx context.Context) bool {
 	return p.getProvider(ctx).BoolF(KeyEncryptSessionData, true)
 }
@@ -367,6 +405,16 @@ func (p *DefaultProvider) PublicAllowDynamicRegistration(ctx context.Context) bo
 	return p.getProvider(ctx).Bool(KeyPublicAllowDynamicRegistration)
 }
 
+// ACRValuesSupported returns the supported authentication context class
+// references ordered from the weakest to the strongest.
+func (p *DefaultProvider) ACRValuesSupported(ctx context.Context) []string {
+	return p.getProvider(ctx).Strings(KeyACRValuesSupported)
+}
+
+func (p *DefaultProvider) EnforceACRValues(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeyEnforceACRValues)
+}
+
 func (p *DefaultProvider) CookieSameSiteLegacyWorkaround(ctx context.Context) bool {
 	return p.getProvider(ctx).Bool(KeyCookieSameSiteLegacyWorkaround)
 }
@@ -600,6 +648,28 @@ func (p *DefaultProvider) AccessTokenStrategy(ctx context.Context, additionalSou
 	return s
 }
 
+type RefreshTokenStrategySource interface {
+	GetRefreshTokenStrategy() RefreshTokenStrategyType
+}
+
+func (p *DefaultProvider) RefreshTokenStrategy(ctx context.Context, additionalSources ...RefreshTokenStrategySource) RefreshTokenStrategyType {
+	for _, src := range additionalSources {
+		if src == nil {
+			continue
+		}
+		if strategy := src.GetRefreshTokenStrategy(); strategy != "" {
+			return strategy
+		}
+	}
+	s, err := ToRefreshTokenStrategyType(p.getProvider(ctx).String(KeyRefreshTokenStrategy))
+	if err != nil {
+		p.l.WithError(err).Warn("Key `strategies.refresh_token` contains an invalid value, falling back to `opaque` strategy.")
+		return RefreshTokenDefaultStrategy
+	}
+
+	return s
+}
+
 type (
 	Auth struct {
 		Type   string     `json:"type"`
@@ -613,18 +683,76 @@ type (
 	HookConfig struct {
 		URL  string `json:"url"`
 		Auth *Auth  `json:"auth"`
+		// Timeout bounds a single hook call, including retries.
+		Timeout time.Duration `json:"timeout"`
+		// FailOpen issues the token with the unmodified session if the hook
+		// fails or its circuit breaker is open.
+		FailOpen bool `json:"fail_open" koanf:"fail_open"`
+		// CacheTTL is how long hook responses are cached per grant. Zero
+		// disables caching.
+		CacheTTL       time.Duration            `json:"cache_ttl" koanf:"cache_ttl"`
+		CircuitBreaker HookCircuitBreakerConfig `json:"circuit_breaker" koanf:"circuit_breaker"`
+		// Match restricts a token hook to matching token requests. A nil
+		// match applies the hook to all token requests.
+		Match *HookMatch `json:"match"`
+	}
+	// HookMatch selects the token requests a hook is called for. All
+	// non-empty criteria must match; within a criterion, any value matches.
+	HookMatch struct {
+		ClientIDs  []string `json:"client_ids" koanf:"client_ids"`
+		Owners     []string `json:"owners"`
+		GrantTypes []string `json:"grant_types" koanf:"grant_types"`
+		Scopes     []string `json:"scopes"`
+	}
+	HookCircuitBreakerConfig struct {
+		// FailureThreshold is the number of consecutive failures after which
+		// the circuit opens. Zero disables the circuit breaker.
+		FailureThreshold int `json:"failure_threshold" koanf:"failure_threshold"`
+		// OpenDuration is how long the circuit stays open before a single
+		// probe request is let through.
+		OpenDuration time.Duration `json:"open_duration" koanf:"open_duration"`
+	}
+	// ConsentReceiptsConfig describes the data controller named in consent
+	// receipts.
+	ConsentReceiptsConfig struct {
+		Jurisdiction string                    `json:"jurisdiction"`
+		PolicyURL    string                    `json:"policy_url" koanf:"policy_url"`
+		Controller   ConsentReceiptsController `json:"controller"`
+	}
+	ConsentReceiptsController struct {
+		Name    string `json:"name"`
+		Contact string `json:"contact"`
+		Email   string `json:"email"`
+		Phone   string `json:"phone"`
+		Address string `json:"address"`
+		URL     string `json:"url"`
 	}
 )
 
+const (
+	defaultHookTimeout                    = 10 * time.Second
+	defaultHookCircuitBreakerOpenDuration = 30 * time.Second
+)
+
+func (c *HookConfig) withDefaults() *HookConfig {
+	if c.Timeout <= 0 {
+		c.Timeout = defaultHookTimeout
+	}
+	if c.CircuitBreaker.OpenDuration <= 0 {
+		c.CircuitBreaker.OpenDuration = defaultHookCircuitBreakerOpenDuration
+	}
+	return c
+}
+
 func (p *DefaultProvider) getHookConfig(ctx context.Context, key string) *HookConfig {
 	if p.getProvider(ctx).String(key) == "" {
 		return nil
 	}
 
 	if hookURL := p.getProvider(ctx).RequestURIF(key, nil); hookURL != nil {
-		return &HookConfig{
+		return (&HookConfig{
 			URL: hookURL.String(),
-		}
+		}).withDefaults()
 	}
 
 	var hookConfig *HookConfig
@@ -646,17 +774,187 @@ func (p *DefaultProvider) getHookConfig(ctx context.Context, key string) *HookCo
 	}
 	hookConfig.URL = u.String()
 
-	return hookConfig
+	return hookConfig.withDefaults()
 }
 
-func (p *DefaultProvider) TokenHookConfig(ctx context.Context) *HookConfig {
-	return p.getHookConfig(ctx, KeyTokenHook)
+// TokenHookConfigs returns the token hooks in the order they are called.
+// `oauth2.token_hook` may either be a single hook or a list of hooks.
+func (p *DefaultProvider) TokenHookConfigs(ctx context.Context) []*HookConfig {
+	if reflect.ValueOf(p.getProvider(ctx).Get(KeyTokenHook)).Kind() != reflect.Slice {
+		if hookConfig := p.getHookConfig(ctx, KeyTokenHook); hookConfig != nil {
+			return []*HookConfig{hookConfig}
+		}
+		return nil
+	}
+
+	var hookConfigs []*HookConfig
+	if err := p.getProvider(ctx).Unmarshal(KeyTokenHook, &hookConfigs); err != nil {
+		p.l.WithError(errors.WithStack(err)).
+			Errorf("Configuration value from key %s could not be decoded.", KeyTokenHook)
+		return nil
+	}
+
+	result := make([]*HookConfig, 0, len(hookConfigs))
+	for i, hookConfig := range hookConfigs {
+		if hookConfig == nil {
+			continue
+		}
+		u, err := url.ParseRequestURI(hookConfig.URL)
+		if err != nil {
+			p.l.WithError(errors.WithStack(err)).
+				Errorf("Configuration value from key %s.%d could not be decoded.", KeyTokenHook, i)
+			continue
+		}
+		hookConfig.URL = u.String()
+		result = append(result, hookConfig.withDefaults())
+	}
+	return result
 }
 
 func (p *DefaultProvider) TokenRefreshHookConfig(ctx context.Context) *HookConfig {
 	return p.getHookConfig(ctx, KeyRefreshTokenHook)
 }
 
+// UserinfoHookConfig returns the userinfo hook, or nil if none is configured.
+func (p *DefaultProvider) UserinfoHookConfig(ctx context.Context) *HookConfig {
+	return p.getHookConfig(ctx, KeyUserinfoHook)
+}
+
+// TokenClaimsMapperURL returns the location of the global Jsonnet token claims
+// mapper, or an empty string if none is configured.
+func (p *DefaultProvider) TokenClaimsMapperURL(ctx context.Context) string {
+	return p.getProvider(ctx).String(KeyTokenClaimsMapperURL)
+}
+
+// TokenClaimsMapperTimeout returns the maximum time a token claims mapper may
+// take to evaluate.
+func (p *DefaultProvider) TokenClaimsMapperTimeout(ctx context.Context) time.Duration {
+	return x.Clamp(p.getProvider(ctx).DurationF(KeyTokenClaimsMapperTimeout, 500*time.Millisecond), time.Millisecond, time.Second)
+}
+
+// TokenClaimsMapperMemoryLimit returns the virtual memory limit in bytes of
+// the worker processes which evaluate Jsonnet mappers.
+func (p *DefaultProvider) TokenClaimsMapperMemoryLimit(ctx context.Context) uint64 {
+	return uint64(x.Clamp(p.getProvider(ctx).IntF(KeyTokenClaimsMapperMemoryLimit, 512), 64, 2048)) << 20 //nolint:gosec // The value is clamped to a positive range.
+}
+
+// IntrospectionCacheEnabled returns whether introspection results are cached
+// in memory.
+func (p *DefaultProvider) IntrospectionCacheEnabled(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeyIntrospectionCacheEnabled)
+}
+
+// IntrospectionCacheMaxItems returns the maximum number of cached
+// introspection results.
+func (p *DefaultProvider) IntrospectionCacheMaxItems(ctx context.Context) int64 {
+	return int64(x.Clamp(p.getProvider(ctx).IntF(KeyIntrospectionCacheMaxItems, 100_000), 1, math.MaxInt32))
+}
+
+// IntrospectionCacheTTL returns how long an introspection result is cached at
+// most. Results are never cached beyond the token's expiry.
+func (p *DefaultProvider) IntrospectionCacheTTL(ctx context.Context) time.Duration {
+	return x.Clamp(p.getProvider(ctx).DurationF(KeyIntrospectionCacheTTL, time.Minute), time.Second, time.Hour)
+}
+
+// IntrospectionCacheRevocationPollInterval returns how often the revocation
+// feed is polled to invalidate cached introspection results.
+func (p *DefaultProvider) IntrospectionCacheRevocationPollInterval(ctx context.Context) time.Duration {
+	return x.Clamp(p.getProvider(ctx).DurationF(KeyIntrospectionCacheRevocationPollInterval, 5*time.Second), 100*time.Millisecond, time.Minute)
+}
+
+// ConsentReceipts returns the data controller details included in consent
+// receipts.
+func (p *DefaultProvider) ConsentReceipts(ctx context.Context) *ConsentReceiptsConfig {
+	var c ConsentReceiptsConfig
+	if err := p.getProvider(ctx).Unmarshal(KeyConsentReceipts, &c); err != nil {
+		p.l.WithError(errors.WithStack(err)).
+			Errorf("Configuration value from key %s could not be decoded.", KeyConsentReceipts)
+	}
+	return &c
+}
+
+// MultitenancyEnabled returns whether requests are resolved to tenants.
+func (p *DefaultProvider) MultitenancyEnabled(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeyMultitenancyEnabled)
+}
+
+// MultitenancyResolveBy returns how the tenant of a request is resolved,
+// either "host" or "path".
+func (p *DefaultProvider) MultitenancyResolveBy(ctx context.Context) string {
+	return p.getProvider(ctx).StringF(KeyMultitenancyResolveBy, "host")
+}
+
+// MultitenancyRefreshInterval returns how often the list of tenants is
+// reloaded from the database.
+func (p *DefaultProvider) MultitenancyRefreshInterval(ctx context.Context) time.Duration {
+	return x.Clamp(p.getProvider(ctx).DurationF(KeyMultitenancyRefreshInterval, 30*time.Second), time.Second, time.Hour)
+}
+
+// JanitorEnabled returns whether the janitor runs as part of the admin server.
+func (p *DefaultProvider) JanitorEnabled(ctx context.Context) bool {
+	return p.getProvider(ctx).Bool(KeyJanitorEnabled)
+}
+
+// JanitorInterval returns how often the janitor runs.
+func (p *DefaultProvider) JanitorInterval(ctx context.Context) time.Duration {
+	return x.Clamp(p.getProvider(ctx).DurationF(KeyJanitorInterval, time.Hour), time.Minute, 7*24*time.Hour)
+}
+
+// JanitorKeepIfYounger returns how long records are kept by the janitor
+// beyond their expiry.
+func (p *DefaultProvider) JanitorKeepIfYounger(ctx context.Context) time.Duration {
+	return max(p.getProvider(ctx).DurationF(KeyJanitorKeepIfYounger, 0), 0)
+}
+
+// JanitorLimit returns how many records are deleted by each cleanup routine
+// of a janitor run at most.
+func (p *DefaultProvider) JanitorLimit(ctx context.Context) int {
+	return max(p.getProvider(ctx).IntF(KeyJanitorLimit, 10000), 1)
+}
+
+// JanitorBatchSize returns how many records the janitor deletes at once. It
+// is never greater than the limit.
+func (p *DefaultProvider) JanitorBatchSize(ctx context.Context) int {
+	return x.Clamp(p.getProvider(ctx).IntF(KeyJanitorBatchSize, 100), 1, p.JanitorLimit(ctx))
+}
+
+// JanitorTokens returns whether the janitor deletes expired tokens.
+func (p *DefaultProvider) JanitorTokens(ctx context.Context) bool {
+	return p.getProvider(ctx).BoolF(KeyJanitorTokens, true)
+}
+
+// JanitorRequests returns whether the janitor deletes inactive login and
+// consent requests.
+func (p *DefaultProvider) JanitorRequests(ctx context.Context) bool {
+	return p.getProvider(ctx).BoolF(KeyJanitorRequests, true)
+}
+
+// JanitorGrants returns whether the janitor deletes expired trust
+// relationships.
+func (p *DefaultProvider) JanitorGrants(ctx context.Context) bool {
+	return p.getProvider(ctx).BoolF(KeyJanitorGrants, true)
+}
+
+// ErrorTemplateURL returns the location of the Go HTML template overriding
+// the built-in error page, or an empty string if none is configured.
+func (p *DefaultProvider) ErrorTemplateURL(ctx context.Context) string {
+	return p.getProvider(ctx).String(KeyErrorTemplateURL)
+}
+
+// FormPostTemplateURL returns the location of the Go HTML template overriding
+// the built-in `response_mode=form_post` page, or an empty string if none is
+// configured.
+func (p *DefaultProvider) FormPostTemplateURL(ctx context.Context) string {
+	return p.getProvider(ctx).String(KeyFormPostTemplateURL)
+}
+
+// FallbackTemplateURL returns the location of the Go HTML template overriding
+// the built-in fallback pages shown when the login, consent, logout or device
+// URLs are not configured, or an empty string if none is configured.
+func (p *DefaultProvider) FallbackTemplateURL(ctx context.Context) string {
+	return p.getProvider(ctx).String(KeyFallbackTemplateURL)
+}
+
 func (p *DefaultProvider) DbIgnoreUnknownTableColumns() bool {
 	return p.p.Bool(KeyDBIgnoreUnknownTableColumns)
 }
@@ -665,6 +963,12 @@ func (p *DefaultProvider) SubjectIdentifierAlgorithmSalt(ctx context.Context) st
 	return p.getProvider(ctx).String(KeySubjectIdentifierAlgorithmSalt)
 }
 
+// SubjectIdentifierAlgorithmPreviousSalts returns the pairwise salts which
+// were used before the current one, most recent first.
+func (p *DefaultProvider) SubjectIdentifierAlgorithmPreviousSalts(ctx context.Context) []string {
+	return p.getProvider(ctx).StringsF(KeySubjectIdentifierAlgorithmPreviousSalts, []string{})
+}
+
 func (p *DefaultProvider) OIDCDiscoverySupportedClaims(ctx context.Context) []string {
 	return stringslice.Unique(
 		append(
@@ -746,6 +1050,12 @@ func (p *DefaultProvider) GetGrantTypeJWTBearerOmitAssertionAudience(ctx context
 	return p.getProvider(ctx).BoolF(KeyOAuth2GrantJWTOmitAssertionAudience, true)
 }
 
+// GrantTypeJWTBearerClaimsMapperTimeout returns the maximum time the claims
+// mapper of a trust relationship may take to evaluate.
+func (p *DefaultProvider) GrantTypeJWTBearerClaimsMapperTimeout(ctx context.Context) time.Duration {
+	return x.Clamp(p.getProvider(ctx).DurationF(KeyOAuth2GrantJWTClaimsMapperTimeout, 500*time.Millisecond), time.Millisecond, time.Second)
+}
+
 func (p *DefaultProvider) GetJWTMaxDuration(ctx context.Context) time.Duration {
 	return p.getProvider(ctx).DurationF(KeyOAuth2GrantJWTMaxDuration, time.Hour*24*30)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/i18n"
 	"github.com/ory/hydra/v2/fosite/token/jwt"
 	"github.com/ory/hydra/v2/x"
 )
@@ -90,14 +91,68 @@ func (p *DefaultProvider) GetAuthorizeCodeLifespan(ctx context.Context) time.Dur
 var _ fosite.ScopeStrategyProvider = (*DefaultProvider)(nil)
 
 func (p *DefaultProvider) GetScopeStrategy(ctx context.Context) fosite.ScopeStrategy {
-	if strings.ToLower(p.getProvider(ctx).String(KeyScopeStrategy)) == "wildcard" {
+	switch strings.ToLower(p.getProvider(ctx).String(KeyScopeStrategy)) {
+	case "wildcard":
 		return fosite.WildcardScopeStrategy
+	case "parameterized":
+		return fosite.NewParameterizedScopeStrategy(p.GetScopeTemplates(ctx)...)
 	}
 	return fosite.ExactScopeStrategy
 }
 
+var _ fosite.RefreshTokenDownscopingProvider = (*DefaultProvider)(nil)
+
+// GetRefreshTokenDownscoping enables downscoping in the refresh token grant if
+// the parameterized scope strategy is used.
+func (p *DefaultProvider) GetRefreshTokenDownscoping(ctx context.Context) bool {
+	return strings.ToLower(p.getProvider(ctx).String(KeyScopeStrategy)) == "parameterized"
+}
+
+// GetScopeTemplates returns the configured scope templates. Invalid templates are skipped.
+func (p *DefaultProvider) GetScopeTemplates(ctx context.Context) []*fosite.ScopeTemplate {
+	var templates []*fosite.ScopeTemplate
+	for _, raw := range p.getProvider(ctx).Strings(KeyScopeTemplates) {
+		t, err := fosite.ParseScopeTemplate(raw)
+		if err != nil {
+			p.l.WithError(err).Warnf("Ignoring invalid scope template in key `%s`.", KeyScopeTemplates)
+			continue
+		}
+		templates = append(templates, t)
+	}
+	return templates
+}
+
 var _ fosite.JWTScopeFieldProvider = (*DefaultProvider)(nil)
 
+// MessageBundles returns the configured locale bundles used to localize error
+// descriptions and the built-in pages.
+func (p *DefaultProvider) MessageBundles(ctx context.Context) []*i18n.DefaultLocaleBundle {
+	// The i18n types only carry JSON tags, so the configuration is decoded
+	// into local types first.
+	var raw []struct {
+		Lang     string `koanf:"lang"`
+		Messages []struct {
+			ID  string `koanf:"id"`
+			Msg string `koanf:"msg"`
+		} `koanf:"messages"`
+	}
+	if err := p.getProvider(ctx).Unmarshal(KeyMessageBundles, &raw); err != nil {
+		p.l.WithError(errors.WithStack(err)).
+			Errorf("Configuration value from key %s could not be decoded.", KeyMessageBundles)
+		return nil
+	}
+
+	var bundles []*i18n.DefaultLocaleBundle
+	for _, r := range raw {
+		bundle := &i18n.DefaultLocaleBundle{LangTag: r.Lang}
+		for _, m := range r.Messages {
+			bundle.Messages = append(bundle.Messages, &i18n.DefaultMessage{ID: m.ID, FormattedMessage: m.Msg})
+		}
+		bundles = append(bundles, bundle)
+	}
+	return bundles
+}
+
 func (p *DefaultProvider) GetJWTScopeField(ctx context.Context) jwt.JWTScopeFieldEnum {
 	switch strings.ToLower(p.getProvider(ctx).String(KeyJWTScopeClaimStrategy)) {
 	case "string":
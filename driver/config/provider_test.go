@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ory/hydra/v2/fosite/i18n"
 	"github.com/ory/hydra/v2/fosite/token/jwt"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/configx"
@@ -81,7 +82,7 @@ func TestSubjectTypesSupported(t *testing.T) {
 
 func TestWellKnownKeysUnique(t *testing.T) {
 	p := newProvider(t)
-	assert.EqualValues(t, []string{x.OpenIDConnectKeyName, x.OAuth2JWTKeyName}, p.WellKnownKeys(t.Context(), x.OAuth2JWTKeyName, x.OpenIDConnectKeyName, x.OpenIDConnectKeyName))
+	assert.EqualValues(t, []string{x.OpenIDConnectKeyName, x.OAuth2JWTKeyName, x.OAuth2IntrospectionKeyName}, p.WellKnownKeys(t.Context(), x.OAuth2JWTKeyName, x.OpenIDConnectKeyName, x.OpenIDConnectKeyName))
 }
 
 func TestCORSOptions(t *testing.T) {
@@ -280,7 +281,7 @@ func TestProviderValidates(t *testing.T) {
 	assert.Contains(t, c.DSN(), "sqlite://")
 
 	// webfinger
-	assert.Equal(t, []string{"hydra.openid.id-token", "hydra.jwt.access-token"}, c.WellKnownKeys(ctx))
+	assert.Equal(t, []string{"hydra.openid.id-token", "hydra.jwt.access-token", "hydra.jwt.introspection"}, c.WellKnownKeys(ctx))
 	assert.Equal(t, urlx.ParseOrPanic("https://example.com"), c.OAuth2ClientRegistrationURL(ctx))
 	assert.Equal(t, urlx.ParseOrPanic("https://example.com/device_authorization"), c.OAuth2DeviceAuthorisationURL(ctx))
 	assert.Equal(t, urlx.ParseOrPanic("https://example.com/jwks.json"), c.JWKSURL(ctx))
@@ -470,7 +471,14 @@ func TestHookConfigs(t *testing.T) {
 
 	for key, getFunc := range map[string]func(context.Context) *HookConfig{
 		KeyRefreshTokenHook: c.TokenRefreshHookConfig,
-		KeyTokenHook:        c.TokenHookConfig,
+		KeyUserinfoHook:     c.UserinfoHookConfig,
+		KeyTokenHook: func(ctx context.Context) *HookConfig {
+			if hooks := c.TokenHookConfigs(ctx); len(hooks) > 0 {
+				require.Len(t, hooks, 1)
+				return hooks[0]
+			}
+			return nil
+		},
 	} {
 		assert.Nil(t, getFunc(ctx))
 		c.MustSet(ctx, key, "")
@@ -479,6 +487,11 @@ func TestHookConfigs(t *testing.T) {
 		hc := getFunc(ctx)
 		require.NotNil(t, hc)
 		assert.EqualValues(t, "http://localhost:8080/hook", hc.URL)
+		assert.Equal(t, 10*time.Second, hc.Timeout)
+		assert.False(t, hc.FailOpen)
+		assert.Zero(t, hc.CacheTTL)
+		assert.Zero(t, hc.CircuitBreaker.FailureThreshold)
+		assert.Equal(t, 30*time.Second, hc.CircuitBreaker.OpenDuration)
 
 		c.MustSet(ctx, key, `
 {
@@ -499,9 +512,77 @@ func TestHookConfigs(t *testing.T) {
 		rawConfig, err := json.Marshal(hc.Auth.Config)
 		require.NoError(t, err)
 		assert.JSONEq(t, `{"in":"header","name":"my-header","value":"my-value"}`, string(rawConfig))
+
+		c.MustSet(ctx, key, `
+{
+	"url": "http://localhost:8080/hook3",
+	"timeout": "250ms",
+	"fail_open": true,
+	"cache_ttl": "1m",
+	"circuit_breaker": {
+		"failure_threshold": 5,
+		"open_duration": "10s"
+	}
+}`)
+		hc = getFunc(ctx)
+		require.NotNil(t, hc)
+		assert.Equal(t, 250*time.Millisecond, hc.Timeout)
+		assert.True(t, hc.FailOpen)
+		assert.Equal(t, time.Minute, hc.CacheTTL)
+		assert.Equal(t, 5, hc.CircuitBreaker.FailureThreshold)
+		assert.Equal(t, 10*time.Second, hc.CircuitBreaker.OpenDuration)
 	}
 }
 
+func TestTokenHookConfigs(t *testing.T) {
+	ctx := context.Background()
+	l := logrusx.New("", "")
+	l.Logrus().SetOutput(io.Discard)
+	c := MustNew(t, l, configx.SkipValidation())
+
+	c.MustSet(ctx, KeyTokenHook, []map[string]any{
+		{
+			"url": "http://localhost:8080/hook1",
+			"match": map[string]any{
+				"client_ids":  []string{"client-a", "client-b"},
+				"grant_types": []string{"authorization_code"},
+			},
+		},
+		{
+			"url":     "http://localhost:8080/hook2",
+			"timeout": "1s",
+			"match": map[string]any{
+				"owners": []string{"team-x"},
+				"scopes": []string{"profile"},
+			},
+		},
+		{
+			"url": "not a url",
+		},
+		{
+			"url": "http://localhost:8080/hook3",
+		},
+	})
+
+	hooks := c.TokenHookConfigs(ctx)
+	require.Len(t, hooks, 3)
+
+	assert.Equal(t, "http://localhost:8080/hook1", hooks[0].URL)
+	require.NotNil(t, hooks[0].Match)
+	assert.Equal(t, []string{"client-a", "client-b"}, hooks[0].Match.ClientIDs)
+	assert.Equal(t, []string{"authorization_code"}, hooks[0].Match.GrantTypes)
+	assert.Equal(t, 10*time.Second, hooks[0].Timeout)
+
+	assert.Equal(t, "http://localhost:8080/hook2", hooks[1].URL)
+	require.NotNil(t, hooks[1].Match)
+	assert.Equal(t, []string{"team-x"}, hooks[1].Match.Owners)
+	assert.Equal(t, []string{"profile"}, hooks[1].Match.Scopes)
+	assert.Equal(t, time.Second, hooks[1].Timeout)
+
+	assert.Equal(t, "http://localhost:8080/hook3", hooks[2].URL)
+	assert.Nil(t, hooks[2].Match)
+}
+
 func TestJWTBearer(t *testing.T) {
 	l := logrusx.New("", "")
 	l.Logrus().SetOutput(io.Discard)
@@ -550,6 +631,96 @@ func TestJWTScopeClaimStrategy(t *testing.T) {
 	assert.Equal(t, jwt.JWTScopeFieldBoth, p.GetJWTScopeField(ctx))
 }
 
+func TestParameterizedScopeStrategy(t *testing.T) {
+	l := logrusx.New("", "")
+	l.Logrus().SetOutput(io.Discard)
+	p := MustNew(t, l)
+
+	ctx := context.Background()
+	assert.False(t, p.GetRefreshTokenDownscoping(ctx))
+	p.MustSet(ctx, KeyScopeStrategy, "parameterized")
+	assert.True(t, p.GetRefreshTokenDownscoping(ctx), "downscoping is enabled by the parameterized scope strategy")
+	p.MustSet(ctx, KeyScopeTemplates, []string{"transfer:max:{amount:max}", "read:{id:float}"})
+
+	templates := p.GetScopeTemplates(ctx)
+	require.Len(t, templates, 1, "invalid templates are skipped")
+	assert.Equal(t, "transfer:max:{amount:max}", templates[0].String())
+
+	strategy := p.GetScopeStrategy(ctx)
+	assert.True(t, strategy([]string{"transfer:max:500"}, "transfer:max:100"))
+	assert.False(t, strategy([]string{"transfer:max:500"}, "transfer:max:1000"))
+	assert.True(t, strategy([]string{"read:account:{id}"}, "read:account:123"))
+}
+
+func TestAuthenticationContext(t *testing.T) {
+	l := logrusx.New("", "")
+	l.Logrus().SetOutput(io.Discard)
+	p := MustNew(t, l)
+
+	ctx := context.Background()
+	assert.Empty(t, p.ACRValuesSupported(ctx))
+	assert.False(t, p.EnforceACRValues(ctx))
+
+	p.MustSet(ctx, KeyACRValuesSupported, []string{"urn:acr:pwd", "urn:acr:mfa"})
+	p.MustSet(ctx, KeyEnforceACRValues, true)
+	assert.Equal(t, []string{"urn:acr:pwd", "urn:acr:mfa"}, p.ACRValuesSupported(ctx))
+	assert.True(t, p.EnforceACRValues(ctx))
+}
+
+func TestLocalization(t *testing.T) {
+	l := logrusx.New("", "")
+	l.Logrus().SetOutput(io.Discard)
+	p := MustNew(t, l)
+
+	ctx := context.Background()
+	assert.Empty(t, p.MessageBundles(ctx))
+	assert.Empty(t, p.ErrorTemplateURL(ctx))
+	assert.Empty(t, p.FormPostTemplateURL(ctx))
+	assert.Empty(t, p.FallbackTemplateURL(ctx))
+
+	p.MustSet(ctx, KeyMessageBundles, []map[string]any{{
+		"lang":     "de",
+		"messages": []map[string]any{{"id": "invalid_request", "msg": "Die Anfrage ist ungültig."}},
+	}})
+	p.MustSet(ctx, KeyErrorTemplateURL, "file:///etc/hydra/error.html")
+	p.MustSet(ctx, KeyFormPostTemplateURL, "file:///etc/hydra/form_post.html")
+	p.MustSet(ctx, KeyFallbackTemplateURL, "file:///etc/hydra/fallback.html")
+
+	assert.Equal(t, []*i18n.DefaultLocaleBundle{{
+		LangTag:  "de",
+		Messages: []*i18n.DefaultMessage{{ID: "invalid_request", FormattedMessage: "Die Anfrage ist ungültig."}},
+	}}, p.MessageBundles(ctx))
+	assert.Equal(t, "file:///etc/hydra/error.html", p.ErrorTemplateURL(ctx))
+	assert.Equal(t, "file:///etc/hydra/form_post.html", p.FormPostTemplateURL(ctx))
+	assert.Equal(t, "file:///etc/hydra/fallback.html", p.FallbackTemplateURL(ctx))
+}
+
+func TestConsentReceipts(t *testing.T) {
+	l := logrusx.New("", "")
+	l.Logrus().SetOutput(io.Discard)
+	p := MustNew(t, l)
+
+	ctx := context.Background()
+	assert.Equal(t, &ConsentReceiptsConfig{}, p.ConsentReceipts(ctx))
+
+	p.MustSet(ctx, KeyConsentReceipts, map[string]any{
+		"jurisdiction": "DE",
+		"policy_url":   "https://example.com/privacy",
+		"controller": map[string]any{
+			"name":  "ACME Corp",
+			"email": "privacy@example.com",
+		},
+	})
+	assert.Equal(t, &ConsentReceiptsConfig{
+		Jurisdiction: "DE",
+		PolicyURL:    "https://example.com/privacy",
+		Controller: ConsentReceiptsController{
+			Name:  "ACME Corp",
+			Email: "privacy@example.com",
+		},
+	}, p.ConsentReceipts(ctx))
+}
+
 func TestDeviceUserCode(t *testing.T) {
 	l := logrusx.New("", "")
 
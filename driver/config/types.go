@@ -30,3 +30,25 @@ func ToAccessTokenStrategyType(strategy string) (AccessTokenStrategyType, error)
 		return "", f.ToUnknownCaseErr()
 	}
 }
+
+// RefreshTokenStrategyType is the type of refresh token strategy.
+type RefreshTokenStrategyType string
+
+const (
+	// RefreshTokenJWEStrategy is the self-contained, encrypted refresh token strategy.
+	RefreshTokenJWEStrategy RefreshTokenStrategyType = "jwe"
+	// RefreshTokenDefaultStrategy is the default refresh token strategy using HMAC-SHA pass-by-reference tokens.
+	RefreshTokenDefaultStrategy RefreshTokenStrategyType = "opaque"
+)
+
+// ToRefreshTokenStrategyType converts a string to a RefreshTokenStrategyType
+func ToRefreshTokenStrategyType(strategy string) (RefreshTokenStrategyType, error) {
+	switch f := stringsx.SwitchExact(strings.ToLower(strategy)); {
+	case f.AddCase("jwe"):
+		return RefreshTokenJWEStrategy, nil
+	case f.AddCase("opaque"):
+		return RefreshTokenDefaultStrategy, nil
+	default:
+		return "", f.ToUnknownCaseErr()
+	}
+}
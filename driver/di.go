@@ -10,6 +10,7 @@ import (
 	"github.com/ory/hydra/v2/fosite/handler/openid"
 	"github.com/ory/hydra/v2/fosite/handler/pkce"
 	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/x/jsonnetsecure"
 )
 
 type RegistryModifier func(r *RegistrySQL) error
@@ -89,3 +90,10 @@ func RegistryWithConsentManager(cm func(r *RegistrySQL) (consent.Manager, error)
 		return err
 	}
 }
+
+func RegistryWithJsonnetVMProvider(p func(r *RegistrySQL) jsonnetsecure.VMProvider) RegistryModifier {
+	return func(r *RegistrySQL) error {
+		r.jsonnetVMProvider = p(r)
+		return nil
+	}
+}
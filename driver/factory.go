@@ -16,6 +16,7 @@ import (
 	"github.com/ory/hydra/v2/fositex"
 	"github.com/ory/hydra/v2/hsm"
 	"github.com/ory/hydra/v2/internal/kratos"
+	"github.com/ory/hydra/v2/tenant"
 	"github.com/ory/x/configx"
 	"github.com/ory/x/logrusx"
 	"github.com/ory/x/otelx"
@@ -161,7 +162,11 @@ func New(ctx context.Context, opts ...OptionsModifier) (*RegistrySQL, error) {
 		l = logrusx.New("Ory Hydra", config.Version)
 	}
 
-	c, err := config.New(ctx, l, sl.Contextualizer(), o.configOpts...)
+	// Requests resolved to a tenant are served by the tenant's network and
+	// configuration, all others by the contextualizer of the service locator.
+	ctxer := tenant.NewContextualizer(sl.Contextualizer())
+
+	c, err := config.New(ctx, l, ctxer, o.configOpts...)
 	if err != nil {
 		l.WithError(err).Error("Unable to instantiate configuration.")
 		return nil, err
@@ -183,7 +188,7 @@ func New(ctx context.Context, opts ...OptionsModifier) (*RegistrySQL, error) {
 	r.fositeFactories = o.fositexFactories
 	r.hsm = o.hsmContext
 	r.middlewares = sl.HTTPMiddlewares()
-	r.ctxer = sl.Contextualizer()
+	r.ctxer = ctxer
 	r.kratos = o.kratos
 	r.fop = o.fop
 	r.dbOptsModifier = o.dbOptsModifier
@@ -12,19 +12,24 @@ import (
 	"github.com/ory/x/httpx"
 	"github.com/ory/x/otelx"
 
+	"github.com/ory/hydra/v2/bundle"
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/consent"
 	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/internal/kratos"
+	"github.com/ory/hydra/v2/janitor"
 	"github.com/ory/hydra/v2/jwk"
 	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/oauth2/scope"
 	"github.com/ory/hydra/v2/oauth2/trust"
 	"github.com/ory/hydra/v2/persistence"
+	"github.com/ory/hydra/v2/tenant"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/pop/v6"
 	"github.com/ory/x/contextx"
 	"github.com/ory/x/dbal"
+	"github.com/ory/x/jsonnetsecure"
 	"github.com/ory/x/logrusx"
 )
 
@@ -41,9 +46,14 @@ type registry interface {
 	consent.Registry
 	jwk.Registry
 	trust.Registry
+	scope.Registry
+	tenant.Registry
+	janitor.Registry
+	bundle.Registry
 	oauth2.Registry
 	otelx.Provider
 	x.NetworkProvider
+	jsonnetsecure.VMProvider
 
 	kratos.Provider
 
@@ -79,6 +89,8 @@ func callRegistry(ctx context.Context, r *RegistrySQL) {
 	r.OAuth2Provider()
 	r.AccessTokenJWTSigner()
 	r.OpenIDJWTSigner()
+	r.IntrospectionJWTSigner()
+	r.OAuth2IntrospectionCache()
 	r.OpenIDConnectRequestValidator()
 	r.Tracer(ctx)
 }
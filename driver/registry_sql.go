@@ -9,6 +9,10 @@ import (
 	"fmt"
 	"io/fs"
 	"net/http"
+	"net/url"
+	"runtime"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/gorilla/sessions"
@@ -22,6 +26,7 @@ import (
 
 	"github.com/ory/herodot"
 	"github.com/ory/hydra/v2/aead"
+	"github.com/ory/hydra/v2/bundle"
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/consent"
 	"github.com/ory/hydra/v2/driver/config"
@@ -37,11 +42,14 @@ import (
 	"github.com/ory/hydra/v2/fositex"
 	"github.com/ory/hydra/v2/hsm"
 	"github.com/ory/hydra/v2/internal/kratos"
+	"github.com/ory/hydra/v2/janitor"
 	"github.com/ory/hydra/v2/jwk"
 	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/oauth2/scope"
 	"github.com/ory/hydra/v2/oauth2/trust"
 	"github.com/ory/hydra/v2/persistence"
 	"github.com/ory/hydra/v2/persistence/sql"
+	"github.com/ory/hydra/v2/tenant"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/hydra/v2/x/oauth2cors"
 	"github.com/ory/pop/v6"
@@ -50,6 +58,7 @@ import (
 	"github.com/ory/x/healthx"
 	"github.com/ory/x/httprouterx"
 	"github.com/ory/x/httpx"
+	"github.com/ory/x/jsonnetsecure"
 	"github.com/ory/x/logrusx"
 	"github.com/ory/x/otelx"
 	"github.com/ory/x/popx"
@@ -84,8 +93,13 @@ type RegistrySQL struct {
 	oc                          fosite.Configurator
 	oidcs                       jwk.JWTSigner
 	ats                         jwk.JWTSigner
+	its                         jwk.JWTSigner
 	hmacs                       foauth2.CoreStrategy
 	jwtStrategy                 foauth2.AccessTokenStrategy
+	jweRefreshStrategy          *fositex.JWERefreshTokenStrategy
+	introspectionCache          *oauth2.IntrospectionCache
+	tenantResolver              *tenant.Resolver
+	janitor                     *janitor.Janitor
 	enigmaHMAC                  *hmac.HMACStrategy
 	deviceHmac                  *rfc8628.DefaultDeviceStrategy
 	fc                          *fositex.Config
@@ -98,6 +112,10 @@ type RegistrySQL struct {
 	keyManager     jwk.Manager
 	consentManager consent.Manager
 
+	jsonnetOnce       sync.Once
+	jsonnetPool       jsonnetsecure.Pool
+	jsonnetVMProvider jsonnetsecure.VMProvider
+
 	initialPing func(ctx context.Context, l *logrusx.Logger, p *sql.BasePersister) error
 	middlewares []negroni.Handler
 }
@@ -198,30 +216,9 @@ func (m *RegistrySQL) Init(
 		}
 
 		// new db connection
-		pool, idlePool, connMaxLifetime, connMaxIdleTime, cleanedDSN := sqlcon.ParseConnectionOptions(
-			m.l, m.Config().DSN(),
-		)
-
-		opts := &pop.ConnectionDetails{
-			URL:             sqlcon.FinalizeDSN(m.l, cleanedDSN),
-			IdlePool:        idlePool,
-			ConnMaxLifetime: connMaxLifetime,
-			ConnMaxIdleTime: connMaxIdleTime,
-			Pool:            pool,
-			TracerProvider:  m.Tracer(ctx).Provider(),
-			Unsafe:          m.Config().DbIgnoreUnknownTableColumns(),
-		}
-
-		for _, f := range m.dbOptsModifier {
-			f(opts)
-		}
-
-		c, err := pop.NewConnection(opts)
+		c, err := m.newConnection(ctx, m.Config().DSN())
 		if err != nil {
-			return errors.WithStack(err)
-		}
-		if err := resilience.Retry(m.l, 5*time.Second, 5*time.Minute, c.Open); err != nil {
-			return errors.WithStack(err)
+			return err
 		}
 
 		m.basePersister = sql.NewBasePersister(c, m)
@@ -230,6 +227,19 @@ func (m *RegistrySQL) Init(
 			return err
 		}
 
+		if dsns := m.Config().DSNReadReplicas(); len(dsns) > 0 {
+			replicas := make([]*sql.ReadReplica, len(dsns))
+			for i, dsn := range dsns {
+				rc, err := m.newConnection(ctx, dsn)
+				if err != nil {
+					return err
+				}
+				replicas[i] = sql.NewReadReplica(readReplicaName(i, dsn), rc)
+			}
+			m.basePersister = m.basePersister.WithReadReplicas(replicas)
+			m.basePersister.CheckReadReplicas(ctx)
+		}
+
 		m.migrator = sql.NewMigrationManager(c, m, extraMigrations, goMigrations)
 
 		// if dsn is memory we have to run the migrations on every start
@@ -259,6 +269,42 @@ func (m *RegistrySQL) Init(
 	return nil
 }
 
+func (m *RegistrySQL) newConnection(ctx context.Context, dsn string) (*pop.Connection, error) {
+	pool, idlePool, connMaxLifetime, connMaxIdleTime, cleanedDSN := sqlcon.ParseConnectionOptions(m.l, dsn)
+
+	opts := &pop.ConnectionDetails{
+		URL:             sqlcon.FinalizeDSN(m.l, cleanedDSN),
+		IdlePool:        idlePool,
+		ConnMaxLifetime: connMaxLifetime,
+		ConnMaxIdleTime: connMaxIdleTime,
+		Pool:            pool,
+		TracerProvider:  m.Tracer(ctx).Provider(),
+		Unsafe:          m.Config().DbIgnoreUnknownTableColumns(),
+	}
+
+	for _, f := range m.dbOptsModifier {
+		f(opts)
+	}
+
+	c, err := pop.NewConnection(opts)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := resilience.Retry(m.l, 5*time.Second, 5*time.Minute, c.Open); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return c, nil
+}
+
+// readReplicaName returns a name for the replica which does not leak its
+// credentials.
+func readReplicaName(i int, dsn string) string {
+	if u, err := url.Parse(dsn); err == nil && u.Host != "" {
+		return fmt.Sprintf("%d:%s", i, u.Host)
+	}
+	return strconv.Itoa(i)
+}
+
 func (m *RegistrySQL) InitNetwork(ctx context.Context) error {
 	net, err := m.basePersister.DetermineNetwork(ctx)
 	if err != nil {
@@ -286,7 +332,11 @@ func (m *RegistrySQL) ObfuscatedSubjectManager() consent.ObfuscatedSubjectManage
 }
 func (m *RegistrySQL) LoginManager() consent.LoginManager   { return m.Persister() }
 func (m *RegistrySQL) LogoutManager() consent.LogoutManager { return m.Persister() }
-func (m *RegistrySQL) OAuth2Storage() x.FositeStorer        { return m.Persister() }
+func (m *RegistrySQL) SubjectEraser() consent.SubjectEraser { return m.Persister() }
+func (m *RegistrySQL) PairwiseSubjectManager() consent.PairwiseSubjectManager {
+	return m.Persister()
+}
+func (m *RegistrySQL) OAuth2Storage() x.FositeStorer { return m.Persister() }
 
 func (m *RegistrySQL) KeyManager() jwk.Manager {
 	if m.keyManager == nil {
@@ -303,6 +353,28 @@ func (m *RegistrySQL) KeyManager() jwk.Manager {
 
 func (m *RegistrySQL) GrantManager() trust.GrantManager { return m.Persister() }
 
+func (m *RegistrySQL) ScopeManager() scope.Manager { return m.Persister() }
+
+func (m *RegistrySQL) TenantManager() tenant.Manager { return m.Persister() }
+
+func (m *RegistrySQL) TenantResolver() *tenant.Resolver {
+	if m.tenantResolver == nil {
+		m.tenantResolver = tenant.NewResolver(m)
+	}
+	return m.tenantResolver
+}
+
+func (m *RegistrySQL) JanitorManager() janitor.Manager { return m.Persister() }
+
+func (m *RegistrySQL) BundleManager() bundle.Manager { return m.Persister() }
+
+func (m *RegistrySQL) Janitor() *janitor.Janitor {
+	if m.janitor == nil {
+		m.janitor = janitor.NewJanitor(m)
+	}
+	return m.janitor
+}
+
 func (m *RegistrySQL) Contextualizer() contextx.Contextualizer {
 	if m.ctxer == nil {
 		panic("registry Contextualizer not set")
@@ -344,6 +416,9 @@ func (m *RegistrySQL) RegisterAdminRoutes(admin *httprouterx.RouterAdmin) {
 	client.NewHandler(m).SetAdminRoutes(admin)
 	oauth2.NewHandler(m).SetAdminRoutes(admin)
 	trust.NewHandler(m).SetRoutes(admin)
+	scope.NewHandler(m).SetRoutes(admin)
+	tenant.NewHandler(m).SetRoutes(admin)
+	janitor.NewHandler(m).SetRoutes(admin)
 }
 
 func (m *RegistrySQL) Writer() herodot.Writer {
@@ -499,6 +574,20 @@ func (m *RegistrySQL) AccessTokenJWTSigner() jwk.JWTSigner {
 	return m.ats
 }
 
+func (m *RegistrySQL) IntrospectionJWTSigner() jwk.JWTSigner {
+	if m.its == nil {
+		m.its = jwk.NewDefaultJWTSigner(m, x.OAuth2IntrospectionKeyName)
+	}
+	return m.its
+}
+
+func (m *RegistrySQL) OAuth2IntrospectionCache() *oauth2.IntrospectionCache {
+	if m.introspectionCache == nil {
+		m.introspectionCache = oauth2.NewIntrospectionCache(contextx.RootContext, m)
+	}
+	return m.introspectionCache
+}
+
 func (m *RegistrySQL) OAuth2EnigmaStrategy() *hmac.HMACStrategy {
 	if m.enigmaHMAC == nil {
 		m.enigmaHMAC = &hmac.HMACStrategy{Config: m.OAuth2Config()}
@@ -523,6 +612,13 @@ func (m *RegistrySQL) OAuth2JWTStrategy() foauth2.AccessTokenStrategy {
 	return m.jwtStrategy
 }
 
+func (m *RegistrySQL) OAuth2JWERefreshTokenStrategy() *fositex.JWERefreshTokenStrategy {
+	if m.jweRefreshStrategy == nil {
+		m.jweRefreshStrategy = fositex.NewJWERefreshTokenStrategy(m)
+	}
+	return m.jweRefreshStrategy
+}
+
 func (m *RegistrySQL) OAuth2AuthorizeCodeStrategy() foauth2.AuthorizeCodeStrategy {
 	if m.authorizeCodeStrategy != nil {
 		return m.authorizeCodeStrategy
@@ -631,11 +727,26 @@ func (m *RegistrySQL) AccessRequestHooks() []oauth2.AccessRequestHook {
 		m.arhs = []oauth2.AccessRequestHook{
 			oauth2.RefreshTokenHook(m),
 			oauth2.TokenHook(m),
+			oauth2.TokenClaimsMapperHook(m),
 		}
 	}
 	return m.arhs
 }
 
+// JsonnetVM returns a sandboxed Jsonnet VM which is evaluated by the hidden
+// `jsonnet` subcommand of the running binary. The worker processes are shared
+// by all tenants, so their memory limit is read from the root configuration.
+func (m *RegistrySQL) JsonnetVM(ctx context.Context) (jsonnetsecure.VM, error) {
+	m.jsonnetOnce.Do(func() {
+		if m.jsonnetVMProvider == nil {
+			m.jsonnetPool = jsonnetsecure.NewProcessPool(runtime.GOMAXPROCS(0),
+				jsonnetsecure.WithMemoryLimit(m.Config().TokenClaimsMapperMemoryLimit(contextx.RootContext)))
+			m.jsonnetVMProvider = &jsonnetsecure.DefaultProvider{Subcommand: "jsonnet", Pool: m.jsonnetPool}
+		}
+	})
+	return m.jsonnetVMProvider.JsonnetVM(ctx)
+}
+
 func (m *RegistrySQL) HSMContext() hsm.Context {
 	if m.hsm == nil {
 		m.hsm = hsm.NewContext(m.Config(), m.l)
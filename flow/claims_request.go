@@ -0,0 +1,175 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/fosite"
+)
+
+// protocolClaims are set by Ory Hydra itself and are always granted.
+var protocolClaims = []string{
+	"sub", "iss", "aud", "exp", "iat", "nbf", "jti", "nonce",
+	"auth_time", "acr", "amr", "azp", "at_hash", "c_hash", "sid", "rat",
+}
+
+// ScopeClaims are the standard claims requested by the OpenID Connect scope
+// values, see OpenID Connect Core 1.0 Section 5.4.
+var ScopeClaims = map[string][]string{
+	"profile": {
+		"name", "family_name", "given_name", "middle_name", "nickname", "preferred_username",
+		"profile", "picture", "website", "gender", "birthdate", "zoneinfo", "locale", "updated_at",
+	},
+	"email":   {"email", "email_verified"},
+	"address": {"address"},
+	"phone":   {"phone_number", "phone_number_verified"},
+}
+
+// RequestedByScope reports whether the claim is a standard claim requested by
+// one of the scopes.
+func RequestedByScope(claim string, scopes fosite.Arguments) bool {
+	for scope, claims := range ScopeClaims {
+		if scopes.Has(scope) && slices.Contains(claims, claim) {
+			return true
+		}
+	}
+	return false
+}
+
+// OpenID Connect Claims Request
+//
+// The `claims` request parameter as defined in OpenID Connect Core 1.0 Section 5.5. It requests
+// individual claims to be returned in the ID token and from the userinfo endpoint.
+//
+// swagger:model oAuth2ConsentRequestClaims
+type OIDCClaimsRequest struct {
+	// UserInfo are the claims requested to be returned from the userinfo endpoint. If set,
+	// the userinfo endpoint returns these claims in addition to the protocol claims and the
+	// claims requested by the granted scopes.
+	UserInfo map[string]*OIDCClaimRequest `json:"userinfo,omitempty"`
+
+	// IDToken are the claims requested to be returned in the ID token. If set, the ID token
+	// contains these claims in addition to the protocol claims and the claims requested by
+	// the granted scopes.
+	IDToken map[string]*OIDCClaimRequest `json:"id_token,omitempty"`
+}
+
+// OpenID Connect Individual Claim Request
+//
+// The requirements for an individual claim. A null value requests the claim in the default manner.
+//
+// swagger:model oAuth2ConsentRequestClaim
+type OIDCClaimRequest struct {
+	// Essential indicates whether the claim is necessary for the client to work. The consent
+	// request can only be accepted if all essential claims are granted.
+	Essential bool `json:"essential,omitempty"`
+
+	// Value is the value the claim is requested to have.
+	Value interface{} `json:"value,omitempty"`
+
+	// Values are the values the claim is requested to have, in order of preference.
+	Values []interface{} `json:"values,omitempty"`
+}
+
+// ClaimsRequest returns the OpenID Connect `claims` request parameter of the
+// flow, or nil if none was sent.
+func (f *Flow) ClaimsRequest() *OIDCClaimsRequest {
+	if f.OpenIDConnectContext == nil {
+		return nil
+	}
+	return f.OpenIDConnectContext.Claims
+}
+
+// ParseClaimsRequest parses the `claims` request parameter. It returns nil if
+// the parameter is empty.
+func ParseClaimsRequest(raw string) (*OIDCClaimsRequest, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var r OIDCClaimsRequest
+	if err := json.Unmarshal([]byte(raw), &r); err != nil {
+		return nil, errors.WithStack(fosite.ErrInvalidRequest.WithHint("The 'claims' parameter must be a JSON object with the members 'userinfo' and 'id_token'.").WithDebug(err.Error()))
+	}
+	return &r, nil
+}
+
+// ValidateGranted returns an error if an essential claim was not granted, or
+// was granted with a value other than the requested one.
+func (r *OIDCClaimsRequest) ValidateGranted(granted map[string]interface{}) error {
+	if r == nil {
+		return nil
+	}
+
+	for _, requested := range []map[string]*OIDCClaimRequest{r.IDToken, r.UserInfo} {
+		names := make([]string, 0, len(requested))
+		for name := range requested {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			c := requested[name]
+			if c == nil || !c.Essential || slices.Contains(protocolClaims, name) {
+				continue
+			}
+
+			value, ok := granted[name]
+			if !ok {
+				return errors.WithStack(fosite.ErrInvalidRequest.WithHintf("The essential claim '%s' was requested but not granted in 'session.id_token'.", name))
+			}
+			if !c.matches(value) {
+				return errors.WithStack(fosite.ErrInvalidRequest.WithHintf("The essential claim '%s' was granted with a value other than the requested one.", name))
+			}
+		}
+	}
+
+	return nil
+}
+
+// IDTokenClaims returns the granted claims to include in the ID token. The
+// requested claims are returned in addition to the claims requested by the
+// granted scopes, see OpenID Connect Core 1.0 Section 5.5.
+func (r *OIDCClaimsRequest) IDTokenClaims(granted map[string]interface{}, grantedScopes fosite.Arguments) map[string]interface{} {
+	if r == nil || r.IDToken == nil {
+		return granted
+	}
+	return filterClaims(r.IDToken, granted, grantedScopes)
+}
+
+// UserInfoClaims returns the granted claims to return from the userinfo
+// endpoint. The requested claims are returned in addition to the claims
+// requested by the granted scopes.
+func (r *OIDCClaimsRequest) UserInfoClaims(granted map[string]interface{}, grantedScopes fosite.Arguments) map[string]interface{} {
+	if r == nil || r.UserInfo == nil {
+		return granted
+	}
+	return filterClaims(r.UserInfo, granted, grantedScopes)
+}
+
+func (c *OIDCClaimRequest) matches(value interface{}) bool {
+	if c.Value != nil {
+		return reflect.DeepEqual(c.Value, value)
+	}
+	if len(c.Values) > 0 {
+		return slices.ContainsFunc(c.Values, func(v interface{}) bool { return reflect.DeepEqual(v, value) })
+	}
+	return true
+}
+
+func filterClaims(requested map[string]*OIDCClaimRequest, granted map[string]interface{}, grantedScopes fosite.Arguments) map[string]interface{} {
+	filtered := make(map[string]interface{}, len(requested))
+	for name, value := range granted {
+		if _, ok := requested[name]; ok || RequestedByScope(name, grantedScopes) {
+			filtered[name] = value
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,73 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/fosite"
+)
+
+func TestClaimsRequest(t *testing.T) {
+	t.Run("case=parses the claims parameter", func(t *testing.T) {
+		r, err := ParseClaimsRequest("")
+		require.NoError(t, err)
+		assert.Nil(t, r)
+
+		_, err = ParseClaimsRequest(`["email"]`)
+		assert.ErrorIs(t, err, fosite.ErrInvalidRequest)
+
+		r, err = ParseClaimsRequest(`{"userinfo":{"email":null,"locale":{"values":["de","en"]}},"id_token":{"email_verified":{"essential":true,"value":true}}}`)
+		require.NoError(t, err)
+		assert.Equal(t, &OIDCClaimsRequest{
+			UserInfo: map[string]*OIDCClaimRequest{"email": nil, "locale": {Values: []interface{}{"de", "en"}}},
+			IDToken:  map[string]*OIDCClaimRequest{"email_verified": {Essential: true, Value: true}},
+		}, r)
+	})
+
+	t.Run("case=validates essential claims", func(t *testing.T) {
+		r := &OIDCClaimsRequest{
+			UserInfo: map[string]*OIDCClaimRequest{"email": {Essential: true}, "locale": {Essential: true, Values: []interface{}{"de", "en"}}},
+			IDToken:  map[string]*OIDCClaimRequest{"auth_time": {Essential: true}, "name": nil, "nickname": {}},
+		}
+
+		assert.NoError(t, r.ValidateGranted(map[string]interface{}{"email": "foo@bar", "locale": "en"}))
+
+		err := r.ValidateGranted(map[string]interface{}{"locale": "en"})
+		require.ErrorIs(t, err, fosite.ErrInvalidRequest)
+		assert.Contains(t, fosite.ErrorToRFC6749Error(err).HintField, "'email' was requested but not granted")
+
+		err = r.ValidateGranted(map[string]interface{}{"email": "foo@bar", "locale": "fr"})
+		require.ErrorIs(t, err, fosite.ErrInvalidRequest)
+		assert.Contains(t, fosite.ErrorToRFC6749Error(err).HintField, "'locale' was granted with a value other than the requested one")
+
+		assert.NoError(t, (*OIDCClaimsRequest)(nil).ValidateGranted(nil))
+	})
+
+	t.Run("case=filters claims by member", func(t *testing.T) {
+		granted := map[string]interface{}{"email": "foo@bar", "name": "Foo", "locale": "en"}
+		r := &OIDCClaimsRequest{IDToken: map[string]*OIDCClaimRequest{"email": nil, "picture": nil}}
+
+		assert.Equal(t, map[string]interface{}{"email": "foo@bar"}, r.IDTokenClaims(granted, nil))
+		assert.Equal(t, granted, r.UserInfoClaims(granted, nil))
+
+		r.UserInfo = map[string]*OIDCClaimRequest{}
+		assert.Empty(t, r.UserInfoClaims(granted, nil))
+		assert.Equal(t, granted, (*OIDCClaimsRequest)(nil).IDTokenClaims(granted, nil))
+	})
+
+	t.Run("case=merges requested claims with scope claims", func(t *testing.T) {
+		granted := map[string]interface{}{"email": "foo@bar", "name": "Foo", "locale": "en", "tenant": "acme"}
+		r := &OIDCClaimsRequest{
+			IDToken:  map[string]*OIDCClaimRequest{"email": nil},
+			UserInfo: map[string]*OIDCClaimRequest{"tenant": nil},
+		}
+
+		assert.Equal(t, map[string]interface{}{"email": "foo@bar", "name": "Foo", "locale": "en"}, r.IDTokenClaims(granted, fosite.Arguments{"openid", "profile"}))
+		assert.Equal(t, map[string]interface{}{"email": "foo@bar", "tenant": "acme"}, r.UserInfoClaims(granted, fosite.Arguments{"openid", "email"}))
+	})
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/oauth2/scope"
 	"github.com/ory/x/sqlxx"
 )
 
@@ -43,6 +44,7 @@ type LoginSession struct {
 	IdentityProviderSessionID sqlxx.NullString `db:"identity_provider_session_id"`
 	Remember                  bool             `db:"remember"`
 	ExpiresAt                 sqlxx.NullTime   `db:"expires_at"`
+	ACR                       string           `db:"acr"`
 }
 
 func (LoginSession) TableName() string {
@@ -354,6 +356,11 @@ type OAuth2ConsentRequestOpenIDConnectContext struct {
 	// and then wants to pass that value as a hint to the discovered authorization service. This value MAY also be a
 	// phone number in the format specified for the phone_number Claim. The use of this parameter is optional.
 	LoginHint string `json:"login_hint,omitempty"`
+
+	// Claims is the `claims` request parameter, which requests individual claims to be returned in the
+	// ID token and from the userinfo endpoint. Essential claims must be granted in `session.id_token`
+	// when accepting the consent request.
+	Claims *OIDCClaimsRequest `json:"claims,omitempty" faker:"-"`
 }
 
 func (n *OAuth2ConsentRequestOpenIDConnectContext) MarshalJSON() ([]byte, error) {
@@ -551,6 +558,14 @@ type OAuth2ConsentRequest struct {
 	// RequestedScope contains the OAuth 2.0 Scope requested by the OAuth 2.0 Client.
 	RequestedScope sqlxx.StringSliceJSONFormat `json:"requested_scope"`
 
+	// RequestedScopeMetadata contains the scope registry entries of the requested scopes. Requested scopes
+	// which are not registered are omitted.
+	RequestedScopeMetadata []scope.Scope `json:"requested_scope_metadata,omitempty" faker:"-"`
+
+	// RequestedScopeParameters contains the parameters of the requested scopes which are instances of a
+	// scope template, keyed by scope.
+	RequestedScopeParameters map[string]map[string]string `json:"requested_scope_parameters,omitempty" faker:"-"`
+
 	// RequestedAudience contains the access token audience as requested by the OAuth 2.0 Client.
 	RequestedAudience sqlxx.StringSliceJSONFormat `json:"requested_access_token_audience"`
 
@@ -389,6 +389,16 @@ func (f *Flow) HandleConsentRequest(r *AcceptOAuth2ConsentRequest) error {
 		return err
 	}
 
+	if claims := f.ClaimsRequest(); claims != nil {
+		var granted map[string]interface{}
+		if r.Session != nil {
+			granted = r.Session.IDToken
+		}
+		if err := claims.ValidateGranted(granted); err != nil {
+			return err
+		}
+	}
+
 	f.State = FlowStateConsentUnused
 
 	f.GrantedScope = r.GrantedScope
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/fosite"
@@ -15,6 +17,13 @@ import (
 	"github.com/ory/x/otelx"
 )
 
+var expiredFlows = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "hydra",
+	Subsystem: "flow",
+	Name:      "expired_total",
+	Help:      "Number of login, consent and device challenges and verifiers used after the flow expired.",
+}, []string{"flow"})
+
 type decodeDependencies interface {
 	CipherProvider
 	x.NetworkProvider
@@ -33,6 +42,7 @@ func decodeFlow(ctx context.Context, d decodeDependencies, enc string, p purpose
 	}
 
 	if f.RequestedAt.Add(d.Config().ConsentRequestMaxAge(ctx)).Before(time.Now()) {
+		expiredFlows.WithLabelValues(p.RequestType()).Inc()
 		return nil, errors.WithStack(fosite.ErrRequestUnauthorized.WithHintf("The %s request has expired, please try again.", p.RequestType()))
 	}
 
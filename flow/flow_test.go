@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/x/sqlxx"
 )
 
@@ -193,6 +194,20 @@ func TestFlow_HandleConsentRequest(t *testing.T) {
 		assert.EqualValues(t, expected.Session.AccessToken, f.SessionAccessToken)
 	})
 
+	t.Run("HandleConsentRequest should fail when an essential claim was not granted", func(t *testing.T) {
+		f := deepcopy.Copy(fGood).(Flow)
+		f.OpenIDConnectContext = &OAuth2ConsentRequestOpenIDConnectContext{Claims: &OIDCClaimsRequest{
+			IDToken: map[string]*OIDCClaimRequest{"claim1": {Essential: true}, "email": {Essential: true}},
+		}}
+		err := f.HandleConsentRequest(&expected)
+		require.ErrorIs(t, err, fosite.ErrInvalidRequest)
+		assert.Contains(t, fosite.ErrorToRFC6749Error(err).HintField, "essential claim 'email'")
+		assert.Empty(t, f.SessionIDToken)
+
+		f.OpenIDConnectContext.Claims.IDToken["email"].Essential = false
+		require.NoError(t, f.HandleConsentRequest(&expected))
+	})
+
 	require.NoError(t, fGood.HandleConsentRequest(&expected))
 
 	assert.Equal(t, expected.GrantedScope, fGood.GrantedScope)
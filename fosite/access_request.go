@@ -7,6 +7,10 @@ type AccessRequest struct {
 	GrantTypes       Arguments `json:"grantTypes" gorethink:"grantTypes"`
 	HandledGrantType Arguments `json:"handledGrantType" gorethink:"handledGrantType"`
 
+	// RefreshTokenGrantedScope is the scope of the refresh token if the access
+	// token was downscoped during the refresh token grant.
+	RefreshTokenGrantedScope Arguments `json:"refreshTokenGrantedScope,omitempty" gorethink:"refreshTokenGrantedScope"`
+
 	Request
 }
 
@@ -5,6 +5,7 @@ package fosite
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -155,7 +156,17 @@ func (f *Fosite) authorizeRequestParametersFromOpenIDConnectRequest(ctx context.
 	}
 
 	for k, v := range claims {
-		request.Form.Set(k, fmt.Sprintf("%s", v))
+		switch v.(type) {
+		case map[string]interface{}:
+			// Structured parameters such as "claims" are passed as JSON.
+			raw, err := json.Marshal(v)
+			if err != nil {
+				return errorsx.WithStack(ErrInvalidRequestObject.WithHintf("Unable to encode the request object's '%s' parameter.", k).WithWrap(err).WithDebug(err.Error()))
+			}
+			request.Form.Set(k, string(raw))
+		default:
+			request.Form.Set(k, fmt.Sprintf("%s", v))
+		}
 	}
 
 	claimScope := RemoveEmpty(strings.Split(request.Form.Get("scope"), " "))
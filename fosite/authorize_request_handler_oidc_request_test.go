@@ -64,6 +64,7 @@ func TestAuthorizeRequestParametersFromOpenIDConnectRequest(t *testing.T) {
 
 	validRequestObject := mustGenerateAssertion(t, jwt.MapClaims{"scope": "foo", "foo": "bar", "baz": "baz", "response_type": "token", "response_mode": "post_form"}, key, "kid-foo")
 	validRequestObjectWithoutKid := mustGenerateAssertion(t, jwt.MapClaims{"scope": "foo", "foo": "bar", "baz": "baz"}, key, "")
+	claimsRequestObject := mustGenerateAssertion(t, jwt.MapClaims{"scope": "foo", "claims": map[string]interface{}{"id_token": map[string]interface{}{"email": map[string]interface{}{"essential": true}}}}, key, "kid-foo")
 	validNoneRequestObject := mustGenerateNoneAssertion(t, jwt.MapClaims{"scope": "foo", "foo": "bar", "baz": "baz", "state": "some-state"})
 
 	var reqH http.HandlerFunc = func(rw http.ResponseWriter, r *http.Request) {
@@ -156,6 +157,12 @@ func TestAuthorizeRequestParametersFromOpenIDConnectRequest(t *testing.T) {
 			// The values from form are overwritten by the request object.
 			expectForm: url.Values{"response_type": {"token"}, "response_mode": {"post_form"}, "scope": {"foo openid"}, "request": {validRequestObject}, "foo": {"bar"}, "baz": {"baz"}},
 		},
+		{
+			d:          "should pass and encode structured request parameters as JSON",
+			form:       url.Values{"scope": {"openid"}, "request": {claimsRequestObject}},
+			client:     &DefaultOpenIDConnectClient{JSONWebKeys: jwks, RequestObjectSigningAlgorithm: "RS256"},
+			expectForm: url.Values{"scope": {"foo openid"}, "request": {claimsRequestObject}, "claims": {`{"id_token":{"email":{"essential":true}}}`}},
+		},
 		{
 			d:          "should pass even if kid is unset",
 			form:       url.Values{"scope": {"openid"}, "request": {validRequestObjectWithoutKid}},
@@ -194,6 +194,15 @@ type GrantTypeJWTBearerOmitAssertionAudienceProvider interface {
 	GetGrantTypeJWTBearerOmitAssertionAudience(ctx context.Context) bool
 }
 
+// RefreshTokenDownscopingProvider returns the provider for configuring whether the scope requested in the
+// refresh token grant narrows the scope of the issued access token.
+type RefreshTokenDownscopingProvider interface {
+	// GetRefreshTokenDownscoping returns whether the refresh token grant issues the access token for the requested
+	// scope only, while the refresh token keeps its scope. Ory Hydra enables it together with the parameterized
+	// scope strategy, whose templates let clients narrow the parameters of granted scopes.
+	GetRefreshTokenDownscoping(ctx context.Context) bool
+}
+
 // GetJWTMaxDurationProvider returns the provider for configuring the JWT max duration.
 type GetJWTMaxDurationProvider interface {
 	// GetJWTMaxDuration returns the JWT max duration.
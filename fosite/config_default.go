@@ -47,6 +47,7 @@ var (
 	_ GrantTypeJWTBearerIDOptionalProvider            = (*Config)(nil)
 	_ GrantTypeJWTBearerIssuedDateOptionalProvider    = (*Config)(nil)
 	_ GrantTypeJWTBearerOmitAssertionAudienceProvider = (*Config)(nil)
+	_ RefreshTokenDownscopingProvider                 = (*Config)(nil)
 	_ GetJWTMaxDurationProvider                       = (*Config)(nil)
 	_ IDTokenLifespanProvider                         = (*Config)(nil)
 	_ IDTokenIssuerProvider                           = (*Config)(nil)
@@ -169,6 +170,10 @@ type Config struct {
 	// GrantTypeJWTBearerMaxDuration sets the maximum time after JWT issued date, during which the JWT is considered valid.
 	GrantTypeJWTBearerMaxDuration time.Duration
 
+	// RefreshTokenDownscoping indicates whether the refresh token grant issues the access token for the requested
+	// scope only. Defaults to false, in which case the requested scope is ignored.
+	RefreshTokenDownscoping bool
+
 	// ClientAuthenticationStrategy indicates the Strategy to authenticate client requests
 	ClientAuthenticationStrategy ClientAuthenticationStrategy
 
@@ -334,6 +339,11 @@ func (c *Config) GetGrantTypeJWTBearerOmitAssertionAudience(ctx context.Context)
 	return c.GrantTypeJWTBearerOmitAssertionAudience
 }
 
+// GetRefreshTokenDownscoping returns RefreshTokenDownscoping.
+func (c *Config) GetRefreshTokenDownscoping(ctx context.Context) bool {
+	return c.RefreshTokenDownscoping
+}
+
 // GetGrantTypeJWTBearerCanSkipClientAuth returns the GrantTypeJWTBearerCanSkipClientAuth field.
 func (c *Config) GetGrantTypeJWTBearerCanSkipClientAuth(ctx context.Context) bool {
 	return c.GrantTypeJWTBearerCanSkipClientAuth
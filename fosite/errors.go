@@ -226,6 +226,16 @@ var (
 		ErrorField:       errDeviceExpiredToken,
 		CodeField:        http.StatusBadRequest,
 	}
+	ErrUnmetAuthenticationRequirements = &RFC6749Error{
+		DescriptionField: "The Authorization Server is unable to meet the requirements of the Relying Party for the authentication of the End-User.",
+		ErrorField:       errUnmetAuthenticationRequirements,
+		CodeField:        http.StatusBadRequest,
+	}
+	ErrInsufficientUserAuthentication = &RFC6749Error{
+		DescriptionField: "The authentication event associated with the access token presented with the request does not meet the authentication requirements of the protected resource.",
+		ErrorField:       errInsufficientUserAuthentication,
+		CodeField:        http.StatusUnauthorized,
+	}
 )
 
 const (
@@ -266,6 +276,10 @@ const (
 	errAuthorizationPending         = "authorization_pending"
 	errSlowDown                     = "slow_down"
 	errDeviceExpiredToken           = "expired_token"
+	// https://openid.net/specs/openid-connect-unmet-authentication-requirements-1_0.html
+	errUnmetAuthenticationRequirements = "unmet_authentication_requirements"
+	// https://www.rfc-editor.org/rfc/rfc9470.html#section-3
+	errInsufficientUserAuthentication = "insufficient_user_authentication"
 )
 
 type (
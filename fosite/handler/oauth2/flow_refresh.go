@@ -89,6 +89,7 @@ func (c *RefreshTokenGrantHandler) HandleTokenEndpointRequest(ctx context.Contex
 		return errorsx.WithStack(fosite.ErrInvalidGrant.WithHint("The OAuth 2.0 Client ID from this request does not match the ID during the initial token issuance."))
 	}
 
+	downscoped := request.GetRequestedScopes()
 	request.SetID(originalRequest.GetID())
 	request.SetSession(originalRequest.GetSession().Clone())
 	request.SetRequestedScopes(originalRequest.GetRequestedScopes())
@@ -98,6 +99,31 @@ func (c *RefreshTokenGrantHandler) HandleTokenEndpointRequest(ctx context.Contex
 		if !c.Config.GetScopeStrategy(ctx)(request.GetClient().GetScopes(), scope) {
 			return errorsx.WithStack(fosite.ErrInvalidScope.WithHintf("The OAuth 2.0 Client is not allowed to request scope '%s'.", scope))
 		}
+	}
+
+	// The requested scope MUST NOT include any scope not originally granted by the resource owner, and if
+	// omitted is treated as equal to the scope originally granted. The refresh token keeps its scope.
+	// Config is an optional interface here to avoid widening fosite.Configurator; without it, the requested
+	// scope is ignored as before.
+	//
+	// See https://datatracker.ietf.org/doc/html/rfc6749#section-6
+	scopes := originalRequest.GetGrantedScopes()
+	if p, ok := c.Config.(fosite.RefreshTokenDownscopingProvider); !ok || !p.GetRefreshTokenDownscoping(ctx) {
+		downscoped = nil
+	}
+	if len(downscoped) > 0 {
+		for _, scope := range downscoped {
+			if !c.Config.GetScopeStrategy(ctx)(originalRequest.GetGrantedScopes(), scope) {
+				return errorsx.WithStack(fosite.ErrInvalidScope.WithHintf("The requested scope '%s' was not originally granted by the resource owner.", scope))
+			}
+		}
+		if ar, ok := request.(*fosite.AccessRequest); ok {
+			ar.RefreshTokenGrantedScope = originalRequest.GetGrantedScopes()
+		}
+		scopes = downscoped
+	}
+
+	for _, scope := range scopes {
 		request.GrantScope(scope)
 	}
 
@@ -141,6 +167,15 @@ func (c *RefreshTokenGrantHandler) PopulateTokenEndpointResponse(ctx context.Con
 	storeReq := requester.Sanitize([]string{})
 	storeReq.SetID(requester.GetID())
 
+	rtStoreReq := storeReq
+	if ar, ok := requester.(*fosite.AccessRequest); ok && len(ar.RefreshTokenGrantedScope) > 0 {
+		if r, ok := storeReq.(*fosite.Request); ok {
+			rt := *r
+			rt.GrantedScope = ar.RefreshTokenGrantedScope
+			rtStoreReq = &rt
+		}
+	}
+
 	err = c.Storage.Transaction(ctx, func(ctx context.Context) error {
 		if err := c.Storage.RefreshTokenStorage().RotateRefreshToken(ctx, requester.GetID(), signature); err != nil {
 			return err
@@ -148,7 +183,7 @@ func (c *RefreshTokenGrantHandler) PopulateTokenEndpointResponse(ctx context.Con
 		if err := c.Storage.AccessTokenStorage().CreateAccessTokenSession(ctx, accessSignature, storeReq); err != nil {
 			return err
 		}
-		if err := c.Storage.RefreshTokenStorage().CreateRefreshTokenSession(ctx, refreshSignature, accessSignature, storeReq); err != nil {
+		if err := c.Storage.RefreshTokenStorage().CreateRefreshTokenSession(ctx, refreshSignature, accessSignature, rtStoreReq); err != nil {
 			return err
 		}
 		return nil
@@ -0,0 +1,99 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package rfc7523
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// AssertionRule restricts the assertions accepted by a trust relationship
+// based on their claims, and derives the subject, scopes and extra claims of
+// the access token from them.
+type AssertionRule struct {
+	// ClaimConditions maps claim paths to the values the claim may have. Nested
+	// claims are separated by dots. A claim containing a list matches if one of
+	// its elements is allowed. All conditions must match.
+	ClaimConditions map[string][]string
+
+	// ClaimsMapper is evaluated by the AssertionClaimsMapper, if set.
+	ClaimsMapper string
+}
+
+// AssertionClaimsMapping is the result of a claims mapper.
+type AssertionClaimsMapping struct {
+	// Subject replaces the subject of the access token, if set.
+	Subject string `json:"subject"`
+
+	// Scope restricts the scopes which may be requested, if set. They are
+	// granted if the request does not ask for any scope.
+	Scope []string `json:"scope"`
+
+	// Ext is added to the extra claims of the access token.
+	Ext map[string]any `json:"ext"`
+}
+
+// AssertionClaimsMapper evaluates the claims mapper of an AssertionRule.
+type AssertionClaimsMapper interface {
+	MapAssertionClaims(ctx context.Context, mapper string, claims map[string]any) (*AssertionClaimsMapping, error)
+}
+
+// AssertionClaimsMapperProvider is implemented by configurations which
+// support claims mappers. Trust relationships with a claims mapper are
+// rejected if the configuration does not implement it.
+type AssertionClaimsMapperProvider interface {
+	GetAssertionClaimsMapper(ctx context.Context) AssertionClaimsMapper
+}
+
+// unmatchedClaimCondition returns the path of the first condition of the rule
+// which the claims do not satisfy, or an empty string if all are satisfied.
+func (r *AssertionRule) unmatchedClaimCondition(claims map[string]any) string {
+	paths := make([]string, 0, len(r.ClaimConditions))
+	for path := range r.ClaimConditions {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	for _, path := range paths {
+		if !claimMatches(claimAt(claims, path), r.ClaimConditions[path]) {
+			return path
+		}
+	}
+	return ""
+}
+
+func claimAt(claims map[string]any, path string) any {
+	var value any = claims
+	for _, segment := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		if value, ok = object[segment]; !ok {
+			return nil
+		}
+	}
+	return value
+}
+
+func claimMatches(value any, allowed []string) bool {
+	switch v := value.(type) {
+	case nil, map[string]any:
+		return false
+	case []any:
+		for _, element := range v {
+			if claimMatches(element, allowed) {
+				return true
+			}
+		}
+		return false
+	case float64:
+		return slices.Contains(allowed, strconv.FormatFloat(v, 'f', -1, 64))
+	default:
+		return slices.Contains(allowed, fmt.Sprint(v))
+	}
+}
@@ -37,6 +37,7 @@ type Handler struct {
 		fosite.GetJWTMaxDurationProvider
 		fosite.AudienceStrategyProvider
 		fosite.ScopeStrategyProvider
+		fosite.JWKSFetcherStrategyProvider
 	}
 }
 
@@ -65,13 +66,14 @@ func (c *Handler) HandleTokenEndpointRequest(ctx context.Context, request fosite
 		return err
 	}
 
-	key, err := c.findPublicKeyForToken(ctx, token)
+	key, remotes, err := c.findPublicKeyForToken(ctx, token)
 	if err != nil {
 		return err
 	}
 
 	claims := jwt.Claims{}
-	if err := token.Claims(key, &claims); err != nil {
+	rawClaims := map[string]any{}
+	if err := token.Claims(key, &claims, &rawClaims); err != nil {
 		return errorsx.WithStack(fosite.ErrInvalidGrant.
 			WithHint("Unable to verify the integrity of the 'assertion' value.").
 			WithWrap(err).WithDebug(err.Error()),
@@ -82,14 +84,36 @@ func (c *Handler) HandleTokenEndpointRequest(ctx context.Context, request fosite
 		return err
 	}
 
-	scopes, err := c.Storage.RFC7523KeyStorage().GetPublicKeyScopes(ctx, claims.Issuer, claims.Subject, key.KeyID)
-	if err != nil {
-		return errorsx.WithStack(fosite.ErrServerError.WithWrap(err).WithDebug(err.Error()))
-	}
-
-	for _, scope := range request.GetRequestedScopes() {
-		if !c.Config.GetScopeStrategy(ctx)(scopes, scope) {
-			return errorsx.WithStack(fosite.ErrInvalidScope.WithHintf("The public key registered for issuer \"%s\" and subject \"%s\" is not allowed to request scope \"%s\".", claims.Issuer, claims.Subject, scope))
+	var scopes []string
+	var mapping *AssertionClaimsMapping
+	if len(remotes) > 0 {
+		// Several remote key sets may be trusted for the issuer and subject,
+		// so the first one whose conditions the assertion satisfies wins.
+		var firstErr error
+		for i := range remotes {
+			if scopes, mapping, err = c.authorizeAssertion(ctx, request, claims, rawClaims, remotes[i].Scopes, &remotes[i].Rule); err == nil {
+				firstErr = nil
+				break
+			} else if firstErr == nil {
+				firstErr = err
+			}
+		}
+		if firstErr != nil {
+			return firstErr
+		}
+	} else {
+		var rule *AssertionRule
+		storage := c.Storage.RFC7523KeyStorage()
+		if scopes, err = storage.GetPublicKeyScopes(ctx, claims.Issuer, claims.Subject, key.KeyID); err != nil {
+			return errorsx.WithStack(fosite.ErrServerError.WithWrap(err).WithDebug(err.Error()))
+		}
+		if ruleStorage, ok := storage.(RFC7523AssertionRuleStorage); ok {
+			if rule, err = ruleStorage.GetPublicKeyAssertionRule(ctx, claims.Issuer, claims.Subject, key.KeyID); err != nil {
+				return errorsx.WithStack(fosite.ErrServerError.WithWrap(err).WithDebug(err.Error()))
+			}
+		}
+		if scopes, mapping, err = c.authorizeAssertion(ctx, request, claims, rawClaims, scopes, rule); err != nil {
+			return err
 		}
 	}
 
@@ -102,6 +126,11 @@ func (c *Handler) HandleTokenEndpointRequest(ctx context.Context, request fosite
 	for _, scope := range request.GetRequestedScopes() {
 		request.GrantScope(scope)
 	}
+	if mapping != nil && mapping.Scope != nil && len(request.GetRequestedScopes()) == 0 {
+		for _, scope := range scopes {
+			request.GrantScope(scope)
+		}
+	}
 
 	// The audience of the assertion JWT identifies this authorization server (RFC 7523
 	// section 3), not the resource the access token is for, so it is omitted from the
@@ -127,9 +156,79 @@ func (c *Handler) HandleTokenEndpointRequest(ctx context.Context, request fosite
 	session.SetExpiresAt(fosite.AccessToken, time.Now().UTC().Add(atLifespan).Round(time.Second))
 	session.SetSubject(claims.Subject)
 
+	if mapping != nil {
+		if mapping.Subject != "" {
+			session.SetSubject(mapping.Subject)
+		}
+		if len(mapping.Ext) > 0 {
+			extra, ok := session.(fosite.ExtraClaimsSession)
+			if !ok {
+				return errorsx.WithStack(fosite.ErrServerError.WithHintf("Session must implement fosite.ExtraClaimsSession to map assertion claims but got type: %T", session))
+			}
+			for k, v := range mapping.Ext {
+				extra.GetExtraClaims()[k] = v
+			}
+		}
+	}
+
 	return nil
 }
 
+// authorizeAssertion applies the rule of a trust relationship to the assertion
+// and checks that the requested scopes are allowed by it. It returns the scopes
+// allowed for the assertion and the claims mapping of the rule.
+func (c *Handler) authorizeAssertion(ctx context.Context, request fosite.AccessRequester, claims jwt.Claims, rawClaims map[string]any, scopes []string, rule *AssertionRule) ([]string, *AssertionClaimsMapping, error) {
+	mapping, err := c.applyAssertionRule(ctx, rule, rawClaims)
+	if err != nil {
+		return nil, nil, err
+	}
+	if mapping != nil && mapping.Scope != nil {
+		var mapped []string
+		for _, scope := range mapping.Scope {
+			if c.Config.GetScopeStrategy(ctx)(scopes, scope) {
+				mapped = append(mapped, scope)
+			}
+		}
+		scopes = mapped
+	}
+
+	for _, scope := range request.GetRequestedScopes() {
+		if !c.Config.GetScopeStrategy(ctx)(scopes, scope) {
+			return nil, nil, errorsx.WithStack(fosite.ErrInvalidScope.WithHintf("The public key registered for issuer \"%s\" and subject \"%s\" is not allowed to request scope \"%s\".", claims.Issuer, claims.Subject, scope))
+		}
+	}
+	return scopes, mapping, nil
+}
+
+// applyAssertionRule checks the claims of the assertion against the
+// conditions of the rule and evaluates its claims mapper. It returns nil if
+// the rule has no claims mapper.
+func (c *Handler) applyAssertionRule(ctx context.Context, rule *AssertionRule, claims map[string]any) (*AssertionClaimsMapping, error) {
+	if rule == nil {
+		return nil, nil
+	}
+
+	if path := rule.unmatchedClaimCondition(claims); path != "" {
+		return nil, errorsx.WithStack(fosite.ErrInvalidGrant.
+			WithHintf("The JWT in \"assertion\" request parameter does not satisfy the condition on claim \"%s\" of the trust relationship.", path),
+		)
+	}
+
+	if rule.ClaimsMapper == "" {
+		return nil, nil
+	}
+
+	p, ok := c.Config.(AssertionClaimsMapperProvider)
+	if !ok {
+		return nil, errorsx.WithStack(fosite.ErrServerError.WithHint("The trust relationship maps assertion claims but no claims mapper is configured."))
+	}
+	mapping, err := p.GetAssertionClaimsMapper(ctx).MapAssertionClaims(ctx, rule.ClaimsMapper, claims)
+	if err != nil {
+		return nil, err
+	}
+	return mapping, nil
+}
+
 func (c *Handler) PopulateTokenEndpointResponse(ctx context.Context, request fosite.AccessRequester, response fosite.AccessResponder) error {
 	if err := c.CheckRequest(ctx, request); err != nil {
 		return err
@@ -212,10 +311,14 @@ func (c *Handler) validateTokenPreRequisites(token *jwt.JSONWebToken) error {
 	return nil
 }
 
-func (c *Handler) findPublicKeyForToken(ctx context.Context, token *jwt.JSONWebToken) (*jose.JSONWebKey, error) {
+// findPublicKeyForToken returns the key which verifies the token. Keys
+// registered for the issuer and subject take precedence over keys of remote
+// JSON Web Key Sets, in which case all remote sets with a key verifying the
+// token are returned as well.
+func (c *Handler) findPublicKeyForToken(ctx context.Context, token *jwt.JSONWebToken) (*jose.JSONWebKey, []RemoteJSONWebKeySet, error) {
 	unverifiedClaims := jwt.Claims{}
 	if err := token.UnsafeClaimsWithoutVerification(&unverifiedClaims); err != nil {
-		return nil, errorsx.WithStack(fosite.ErrInvalidRequest.WithWrap(err).WithDebug(err.Error()))
+		return nil, nil, errorsx.WithStack(fosite.ErrInvalidRequest.WithWrap(err).WithDebug(err.Error()))
 	}
 
 	var keyID string
@@ -231,28 +334,97 @@ func (c *Handler) findPublicKeyForToken(ctx context.Context, token *jwt.JSONWebT
 		unverifiedClaims.Issuer,
 		unverifiedClaims.Subject,
 	)
+
+	storage := c.Storage.RFC7523KeyStorage()
+	var err error
 	if keyID != "" {
-		key, err := c.Storage.RFC7523KeyStorage().GetPublicKey(ctx, unverifiedClaims.Issuer, unverifiedClaims.Subject, keyID)
-		if err != nil {
-			return nil, errorsx.WithStack(keyNotFoundErr.WithWrap(err).WithDebug(err.Error()))
+		var key *jose.JSONWebKey
+		if key, err = storage.GetPublicKey(ctx, unverifiedClaims.Issuer, unverifiedClaims.Subject, keyID); err == nil {
+			return key, nil, nil
 		}
-		return key, nil
+	} else {
+		var keys *jose.JSONWebKeySet
+		if keys, err = storage.GetPublicKeys(ctx, unverifiedClaims.Issuer, unverifiedClaims.Subject); err == nil {
+			if key := findVerifyingKey(token, keys, ""); key != nil {
+				return key, nil, nil
+			}
+		}
+	}
+
+	key, remotes, rErr := c.findRemotePublicKeys(ctx, storage, token, unverifiedClaims.Issuer, unverifiedClaims.Subject, keyID)
+	if rErr != nil {
+		return nil, nil, errorsx.WithStack(keyNotFoundErr.WithWrap(rErr).WithDebug(rErr.Error()))
+	} else if key != nil {
+		return key, remotes, nil
 	}
 
-	keys, err := c.Storage.RFC7523KeyStorage().GetPublicKeys(ctx, unverifiedClaims.Issuer, unverifiedClaims.Subject)
 	if err != nil {
-		return nil, errorsx.WithStack(keyNotFoundErr.WithWrap(err).WithDebug(err.Error()))
+		return nil, nil, errorsx.WithStack(keyNotFoundErr.WithWrap(err).WithDebug(err.Error()))
 	}
+	return nil, nil, errorsx.WithStack(keyNotFoundErr)
+}
 
-	claims := jwt.Claims{}
-	for _, key := range keys.Keys {
-		err := token.Claims(key, &claims)
-		if err == nil {
-			return &key, nil
+// findRemotePublicKeys returns the remote JSON Web Key Sets trusted for the
+// issuer and subject which contain a key verifying the token, and the key of
+// the first one. A cached key set is fetched again if none of its keys
+// verifies the token, to pick up keys the issuer rotated in; how often that
+// happens is up to the JWKSFetcherStrategy.
+func (c *Handler) findRemotePublicKeys(ctx context.Context, storage RFC7523KeyStorage, token *jwt.JSONWebToken, issuer, subject, keyID string) (*jose.JSONWebKey, []RemoteJSONWebKeySet, error) {
+	remoteStorage, ok := storage.(RFC7523RemoteKeyStorage)
+	if !ok {
+		return nil, nil, nil
+	}
+
+	sets, err := remoteStorage.GetRemotePublicKeySets(ctx, issuer, subject)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// A key set which can not be fetched must not prevent the other key sets
+	// trusted for the issuer from verifying the token, so the first error is
+	// only returned if none of them does.
+	var firstErr error
+	var first *jose.JSONWebKey
+	var verified []RemoteJSONWebKeySet
+	for i := range sets {
+		for _, ignoreCache := range []bool{false, true} {
+			keys, err := c.Config.GetJWKSFetcherStrategy(ctx).Resolve(ctx, sets[i].URI, ignoreCache)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				break
+			}
+			if key := findVerifyingKey(token, keys, keyID); key != nil {
+				if first == nil {
+					first = key
+				}
+				verified = append(verified, sets[i])
+				break
+			}
 		}
 	}
+	if first != nil {
+		return first, verified, nil
+	}
+	return nil, nil, firstErr
+}
+
+// findVerifyingKey returns the key of the set which verifies the token. If
+// keyID is set, only keys with that ID are considered.
+func findVerifyingKey(token *jwt.JSONWebToken, keys *jose.JSONWebKeySet, keyID string) *jose.JSONWebKey {
+	candidates := keys.Keys
+	if keyID != "" {
+		candidates = keys.Key(keyID)
+	}
 
-	return nil, errorsx.WithStack(keyNotFoundErr)
+	claims := jwt.Claims{}
+	for _, key := range candidates {
+		if err := token.Claims(key, &claims); err == nil {
+			return &key
+		}
+	}
+	return nil
 }
 
 func (c *Handler) validateTokenClaims(ctx context.Context, claims jwt.Claims, key *jose.JSONWebKey) error {
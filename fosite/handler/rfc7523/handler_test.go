@@ -728,6 +728,375 @@ func (s *AuthorizeJWTGrantRequestHandlerTestSuite) TestValidAssertion() {
 	s.NoError(err, "no error expected, because assertion must be valid")
 }
 
+type remoteKeyStorage struct {
+	*internal.MockRFC7523KeyStorage
+	sets []rfc7523.RemoteJSONWebKeySet
+}
+
+func (s remoteKeyStorage) GetRemotePublicKeySets(context.Context, string, string) ([]rfc7523.RemoteJSONWebKeySet, error) {
+	return s.sets, nil
+}
+
+type jwksFetcherFunc func(ctx context.Context, location string, ignoreCache bool) (*jose.JSONWebKeySet, error)
+
+func (f jwksFetcherFunc) Resolve(ctx context.Context, location string, ignoreCache bool) (*jose.JSONWebKeySet, error) {
+	return f(ctx, location, ignoreCache)
+}
+
+func (s *AuthorizeJWTGrantRequestHandlerTestSuite) TestValidAssertionWithRemoteJSONWebKeySet() {
+	// arrange
+	ctx := context.Background()
+	s.accessRequest.GrantTypes = []string{grantTypeJWTBearer}
+	keyID := "rotated_key"
+	pubKey := s.createJWK(s.privateKey.Public(), keyID)
+	cl := s.createStandardClaim()
+
+	s.accessRequest.Form.Add("assertion", s.createTestAssertion(cl, keyID))
+	s.accessRequest.RequestedScope = []string{"valid_scope"}
+	storage := remoteKeyStorage{
+		MockRFC7523KeyStorage: s.mockStore,
+		sets:                  []rfc7523.RemoteJSONWebKeySet{{URI: "https://trusted-issuer.example.com/jwks.json", Scopes: []string{"valid_scope"}}},
+	}
+	s.mockStoreProvider.EXPECT().RFC7523KeyStorage().Return(storage).Times(3)
+	s.mockStore.EXPECT().GetPublicKey(ctx, cl.Issuer, cl.Subject, keyID).Return(nil, fosite.ErrNotFound)
+	s.mockStore.EXPECT().IsJWTUsed(ctx, cl.ID).Return(false, nil)
+	s.mockStore.EXPECT().MarkJWTUsedForTime(ctx, cl.ID, cl.Expiry.Time()).Return(nil)
+
+	var fetched []bool
+	s.handler.Config.(*fosite.Config).JWKSFetcherStrategy = jwksFetcherFunc(func(_ context.Context, location string, ignoreCache bool) (*jose.JSONWebKeySet, error) {
+		s.Equal("https://trusted-issuer.example.com/jwks.json", location)
+		fetched = append(fetched, ignoreCache)
+		if !ignoreCache {
+			// the cached key set does not contain the rotated key yet
+			return s.createJWS(s.createRandomTestJWK()), nil
+		}
+		return s.createJWS(pubKey), nil
+	})
+
+	// act
+	err := s.handler.HandleTokenEndpointRequest(ctx, s.accessRequest)
+
+	// assert
+	s.NoError(err, "no error expected, because assertion is signed by a key of the remote key set")
+	s.Equal([]bool{false, true}, fetched, "expected the key set to be fetched again, because the cached one does not contain the key")
+}
+
+func (s *AuthorizeJWTGrantRequestHandlerTestSuite) TestValidAssertionWithUnreachableRemoteJSONWebKeySet() {
+	// arrange
+	ctx := context.Background()
+	s.accessRequest.GrantTypes = []string{grantTypeJWTBearer}
+	keyID := "remote_key"
+	pubKey := s.createJWK(s.privateKey.Public(), keyID)
+	cl := s.createStandardClaim()
+
+	s.accessRequest.Form.Add("assertion", s.createTestAssertion(cl, keyID))
+	s.accessRequest.RequestedScope = []string{"valid_scope"}
+	storage := remoteKeyStorage{
+		MockRFC7523KeyStorage: s.mockStore,
+		sets: []rfc7523.RemoteJSONWebKeySet{
+			{URI: "https://unreachable-issuer.example.com/jwks.json", Scopes: []string{"valid_scope"}},
+			{URI: "https://trusted-issuer.example.com/jwks.json", Scopes: []string{"valid_scope"}},
+		},
+	}
+	s.mockStoreProvider.EXPECT().RFC7523KeyStorage().Return(storage).Times(3)
+	s.mockStore.EXPECT().GetPublicKey(ctx, cl.Issuer, cl.Subject, keyID).Return(nil, fosite.ErrNotFound)
+	s.mockStore.EXPECT().IsJWTUsed(ctx, cl.ID).Return(false, nil)
+	s.mockStore.EXPECT().MarkJWTUsedForTime(ctx, cl.ID, cl.Expiry.Time()).Return(nil)
+
+	var fetched []string
+	s.handler.Config.(*fosite.Config).JWKSFetcherStrategy = jwksFetcherFunc(func(_ context.Context, location string, _ bool) (*jose.JSONWebKeySet, error) {
+		fetched = append(fetched, location)
+		if location == "https://unreachable-issuer.example.com/jwks.json" {
+			return nil, errors.New("connection refused")
+		}
+		return s.createJWS(pubKey), nil
+	})
+
+	// act
+	err := s.handler.HandleTokenEndpointRequest(ctx, s.accessRequest)
+
+	// assert
+	s.NoError(err, "no error expected, because the second remote key set verifies the assertion")
+	s.Equal([]string{"https://unreachable-issuer.example.com/jwks.json", "https://trusted-issuer.example.com/jwks.json"}, fetched)
+}
+
+func (s *AuthorizeJWTGrantRequestHandlerTestSuite) TestAssertionWithUnreachableRemoteJSONWebKeySet() {
+	// arrange
+	ctx := context.Background()
+	s.accessRequest.GrantTypes = []string{grantTypeJWTBearer}
+	keyID := "remote_key"
+	cl := s.createStandardClaim()
+
+	s.accessRequest.Form.Add("assertion", s.createTestAssertion(cl, keyID))
+	storage := remoteKeyStorage{
+		MockRFC7523KeyStorage: s.mockStore,
+		sets:                  []rfc7523.RemoteJSONWebKeySet{{URI: "https://unreachable-issuer.example.com/jwks.json"}},
+	}
+	s.mockStoreProvider.EXPECT().RFC7523KeyStorage().Return(storage).Times(1)
+	s.mockStore.EXPECT().GetPublicKey(ctx, cl.Issuer, cl.Subject, keyID).Return(nil, fosite.ErrNotFound)
+	s.handler.Config.(*fosite.Config).JWKSFetcherStrategy = jwksFetcherFunc(func(context.Context, string, bool) (*jose.JSONWebKeySet, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	// act
+	err := s.handler.HandleTokenEndpointRequest(ctx, s.accessRequest)
+
+	// assert
+	s.True(errors.Is(err, fosite.ErrInvalidGrant))
+	s.Contains(fosite.ErrorToRFC6749Error(err).DebugField, "connection refused")
+}
+
+func (s *AuthorizeJWTGrantRequestHandlerTestSuite) TestAssertionWithRemoteJSONWebKeySetNotAllowedToRequestScope() {
+	// arrange
+	ctx := context.Background()
+	s.accessRequest.GrantTypes = []string{grantTypeJWTBearer}
+	keyID := "remote_key"
+	pubKey := s.createJWK(s.privateKey.Public(), keyID)
+	cl := s.createStandardClaim()
+
+	s.accessRequest.Form.Add("assertion", s.createTestAssertion(cl, keyID))
+	s.accessRequest.RequestedScope = []string{"some_scope"}
+	storage := remoteKeyStorage{
+		MockRFC7523KeyStorage: s.mockStore,
+		sets:                  []rfc7523.RemoteJSONWebKeySet{{URI: "https://trusted-issuer.example.com/jwks.json", Scopes: []string{"valid_scope"}}},
+	}
+	s.mockStoreProvider.EXPECT().RFC7523KeyStorage().Return(storage).Times(2)
+	s.mockStore.EXPECT().GetPublicKey(ctx, cl.Issuer, cl.Subject, keyID).Return(nil, fosite.ErrNotFound)
+	s.mockStore.EXPECT().IsJWTUsed(ctx, cl.ID).Return(false, nil)
+	s.handler.Config.(*fosite.Config).JWKSFetcherStrategy = jwksFetcherFunc(func(context.Context, string, bool) (*jose.JSONWebKeySet, error) {
+		return s.createJWS(pubKey), nil
+	})
+
+	// act
+	err := s.handler.HandleTokenEndpointRequest(ctx, s.accessRequest)
+
+	// assert
+	s.True(errors.Is(err, fosite.ErrInvalidScope))
+}
+
+func (s *AuthorizeJWTGrantRequestHandlerTestSuite) TestAssertionNotSatisfyingClaimCondition() {
+	// arrange
+	ctx := context.Background()
+	s.accessRequest.GrantTypes = []string{grantTypeJWTBearer}
+	keyID := "remote_key"
+	pubKey := s.createJWK(s.privateKey.Public(), keyID)
+	cl := s.createStandardClaim()
+
+	s.accessRequest.Form.Add("assertion", s.createTestAssertionWithClaims(cl, map[string]any{"repository": "org/other", "environment": "production"}, keyID))
+	storage := remoteKeyStorage{
+		MockRFC7523KeyStorage: s.mockStore,
+		sets: []rfc7523.RemoteJSONWebKeySet{{
+			URI:    "https://trusted-issuer.example.com/jwks.json",
+			Scopes: []string{"valid_scope"},
+			Rule: rfc7523.AssertionRule{ClaimConditions: map[string][]string{
+				"repository":  {"org/app"},
+				"environment": {"staging", "production"},
+			}},
+		}},
+	}
+	s.mockStoreProvider.EXPECT().RFC7523KeyStorage().Return(storage).Times(2)
+	s.mockStore.EXPECT().GetPublicKey(ctx, cl.Issuer, cl.Subject, keyID).Return(nil, fosite.ErrNotFound)
+	s.mockStore.EXPECT().IsJWTUsed(ctx, cl.ID).Return(false, nil)
+	s.handler.Config.(*fosite.Config).JWKSFetcherStrategy = jwksFetcherFunc(func(context.Context, string, bool) (*jose.JSONWebKeySet, error) {
+		return s.createJWS(pubKey), nil
+	})
+
+	// act
+	err := s.handler.HandleTokenEndpointRequest(ctx, s.accessRequest)
+
+	// assert
+	s.True(errors.Is(err, fosite.ErrInvalidGrant))
+	s.Equal(
+		"The JWT in \"assertion\" request parameter does not satisfy the condition on claim \"repository\" of the trust relationship.",
+		fosite.ErrorToRFC6749Error(err).HintField,
+	)
+}
+
+func (s *AuthorizeJWTGrantRequestHandlerTestSuite) TestAssertionSelectsRemoteJSONWebKeySetWithMatchingConditions() {
+	// arrange
+	ctx := context.Background()
+	s.accessRequest.GrantTypes = []string{grantTypeJWTBearer}
+	keyID := "remote_key"
+	pubKey := s.createJWK(s.privateKey.Public(), keyID)
+	cl := s.createStandardClaim()
+
+	s.accessRequest.Form.Add("assertion", s.createTestAssertionWithClaims(cl, map[string]any{"repository": "org/app"}, keyID))
+	s.accessRequest.RequestedScope = []string{"deploy_scope"}
+	storage := remoteKeyStorage{
+		MockRFC7523KeyStorage: s.mockStore,
+		sets: []rfc7523.RemoteJSONWebKeySet{
+			{
+				URI:    "https://trusted-issuer.example.com/jwks.json",
+				Scopes: []string{"deploy_scope"},
+				Rule:   rfc7523.AssertionRule{ClaimConditions: map[string][]string{"repository": {"org/other"}}},
+			},
+			{
+				URI:    "https://trusted-issuer.example.com/jwks.json",
+				Scopes: []string{"read_scope"},
+				Rule:   rfc7523.AssertionRule{ClaimConditions: map[string][]string{"repository": {"org/app"}}},
+			},
+			{
+				URI:    "https://trusted-issuer.example.com/jwks.json",
+				Scopes: []string{"deploy_scope"},
+				Rule:   rfc7523.AssertionRule{ClaimConditions: map[string][]string{"repository": {"org/app"}}},
+			},
+		},
+	}
+	s.mockStoreProvider.EXPECT().RFC7523KeyStorage().Return(storage).Times(3)
+	s.mockStore.EXPECT().GetPublicKey(ctx, cl.Issuer, cl.Subject, keyID).Return(nil, fosite.ErrNotFound)
+	s.mockStore.EXPECT().IsJWTUsed(ctx, cl.ID).Return(false, nil)
+	s.mockStore.EXPECT().MarkJWTUsedForTime(ctx, cl.ID, cl.Expiry.Time()).Return(nil)
+	s.handler.Config.(*fosite.Config).JWKSFetcherStrategy = jwksFetcherFunc(func(context.Context, string, bool) (*jose.JSONWebKeySet, error) {
+		return s.createJWS(pubKey), nil
+	})
+
+	// act
+	err := s.handler.HandleTokenEndpointRequest(ctx, s.accessRequest)
+
+	// assert
+	s.NoError(err, "no error expected, because the conditions and scopes of the third trust relationship match the assertion")
+	s.Equal(fosite.Arguments{"deploy_scope"}, s.accessRequest.GetGrantedScopes())
+}
+
+func (s *AuthorizeJWTGrantRequestHandlerTestSuite) TestAssertionNotMatchingAnyRemoteJSONWebKeySet() {
+	// arrange
+	ctx := context.Background()
+	s.accessRequest.GrantTypes = []string{grantTypeJWTBearer}
+	keyID := "remote_key"
+	pubKey := s.createJWK(s.privateKey.Public(), keyID)
+	cl := s.createStandardClaim()
+
+	s.accessRequest.Form.Add("assertion", s.createTestAssertionWithClaims(cl, map[string]any{"repository": "org/app"}, keyID))
+	s.accessRequest.RequestedScope = []string{"deploy_scope"}
+	storage := remoteKeyStorage{
+		MockRFC7523KeyStorage: s.mockStore,
+		sets: []rfc7523.RemoteJSONWebKeySet{
+			{
+				URI:    "https://trusted-issuer.example.com/jwks.json",
+				Scopes: []string{"deploy_scope"},
+				Rule:   rfc7523.AssertionRule{ClaimConditions: map[string][]string{"repository": {"org/other"}}},
+			},
+			{
+				URI:    "https://trusted-issuer.example.com/jwks.json",
+				Scopes: []string{"read_scope"},
+				Rule:   rfc7523.AssertionRule{ClaimConditions: map[string][]string{"repository": {"org/app"}}},
+			},
+		},
+	}
+	s.mockStoreProvider.EXPECT().RFC7523KeyStorage().Return(storage).Times(2)
+	s.mockStore.EXPECT().GetPublicKey(ctx, cl.Issuer, cl.Subject, keyID).Return(nil, fosite.ErrNotFound)
+	s.mockStore.EXPECT().IsJWTUsed(ctx, cl.ID).Return(false, nil)
+	s.handler.Config.(*fosite.Config).JWKSFetcherStrategy = jwksFetcherFunc(func(context.Context, string, bool) (*jose.JSONWebKeySet, error) {
+		return s.createJWS(pubKey), nil
+	})
+
+	// act
+	err := s.handler.HandleTokenEndpointRequest(ctx, s.accessRequest)
+
+	// assert
+	s.True(errors.Is(err, fosite.ErrInvalidGrant), "expected the error of the first trust relationship")
+}
+
+type assertionRuleStorage struct {
+	*internal.MockRFC7523KeyStorage
+	rule *rfc7523.AssertionRule
+}
+
+func (s assertionRuleStorage) GetPublicKeyAssertionRule(context.Context, string, string, string) (*rfc7523.AssertionRule, error) {
+	return s.rule, nil
+}
+
+type assertionClaimsMapperFunc func(ctx context.Context, mapper string, claims map[string]any) (*rfc7523.AssertionClaimsMapping, error)
+
+func (f assertionClaimsMapperFunc) MapAssertionClaims(ctx context.Context, mapper string, claims map[string]any) (*rfc7523.AssertionClaimsMapping, error) {
+	return f(ctx, mapper, claims)
+}
+
+type claimsMapperConfig struct {
+	*fosite.Config
+	mapper rfc7523.AssertionClaimsMapper
+}
+
+func (c claimsMapperConfig) GetAssertionClaimsMapper(context.Context) rfc7523.AssertionClaimsMapper {
+	return c.mapper
+}
+
+func (s *AuthorizeJWTGrantRequestHandlerTestSuite) TestValidAssertionWithClaimsMapper() {
+	// arrange
+	ctx := context.Background()
+	s.accessRequest.GrantTypes = []string{grantTypeJWTBearer}
+	keyID := "my_key"
+	pubKey := s.createJWK(s.privateKey.Public(), keyID)
+	cl := s.createStandardClaim()
+
+	s.accessRequest.Form.Add("assertion", s.createTestAssertionWithClaims(cl, map[string]any{"namespace": "payments", "groups": []string{"dev", "ops"}}, keyID))
+	storage := assertionRuleStorage{
+		MockRFC7523KeyStorage: s.mockStore,
+		rule: &rfc7523.AssertionRule{
+			ClaimConditions: map[string][]string{"namespace": {"payments", "billing"}, "groups": {"ops"}},
+			ClaimsMapper:    "some-mapper",
+		},
+	}
+	s.mockStoreProvider.EXPECT().RFC7523KeyStorage().Return(storage).Times(4)
+	s.mockStore.EXPECT().GetPublicKey(ctx, cl.Issuer, cl.Subject, keyID).Return(&pubKey, nil)
+	s.mockStore.EXPECT().GetPublicKeyScopes(ctx, cl.Issuer, cl.Subject, keyID).Return([]string{"read", "write"}, nil)
+	s.mockStore.EXPECT().IsJWTUsed(ctx, cl.ID).Return(false, nil)
+	s.mockStore.EXPECT().MarkJWTUsedForTime(ctx, cl.ID, cl.Expiry.Time()).Return(nil)
+	s.handler.Config = claimsMapperConfig{
+		Config: s.handler.Config.(*fosite.Config),
+		mapper: assertionClaimsMapperFunc(func(_ context.Context, mapper string, claims map[string]any) (*rfc7523.AssertionClaimsMapping, error) {
+			s.Equal("some-mapper", mapper)
+			return &rfc7523.AssertionClaimsMapping{
+				Subject: "workload:" + claims["namespace"].(string),
+				Scope:   []string{"read", "admin"},
+				Ext:     map[string]any{"namespace": claims["namespace"]},
+			}, nil
+		}),
+	}
+
+	// act
+	err := s.handler.HandleTokenEndpointRequest(ctx, s.accessRequest)
+
+	// assert
+	s.Require().NoError(err, "no error expected, because assertion satisfies the conditions")
+	session := s.accessRequest.GetSession().(*fosite.DefaultSession)
+	s.Equal("workload:payments", session.Subject)
+	s.Equal(map[string]any{"namespace": "payments"}, session.Extra)
+	s.Equal(fosite.Arguments{"read"}, s.accessRequest.GetGrantedScopes(), "expected only the mapped scopes allowed by the trust relationship to be granted")
+}
+
+func (s *AuthorizeJWTGrantRequestHandlerTestSuite) TestAssertionWithClaimsMapperNotAllowedToRequestScope() {
+	// arrange
+	ctx := context.Background()
+	s.accessRequest.GrantTypes = []string{grantTypeJWTBearer}
+	keyID := "my_key"
+	pubKey := s.createJWK(s.privateKey.Public(), keyID)
+	cl := s.createStandardClaim()
+
+	s.accessRequest.Form.Add("assertion", s.createTestAssertion(cl, keyID))
+	s.accessRequest.RequestedScope = []string{"write"}
+	storage := assertionRuleStorage{
+		MockRFC7523KeyStorage: s.mockStore,
+		rule:                  &rfc7523.AssertionRule{ClaimsMapper: "some-mapper"},
+	}
+	s.mockStoreProvider.EXPECT().RFC7523KeyStorage().Return(storage).Times(3)
+	s.mockStore.EXPECT().GetPublicKey(ctx, cl.Issuer, cl.Subject, keyID).Return(&pubKey, nil)
+	s.mockStore.EXPECT().GetPublicKeyScopes(ctx, cl.Issuer, cl.Subject, keyID).Return([]string{"read", "write"}, nil)
+	s.mockStore.EXPECT().IsJWTUsed(ctx, cl.ID).Return(false, nil)
+	s.handler.Config = claimsMapperConfig{
+		Config: s.handler.Config.(*fosite.Config),
+		mapper: assertionClaimsMapperFunc(func(context.Context, string, map[string]any) (*rfc7523.AssertionClaimsMapping, error) {
+			return &rfc7523.AssertionClaimsMapping{Scope: []string{"read"}}, nil
+		}),
+	}
+
+	// act
+	err := s.handler.HandleTokenEndpointRequest(ctx, s.accessRequest)
+
+	// assert
+	s.True(errors.Is(err, fosite.ErrInvalidScope))
+}
+
 func (s *AuthorizeJWTGrantRequestHandlerTestSuite) TestValidAssertionCopiesAudienceWhenOmitDisabled() {
 	// arrange
 	ctx := context.Background()
@@ -874,13 +1243,21 @@ func (s *AuthorizeJWTGrantRequestHandlerTestSuite) TestRequestIsValidWhenClientA
 }
 
 func (s *AuthorizeJWTGrantRequestHandlerTestSuite) createTestAssertion(cl jwt.Claims, keyID string) string {
+	return s.createTestAssertionWithClaims(cl, nil, keyID)
+}
+
+func (s *AuthorizeJWTGrantRequestHandlerTestSuite) createTestAssertionWithClaims(cl jwt.Claims, extra map[string]any, keyID string) string {
 	jwk := jose.JSONWebKey{Key: s.privateKey, KeyID: keyID, Algorithm: string(jose.RS256)}
 	sig, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: jwk}, (&jose.SignerOptions{}).WithType("JWT"))
 	if err != nil {
 		s.FailNowf("failed to create test assertion", "failed to create signer: %s", err.Error())
 	}
 
-	raw, err := jwt.Signed(sig).Claims(cl).CompactSerialize()
+	builder := jwt.Signed(sig).Claims(cl)
+	if extra != nil {
+		builder = builder.Claims(extra)
+	}
+	raw, err := builder.CompactSerialize()
 	if err != nil {
 		s.FailNowf("failed to create test assertion", "failed to sign assertion: %s", err.Error())
 	}
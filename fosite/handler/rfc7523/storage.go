@@ -32,6 +32,38 @@ type RFC7523KeyStorage interface {
 	MarkJWTUsedForTime(ctx context.Context, jti string, exp time.Time) error
 }
 
+// RemoteJSONWebKeySet is a trust relationship whose public keys are resolved
+// from the JSON Web Key Set published by the issuer.
+type RemoteJSONWebKeySet struct {
+	// URI is the location of the JSON Web Key Set.
+	URI string
+
+	// Scopes are the scopes assertions signed by one of the keys may request.
+	Scopes []string
+
+	// Rule restricts and maps the claims of assertions signed by one of the keys.
+	Rule AssertionRule
+}
+
+// RFC7523RemoteKeyStorage is implemented by storages which support trust
+// relationships backed by a remote JSON Web Key Set. Its keys are resolved
+// using the configured fosite.JWKSFetcherStrategy.
+type RFC7523RemoteKeyStorage interface {
+	// GetRemotePublicKeySets returns the remote JSON Web Key Sets trusted for
+	// assertions issued by 'issuer' for subject, the preferred ones first. The
+	// first set verifying an assertion whose conditions and scopes match is
+	// used.
+	GetRemotePublicKeySets(ctx context.Context, issuer string, subject string) ([]RemoteJSONWebKeySet, error)
+}
+
+// RFC7523AssertionRuleStorage is implemented by storages which support
+// conditions on, and mapping of, the claims of assertions.
+type RFC7523AssertionRuleStorage interface {
+	// GetPublicKeyAssertionRule returns the rule of the trust relationship of the public key, issued by 'issuer',
+	// and assigned for subject.
+	GetPublicKeyAssertionRule(ctx context.Context, issuer string, subject string, keyId string) (*AssertionRule, error)
+}
+
 type RFC7523KeyStorageProvider interface {
 	RFC7523KeyStorage() RFC7523KeyStorage
 }
@@ -5,9 +5,11 @@ package i18n
 
 import (
 	"net/http"
+	"strings"
 
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
 )
 
 // DefaultMessage is a single message in the locale bundle
@@ -29,16 +31,21 @@ type DefaultLocaleBundle struct {
 type defaultMessageCatalog struct {
 	Bundles []*DefaultLocaleBundle
 
+	builder *catalog.Builder
 	matcher language.Matcher
 }
 
+// NewDefaultMessageCatalog returns a catalog of the given locale bundles. Each
+// catalog keeps its own messages, so catalogs with different bundles can be
+// used side by side. Bundles with an invalid language tag are skipped.
 func NewDefaultMessageCatalog(bundles []*DefaultLocaleBundle) MessageCatalog {
 	c := &defaultMessageCatalog{
 		Bundles: bundles,
+		builder: catalog.NewBuilder(catalog.Fallback(language.English)),
 	}
 
 	for _, v := range c.Bundles {
-		if err := v.Init(); err != nil {
+		if err := v.init(c.builder); err != nil {
 			continue
 		}
 	}
@@ -61,9 +68,24 @@ func (l *DefaultLocaleBundle) Init() error {
 	return nil
 }
 
+func (l *DefaultLocaleBundle) init(b *catalog.Builder) error {
+	tag, err := language.Parse(l.LangTag)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range l.Messages {
+		if err := b.SetString(tag, m.ID, m.FormattedMessage); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (c *defaultMessageCatalog) GetMessage(ID string, tag language.Tag, v ...interface{}) string {
 	matchedTag, _, _ := c.matcher.Match(tag)
-	p := message.NewPrinter(matchedTag)
+	p := message.NewPrinter(matchedTag, message.Catalog(c.builder))
 
 	result := p.Sprintf(ID, v...)
 	if result == ID && tag != language.English {
@@ -73,24 +95,36 @@ func (c *defaultMessageCatalog) GetMessage(ID string, tag language.Tag, v ...int
 	return result
 }
 
+// GetLangFromRequest matches the languages requested using the `ui_locales`
+// parameter, the `lang` cookie and the Accept-Language header, in that order,
+// against the languages of the catalog.
 func (c *defaultMessageCatalog) GetLangFromRequest(r *http.Request) language.Tag {
-	lang, _ := r.Cookie("lang")
-	accept := r.Header.Get("Accept-Language")
-	tag, _ := language.MatchStrings(c.matcher, lang.String(), accept)
+	form := r.Form
+	if form == nil {
+		form = r.URL.Query()
+	}
+
+	var preferred []string
+	if uiLocales := strings.Fields(form.Get("ui_locales")); len(uiLocales) > 0 {
+		preferred = append(preferred, strings.Join(uiLocales, ","))
+	}
+	if lang, err := r.Cookie("lang"); err == nil {
+		preferred = append(preferred, lang.Value)
+	}
+	preferred = append(preferred, r.Header.Get("Accept-Language"))
 
+	tag, _ := language.MatchStrings(c.matcher, preferred...)
 	return tag
 }
 
 func (c *defaultMessageCatalog) makeMatcher() {
+	// The first language is the default of the matcher.
 	result := []language.Tag{language.English}
-	defLangs := message.DefaultCatalog.Languages()
-	// remove "en" if was already in the list of languages
-	for i, t := range defLangs {
-		if t == language.English {
-			result = append(result, defLangs[:i]...)
-			result = append(result, defLangs[i+1:]...)
+	for _, t := range c.builder.Languages() {
+		if t != language.English {
+			result = append(result, t)
 		}
 	}
 
-	c.matcher = language.NewMatcher(defLangs)
+	c.matcher = language.NewMatcher(result)
 }
@@ -4,6 +4,9 @@
 package i18n
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -46,3 +49,51 @@ func TestSimpleTranslation(t *testing.T) {
 	msg = GetMessage(catalog, "badRequestBody", language.English, "GET")
 	assert.Equal(t, msg, "Unable to parse HTTP body, make sure to send a properly formatted form request body.")
 }
+
+func TestCatalogsAreIsolated(t *testing.T) {
+	german := NewDefaultMessageCatalog([]*DefaultLocaleBundle{
+		{LangTag: "de", Messages: []*DefaultMessage{{ID: "access_denied", FormattedMessage: "Zugriff verweigert."}}},
+	})
+	french := NewDefaultMessageCatalog([]*DefaultLocaleBundle{
+		{LangTag: "fr", Messages: []*DefaultMessage{{ID: "access_denied", FormattedMessage: "Accès refusé."}}},
+		{LangTag: "not a language tag", Messages: []*DefaultMessage{{ID: "access_denied", FormattedMessage: "ignored"}}},
+	})
+
+	assert.Equal(t, "Zugriff verweigert.", GetMessage(german, "access_denied", language.German))
+	assert.Equal(t, "access_denied", GetMessage(german, "access_denied", language.French))
+	assert.Equal(t, "Accès refusé.", GetMessage(french, "access_denied", language.French))
+	assert.Equal(t, "access_denied", GetMessage(french, "access_denied", language.German))
+}
+
+func TestGetLangFromRequest(t *testing.T) {
+	catalog := NewDefaultMessageCatalog([]*DefaultLocaleBundle{
+		{LangTag: "de", Messages: []*DefaultMessage{{ID: "access_denied", FormattedMessage: "Zugriff verweigert."}}},
+		{LangTag: "fr", Messages: []*DefaultMessage{{ID: "access_denied", FormattedMessage: "Accès refusé."}}},
+	})
+
+	for k, tc := range []struct {
+		url, cookie, accept string
+		expected            language.Tag
+	}{
+		{url: "/", expected: language.English},
+		{url: "/", accept: "fr-CH, fr;q=0.9, en;q=0.8", expected: language.French},
+		{url: "/", cookie: "de", accept: "fr", expected: language.German},
+		{url: "/?ui_locales=es+de", cookie: "fr", accept: "fr", expected: language.German},
+		{url: "/?ui_locales=es", accept: "de", expected: language.German},
+		{url: "/", accept: "es", expected: language.English},
+	} {
+		t.Run(fmt.Sprintf("case=%d", k), func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tc.url, nil)
+			if tc.cookie != "" {
+				r.AddCookie(&http.Cookie{Name: "lang", Value: tc.cookie})
+			}
+			if tc.accept != "" {
+				r.Header.Set("Accept-Language", tc.accept)
+			}
+
+			base, _ := GetLangFromRequest(catalog, r).Base()
+			expected, _ := tc.expected.Base()
+			assert.Equal(t, expected, base)
+		})
+	}
+}
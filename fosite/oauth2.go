@@ -171,6 +171,10 @@ type OAuth2Provider interface {
 	// https://tools.ietf.org/html/rfc7009#section-2.2
 	WriteRevocationResponse(ctx context.Context, rw http.ResponseWriter, err error)
 
+	// AuthenticateClient authenticates the client of the request using the client authentication methods of the
+	// token endpoint.
+	AuthenticateClient(ctx context.Context, r *http.Request, form url.Values) (Client, error)
+
 	// IntrospectToken returns token metadata, if the token is valid. Tokens generated by the authorization endpoint,
 	// such as the authorization code, can not be introspected.
 	IntrospectToken(ctx context.Context, token string, tokenUse TokenUse, session Session, scope ...string) (TokenUse, AccessRequester, error)
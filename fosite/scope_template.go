@@ -0,0 +1,254 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package fosite
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+)
+
+// ScopeParameterType is the type of a scope template parameter.
+type ScopeParameterType string
+
+const (
+	// ScopeParameterString accepts any non-empty value. It is the default type.
+	ScopeParameterString ScopeParameterType = "string"
+	// ScopeParameterInt accepts a signed integer.
+	ScopeParameterInt ScopeParameterType = "int"
+	// ScopeParameterUUID accepts a UUID.
+	ScopeParameterUUID ScopeParameterType = "uuid"
+	// ScopeParameterMax accepts a non-negative integer upper bound. A scope
+	// with a lower bound is narrower than the same scope with a higher bound.
+	ScopeParameterMax ScopeParameterType = "max"
+)
+
+const scopeTemplateSeparator = ":"
+
+type scopeTemplateSegment struct {
+	literal string
+	name    string
+	typ     ScopeParameterType
+}
+
+// ScopeTemplate is a scope with typed parameters, for example
+// `read:account:{id:uuid}` or `transfer:max:{amount:max}`. Segments are
+// separated by colons and a parameter spans a whole segment.
+type ScopeTemplate struct {
+	template string
+	segments []scopeTemplateSegment
+}
+
+// IsScopeTemplate reports whether the scope contains template parameters.
+func IsScopeTemplate(scope string) bool {
+	return strings.ContainsAny(scope, "{}")
+}
+
+// ParseScopeTemplate parses a scope template.
+func ParseScopeTemplate(template string) (*ScopeTemplate, error) {
+	t := &ScopeTemplate{template: template}
+	names := map[string]bool{}
+	for _, part := range splitScopeTemplate(template) {
+		if !strings.HasPrefix(part, "{") || !strings.HasSuffix(part, "}") {
+			if IsScopeTemplate(part) {
+				return nil, errors.Errorf("scope template %q: parameters must span a whole segment", template)
+			}
+			t.segments = append(t.segments, scopeTemplateSegment{literal: part})
+			continue
+		}
+
+		name, typ, _ := strings.Cut(part[1:len(part)-1], scopeTemplateSeparator)
+		if typ == "" {
+			typ = string(ScopeParameterString)
+		}
+		switch ScopeParameterType(typ) {
+		case ScopeParameterString, ScopeParameterInt, ScopeParameterUUID, ScopeParameterMax:
+		default:
+			return nil, errors.Errorf("scope template %q: parameter %q has unknown type %q", template, name, typ)
+		}
+		if name == "" || IsScopeTemplate(name) {
+			return nil, errors.Errorf("scope template %q: parameter %q has an invalid name", template, name)
+		}
+		if names[name] {
+			return nil, errors.Errorf("scope template %q: parameter %q is defined more than once", template, name)
+		}
+		names[name] = true
+		t.segments = append(t.segments, scopeTemplateSegment{name: name, typ: ScopeParameterType(typ)})
+	}
+
+	if len(names) == 0 {
+		return nil, errors.Errorf("scope template %q does not define any parameters", template)
+	}
+	return t, nil
+}
+
+// splitScopeTemplate splits the template into segments, keeping the type
+// separator of parameters intact.
+func splitScopeTemplate(template string) []string {
+	var parts []string
+	var depth, start int
+	for k, c := range template {
+		switch {
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+		case depth == 0 && strings.HasPrefix(template[k:], scopeTemplateSeparator):
+			parts = append(parts, template[start:k])
+			start = k + len(scopeTemplateSeparator)
+		}
+	}
+	return append(parts, template[start:])
+}
+
+// ParseScopeTemplates returns the valid scope templates among the given scopes.
+func ParseScopeTemplates(scopes []string) []*ScopeTemplate {
+	var templates []*ScopeTemplate
+	for _, scope := range scopes {
+		if !IsScopeTemplate(scope) {
+			continue
+		}
+		if t, err := ParseScopeTemplate(scope); err == nil {
+			templates = append(templates, t)
+		}
+	}
+	return templates
+}
+
+func (t *ScopeTemplate) String() string {
+	return t.template
+}
+
+// Parse returns the parameters of the scope if it is an instance of the
+// template.
+func (t *ScopeTemplate) Parse(scope string) (map[string]string, bool) {
+	if IsScopeTemplate(scope) {
+		return nil, false
+	}
+
+	parts := strings.Split(scope, scopeTemplateSeparator)
+	if len(parts) != len(t.segments) {
+		return nil, false
+	}
+
+	parameters := make(map[string]string, len(t.segments))
+	for k, segment := range t.segments {
+		if segment.name == "" {
+			if parts[k] != segment.literal {
+				return nil, false
+			}
+			continue
+		}
+		if !segment.typ.valid(parts[k]) {
+			return nil, false
+		}
+		parameters[segment.name] = parts[k]
+	}
+	return parameters, true
+}
+
+// Narrows reports whether the requested scope is an instance of the template
+// that grants no more than the granted scope. All parameters must be equal,
+// except for `max` parameters which may be lowered.
+func (t *ScopeTemplate) Narrows(granted, requested string) bool {
+	if granted == t.template {
+		_, ok := t.Parse(requested)
+		return ok
+	}
+
+	have, ok := t.Parse(granted)
+	if !ok {
+		return false
+	}
+	want, ok := t.Parse(requested)
+	if !ok {
+		return false
+	}
+
+	for _, segment := range t.segments {
+		if segment.name == "" {
+			continue
+		}
+		if segment.typ == ScopeParameterMax {
+			h, _ := strconv.ParseUint(have[segment.name], 10, 64)
+			w, _ := strconv.ParseUint(want[segment.name], 10, 64)
+			if w > h {
+				return false
+			}
+		} else if have[segment.name] != want[segment.name] {
+			return false
+		}
+	}
+	return true
+}
+
+func (typ ScopeParameterType) valid(value string) bool {
+	switch typ {
+	case ScopeParameterInt:
+		_, err := strconv.ParseInt(value, 10, 64)
+		return err == nil
+	case ScopeParameterUUID:
+		_, err := uuid.FromString(value)
+		return err == nil
+	case ScopeParameterMax:
+		_, err := strconv.ParseUint(value, 10, 64)
+		return err == nil
+	default:
+		return value != ""
+	}
+}
+
+// NewParameterizedScopeStrategy returns a scope strategy which matches scopes
+// exactly, against scope templates in the haystack, and against narrower
+// instances of the given templates. A client which is allowed the scope
+// `transfer:max:500` may thus request `transfer:max:100` if the template
+// `transfer:max:{amount:max}` is known.
+func NewParameterizedScopeStrategy(templates ...*ScopeTemplate) ScopeStrategy {
+	return func(haystack []string, needle string) bool {
+		if IsScopeTemplate(needle) {
+			return false
+		}
+
+		for _, this := range haystack {
+			if this == needle {
+				return true
+			}
+
+			if IsScopeTemplate(this) {
+				if t, err := ParseScopeTemplate(this); err == nil && t.Narrows(this, needle) {
+					return true
+				}
+				continue
+			}
+
+			for _, t := range templates {
+				if t.Narrows(this, needle) {
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+}
+
+// ScopeParameters returns the parameters of all scopes which are instances of
+// one of the templates, keyed by scope.
+func ScopeParameters(templates []*ScopeTemplate, scopes []string) map[string]map[string]string {
+	var result map[string]map[string]string
+	for _, scope := range scopes {
+		for _, t := range templates {
+			if parameters, ok := t.Parse(scope); ok {
+				if result == nil {
+					result = map[string]map[string]string{}
+				}
+				result[scope] = parameters
+				break
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,88 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package fosite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScopeTemplate(t *testing.T) {
+	for _, template := range []string{
+		"read:account:{id}",
+		"read:account:{id:uuid}",
+		"transfer:max:{amount:max}",
+		"{tenant:int}:documents:{document}",
+	} {
+		_, err := ParseScopeTemplate(template)
+		assert.NoError(t, err, template)
+	}
+
+	for _, template := range []string{
+		"read:account",
+		"read:account:{}",
+		"read:account:{id:float}",
+		"read:account:id-{id}",
+		"read:{id}:{id}",
+	} {
+		_, err := ParseScopeTemplate(template)
+		assert.Error(t, err, template)
+	}
+}
+
+func TestScopeTemplateParse(t *testing.T) {
+	template, err := ParseScopeTemplate("{tenant:int}:documents:{document}:{id:uuid}")
+	require.NoError(t, err)
+
+	parameters, ok := template.Parse("42:documents:invoice:9a4c4ce1-3a3c-4b8c-b0e2-7d5ab5d0a3a5")
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"tenant": "42", "document": "invoice", "id": "9a4c4ce1-3a3c-4b8c-b0e2-7d5ab5d0a3a5"}, parameters)
+
+	for _, scope := range []string{
+		"foo:documents:invoice:9a4c4ce1-3a3c-4b8c-b0e2-7d5ab5d0a3a5",
+		"42:documents::9a4c4ce1-3a3c-4b8c-b0e2-7d5ab5d0a3a5",
+		"42:document:invoice:9a4c4ce1-3a3c-4b8c-b0e2-7d5ab5d0a3a5",
+		"42:documents:invoice:not-a-uuid",
+		"42:documents:invoice",
+		"42:documents:{document}:9a4c4ce1-3a3c-4b8c-b0e2-7d5ab5d0a3a5",
+	} {
+		_, ok := template.Parse(scope)
+		assert.False(t, ok, scope)
+	}
+}
+
+func TestParameterizedScopeStrategy(t *testing.T) {
+	template, err := ParseScopeTemplate("transfer:max:{amount:max}")
+	require.NoError(t, err)
+	strategy := NewParameterizedScopeStrategy(template)
+
+	scopes := []string{"openid", "read:account:{id:int}", "transfer:max:500"}
+	assert.True(t, strategy(scopes, "openid"))
+	assert.False(t, strategy(scopes, "offline"))
+
+	assert.True(t, strategy(scopes, "read:account:123"))
+	assert.False(t, strategy(scopes, "read:account:abc"))
+	assert.False(t, strategy(scopes, "read:account"))
+	assert.False(t, strategy(scopes, "read:account:{id:int}"))
+
+	assert.True(t, strategy(scopes, "transfer:max:500"))
+	assert.True(t, strategy(scopes, "transfer:max:100"))
+	assert.False(t, strategy(scopes, "transfer:max:501"))
+	assert.False(t, strategy(scopes, "transfer:max:-1"))
+
+	assert.False(t, NewParameterizedScopeStrategy()(scopes, "transfer:max:100"), "narrowing requires a known template")
+}
+
+func TestScopeParameters(t *testing.T) {
+	templates := ParseScopeTemplates([]string{"openid", "read:account:{id}", "transfer:max:{amount:max}", "broken:{"})
+	require.Len(t, templates, 2)
+
+	assert.Equal(t, map[string]map[string]string{
+		"read:account:123": {"id": "123"},
+		"transfer:max:50":  {"amount": "50"},
+	}, ScopeParameters(templates, []string{"openid", "read:account:123", "transfer:max:50"}))
+	assert.Nil(t, ScopeParameters(templates, []string{"openid"}))
+}
@@ -0,0 +1,83 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package fosite
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ory/x/errorsx"
+)
+
+// ACRSatisfies reports whether the authentication context class reference
+// satisfies the required one. The ranking lists the known values from the
+// weakest to the strongest. A value satisfies the requirement if it is equal
+// to it or ranked at least as high.
+func ACRSatisfies(ranking []string, acr, required string) bool {
+	if acr == required {
+		return true
+	}
+
+	have, want := slices.Index(ranking, acr), slices.Index(ranking, required)
+	return have >= 0 && want >= 0 && have >= want
+}
+
+// AuthenticationRequirements are the authentication requirements of a
+// protected resource as defined in RFC 9470.
+type AuthenticationRequirements struct {
+	// ACRValues are the acceptable authentication context class references.
+	// If empty, any value is accepted.
+	ACRValues []string
+
+	// MaxAge is the maximum time which may have passed since the End-User
+	// last actively authenticated. If zero, the authentication time is not
+	// checked.
+	MaxAge time.Duration
+}
+
+// Validate returns ErrInsufficientUserAuthentication if the authentication
+// event described by the acr and auth_time claims of an access token does not
+// meet the requirements.
+func (r *AuthenticationRequirements) Validate(acr string, authTime time.Time) error {
+	if len(r.ACRValues) > 0 && !slices.Contains(r.ACRValues, acr) {
+		return errorsx.WithStack(ErrInsufficientUserAuthentication.WithHint("A different authentication level is required."))
+	}
+
+	if r.MaxAge > 0 {
+		if authTime.IsZero() {
+			return errorsx.WithStack(ErrInsufficientUserAuthentication.WithHint("The time of the authentication is unknown."))
+		} else if time.Since(authTime) > r.MaxAge {
+			return errorsx.WithStack(ErrInsufficientUserAuthentication.WithHint("More recent authentication is required."))
+		}
+	}
+
+	return nil
+}
+
+// Challenge returns the value of the WWW-Authenticate header which asks the
+// client to obtain a new access token meeting the requirements.
+func (r *AuthenticationRequirements) Challenge(err error) string {
+	rfcErr := ErrorToRFC6749Error(err)
+	params := []string{
+		fmt.Sprintf(`error="%s"`, rfcErr.ErrorField),
+		fmt.Sprintf(`error_description="%s"`, rfcErr.GetDescription()),
+	}
+	if len(r.ACRValues) > 0 {
+		params = append(params, fmt.Sprintf(`acr_values="%s"`, strings.Join(r.ACRValues, " ")))
+	}
+	if r.MaxAge > 0 {
+		params = append(params, fmt.Sprintf(`max_age="%d"`, int64(r.MaxAge/time.Second)))
+	}
+	return "Bearer " + strings.Join(params, ", ")
+}
+
+// WriteInsufficientUserAuthentication writes the RFC 9470 step-up challenge
+// for the error returned by Validate.
+func (r *AuthenticationRequirements) WriteInsufficientUserAuthentication(rw http.ResponseWriter, err error) {
+	rw.Header().Set("WWW-Authenticate", r.Challenge(err))
+	rw.WriteHeader(ErrorToRFC6749Error(err).CodeField)
+}
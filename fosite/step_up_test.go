@@ -0,0 +1,64 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package fosite
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACRSatisfies(t *testing.T) {
+	ranking := []string{"bronze", "silver", "gold"}
+	for k, tc := range []struct {
+		acr, required string
+		expect        bool
+	}{
+		{acr: "silver", required: "silver", expect: true},
+		{acr: "gold", required: "silver", expect: true},
+		{acr: "bronze", required: "silver", expect: false},
+		{acr: "", required: "bronze", expect: false},
+		{acr: "platinum", required: "bronze", expect: false},
+		{acr: "platinum", required: "platinum", expect: true},
+	} {
+		assert.Equal(t, tc.expect, ACRSatisfies(ranking, tc.acr, tc.required), "%d: %+v", k, tc)
+	}
+}
+
+func TestAuthenticationRequirements(t *testing.T) {
+	r := &AuthenticationRequirements{ACRValues: []string{"mfa"}, MaxAge: time.Minute}
+
+	t.Run("case=validate", func(t *testing.T) {
+		require.NoError(t, r.Validate("mfa", time.Now().Add(-time.Second)))
+		require.NoError(t, (&AuthenticationRequirements{}).Validate("", time.Time{}))
+
+		for k, tc := range []struct {
+			acr      string
+			authTime time.Time
+		}{
+			{acr: "pwd", authTime: time.Now()},
+			{acr: "mfa", authTime: time.Time{}},
+			{acr: "mfa", authTime: time.Now().Add(-time.Hour)},
+		} {
+			err := r.Validate(tc.acr, tc.authTime)
+			require.ErrorIs(t, err, ErrInsufficientUserAuthentication, "%d", k)
+		}
+	})
+
+	t.Run("case=challenge", func(t *testing.T) {
+		err := r.Validate("pwd", time.Now())
+		assert.Equal(t,
+			`Bearer error="insufficient_user_authentication", error_description="The authentication event associated with the access token presented with the request does not meet the authentication requirements of the protected resource. A different authentication level is required.", acr_values="mfa", max_age="60"`,
+			r.Challenge(err))
+
+		rw := httptest.NewRecorder()
+		r.WriteInsufficientUserAuthentication(rw, err)
+		assert.Equal(t, http.StatusUnauthorized, rw.Code)
+		assert.Equal(t, r.Challenge(err), rw.Header().Get("WWW-Authenticate"))
+	})
+}
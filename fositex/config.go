@@ -6,9 +6,11 @@ package fositex
 import (
 	"context"
 	"crypto/sha512"
+	"encoding/json"
 	"hash"
 	"html/template"
 	"net/url"
+	"sync"
 
 	"github.com/hashicorp/go-retryablehttp"
 
@@ -17,11 +19,15 @@ import (
 	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/fosite/compose"
+	"github.com/ory/hydra/v2/fosite/handler/rfc7523"
 	"github.com/ory/hydra/v2/fosite/i18n"
 	"github.com/ory/hydra/v2/fosite/token/jwt"
 	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/oauth2/trust"
 	"github.com/ory/hydra/v2/persistence"
 	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/jsonnetsecure"
+	"github.com/ory/x/logrusx"
 	"github.com/ory/x/stringslice"
 	"github.com/ory/x/urlx"
 )
@@ -31,6 +37,8 @@ type (
 		config.Provider
 		persistence.Provider
 		httpx.ClientProvider
+		jsonnetsecure.VMProvider
+		logrusx.Provider
 		ClientHasher() fosite.Hasher
 		ExtraFositeFactories() []Factory
 	}
@@ -45,6 +53,10 @@ type (
 		deviceEndpointHandlers     fosite.DeviceEndpointHandlers
 		jwksFetcherStrategy        fosite.JWKSFetcherStrategy
 
+		// catalogs caches the message catalogs by their bundles.
+		catalogs  sync.Map
+		templates *x.TemplateLoader
+
 		*config.DefaultProvider
 	}
 	ConfigProvider interface {
@@ -77,6 +89,7 @@ var (
 func NewConfig(deps configDependencies) *Config {
 	return &Config{
 		deps:            deps,
+		templates:       x.NewTemplateLoader(),
 		DefaultProvider: deps.Config(),
 	}
 }
@@ -109,13 +122,19 @@ func (c *Config) LoadDefaultHandlers(storage fosite.Storage, strategy interface{
 
 func (c *Config) GetJWKSFetcherStrategy(context.Context) fosite.JWKSFetcherStrategy {
 	if c.jwksFetcherStrategy == nil {
-		c.jwksFetcherStrategy = fosite.NewDefaultJWKSFetcherStrategy(fosite.JWKSFetcherWithHTTPClientSource(
+		c.jwksFetcherStrategy = newThrottledJWKSFetcherStrategy(observedJWKSFetcherStrategy{fosite.NewDefaultJWKSFetcherStrategy(fosite.JWKSFetcherWithHTTPClientSource(
 			func(ctx context.Context) *retryablehttp.Client { return c.deps.HTTPClient(ctx) },
-		))
+		))}, jwksRefetchInterval)
 	}
 	return c.jwksFetcherStrategy
 }
 
+// GetAssertionClaimsMapper evaluates the claims mappers of trust relationships
+// for the JWT-bearer grant.
+func (c *Config) GetAssertionClaimsMapper(context.Context) rfc7523.AssertionClaimsMapper {
+	return trust.NewClaimsMapper(c.deps)
+}
+
 func (c *Config) GetHTTPClient(ctx context.Context) *retryablehttp.Client {
 	return c.deps.HTTPClient(ctx)
 }
@@ -186,9 +205,23 @@ func (c *Config) GetSendDebugMessagesToClients(ctx context.Context) bool {
 	return c.deps.Config().GetSendDebugMessagesToClients(ctx)
 }
 
-func (c *Config) GetMessageCatalog(context.Context) i18n.MessageCatalog {
-	// Fosite falls back to the default messages when this is nil.
-	return nil
+func (c *Config) GetMessageCatalog(ctx context.Context) i18n.MessageCatalog {
+	bundles := c.deps.Config().MessageBundles(ctx)
+	if len(bundles) == 0 {
+		// Fosite falls back to the default messages when this is nil.
+		return nil
+	}
+
+	key, err := json.Marshal(bundles)
+	if err != nil {
+		return nil
+	}
+	if cached, ok := c.catalogs.Load(string(key)); ok {
+		return cached.(i18n.MessageCatalog)
+	}
+
+	catalog, _ := c.catalogs.LoadOrStore(string(key), i18n.NewDefaultMessageCatalog(bundles))
+	return catalog.(i18n.MessageCatalog)
 }
 
 func (c *Config) GetSecretsHasher(context.Context) fosite.Hasher {
@@ -223,8 +256,30 @@ func (c *Config) GetJWTScopeField(ctx context.Context) jwt.JWTScopeFieldEnum {
 	return c.deps.Config().GetJWTScopeField(ctx)
 }
 
-func (c *Config) GetFormPostHTMLTemplate(context.Context) *template.Template {
-	return fosite.DefaultFormPostTemplate
+func (c *Config) GetFormPostHTMLTemplate(ctx context.Context) *template.Template {
+	location := c.deps.Config().FormPostTemplateURL(ctx)
+	if location == "" {
+		return fosite.DefaultFormPostTemplate
+	}
+
+	t, err := c.templates.Load(ctx, c.deps.HTTPClient(ctx), "form_post", location)
+	if err != nil {
+		c.deps.Logger().WithError(err).
+			Errorf("Unable to load the template from key %s, using the built-in template instead.", config.KeyFormPostTemplateURL)
+		return fosite.DefaultFormPostTemplate
+	}
+
+	r, ok := x.TemplateRequestFromContext(ctx)
+	if !ok {
+		return t
+	}
+	localized, _, err := x.LocalizeTemplate(t, c.GetMessageCatalog(ctx), r)
+	if err != nil {
+		c.deps.Logger().WithError(err).
+			Errorf("Unable to localize the template from key %s.", config.KeyFormPostTemplateURL)
+		return t
+	}
+	return localized
 }
 
 func (c *Config) GetTokenURLs(ctx context.Context) []string {
@@ -5,6 +5,10 @@ package fositex
 
 import (
 	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -15,9 +19,11 @@ import (
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/persistence"
 	"github.com/ory/hydra/v2/spec"
+	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/configx"
 	"github.com/ory/x/contextx"
 	"github.com/ory/x/httpx"
+	"github.com/ory/x/jsonnetsecure"
 	"github.com/ory/x/logrusx"
 )
 
@@ -32,8 +38,10 @@ func (s *stubConfigDeps) Persister() persistence.Persister { return nil }
 func (s *stubConfigDeps) HTTPClient(context.Context, ...httpx.ResilientOptions) *retryablehttp.Client {
 	return nil
 }
-func (s *stubConfigDeps) ClientHasher() fosite.Hasher     { return nil }
-func (s *stubConfigDeps) ExtraFositeFactories() []Factory { return nil }
+func (s *stubConfigDeps) JsonnetVM(context.Context) (jsonnetsecure.VM, error) { return nil, nil }
+func (s *stubConfigDeps) ClientHasher() fosite.Hasher                         { return nil }
+func (s *stubConfigDeps) ExtraFositeFactories() []Factory                     { return nil }
+func (s *stubConfigDeps) Logger() *logrusx.Logger                             { return logrusx.New("", "") }
 
 func newTestConfig(t *testing.T, opts ...configx.OptionModifier) *config.DefaultProvider {
 	t.Helper()
@@ -104,3 +112,26 @@ func TestGetTokenURLs(t *testing.T) {
 	})
 
 }
+
+func TestGetFormPostHTMLTemplate(t *testing.T) {
+	t.Parallel()
+
+	c := NewConfig(&stubConfigDeps{conf: newTestConfig(t, configx.WithValues(map[string]any{
+		config.KeyFormPostTemplateURL: "base64://" + base64.StdEncoding.EncodeToString([]byte(`<p>{{ translate "Continue" }}</p>`)),
+		config.KeyMessageBundles: []map[string]any{{
+			"lang":     "de",
+			"messages": []map[string]any{{"id": "Continue", "msg": "Weiter"}},
+		}},
+	}))})
+
+	render := func(t *testing.T, ctx context.Context) string {
+		var b strings.Builder
+		require.NoError(t, c.GetFormPostHTMLTemplate(ctx).Execute(&b, nil))
+		return b.String()
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/oauth2/auth", nil)
+	r.Header.Set("Accept-Language", "de")
+	assert.Equal(t, "<p>Weiter</p>", render(t, x.WithTemplateRequest(t.Context(), r)))
+	assert.Equal(t, "<p>Continue</p>", render(t, t.Context()), "the message ID is used without a request")
+}
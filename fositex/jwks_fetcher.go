@@ -0,0 +1,89 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package fositex
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ory/hydra/v2/fosite"
+)
+
+// jwksRefetchInterval is the minimum time between two fetches of the same
+// JSON Web Key Set which bypass the cache. Such fetches are triggered by
+// tokens signed with unknown keys, so they must not reach the remote server
+// once per request.
+const jwksRefetchInterval = 30 * time.Second
+
+var jwksFetchFailures = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "hydra",
+	Subsystem: "oauth2",
+	Name:      "jwks_fetch_failures_total",
+	Help:      "Number of failed fetches of remote JSON Web Key Sets, for example of clients using private_key_jwt with a jwks_uri.",
+})
+
+// observedJWKSFetcherStrategy records failed fetches of the wrapped strategy.
+type observedJWKSFetcherStrategy struct {
+	fosite.JWKSFetcherStrategy
+}
+
+func (s observedJWKSFetcherStrategy) Resolve(ctx context.Context, location string, ignoreCache bool) (*jose.JSONWebKeySet, error) {
+	keys, err := s.JWKSFetcherStrategy.Resolve(ctx, location, ignoreCache)
+	if err != nil {
+		jwksFetchFailures.Inc()
+	}
+	return keys, err
+}
+
+// throttledJWKSFetcherStrategy lets the wrapped strategy bypass its cache at
+// most once per interval and location. Throttled fetches are served from the
+// cache instead.
+type throttledJWKSFetcherStrategy struct {
+	fosite.JWKSFetcherStrategy
+	interval time.Duration
+	now      func() time.Time
+
+	mu        sync.Mutex
+	refetched map[string]time.Time
+}
+
+func newThrottledJWKSFetcherStrategy(s fosite.JWKSFetcherStrategy, interval time.Duration) *throttledJWKSFetcherStrategy {
+	return &throttledJWKSFetcherStrategy{
+		JWKSFetcherStrategy: s,
+		interval:            interval,
+		now:                 time.Now,
+		refetched:           make(map[string]time.Time),
+	}
+}
+
+func (s *throttledJWKSFetcherStrategy) Resolve(ctx context.Context, location string, ignoreCache bool) (*jose.JSONWebKeySet, error) {
+	if ignoreCache {
+		ignoreCache = s.allowRefetch(location)
+	}
+	return s.JWKSFetcherStrategy.Resolve(ctx, location, ignoreCache)
+}
+
+func (s *throttledJWKSFetcherStrategy) allowRefetch(location string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if last, ok := s.refetched[location]; ok && now.Sub(last) < s.interval {
+		return false
+	}
+
+	// Forget locations whose interval passed to keep the map small.
+	for l, last := range s.refetched {
+		if now.Sub(last) >= s.interval {
+			delete(s.refetched, l)
+		}
+	}
+	s.refetched[location] = now
+	return true
+}
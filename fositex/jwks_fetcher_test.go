@@ -0,0 +1,48 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package fositex
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingJWKSFetcherStrategy struct {
+	fetched []bool
+}
+
+func (s *recordingJWKSFetcherStrategy) Resolve(_ context.Context, _ string, ignoreCache bool) (*jose.JSONWebKeySet, error) {
+	s.fetched = append(s.fetched, ignoreCache)
+	return &jose.JSONWebKeySet{}, nil
+}
+
+func TestThrottledJWKSFetcherStrategy(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	inner := &recordingJWKSFetcherStrategy{}
+	s := newThrottledJWKSFetcherStrategy(inner, time.Minute)
+	s.now = func() time.Time { return now }
+
+	resolve := func(location string, ignoreCache bool) {
+		_, err := s.Resolve(ctx, location, ignoreCache)
+		require.NoError(t, err)
+	}
+
+	resolve("https://a.example.com/jwks.json", true)
+	resolve("https://a.example.com/jwks.json", true)
+	resolve("https://b.example.com/jwks.json", true)
+	resolve("https://a.example.com/jwks.json", false)
+	assert.Equal(t, []bool{true, false, true, false}, inner.fetched, "only the first refetch per location must bypass the cache")
+
+	inner.fetched = nil
+	now = now.Add(time.Minute)
+	resolve("https://a.example.com/jwks.json", true)
+	assert.Equal(t, []bool{true}, inner.fetched, "the cache must be bypassed again once the interval passed")
+	assert.Len(t, s.refetched, 1, "locations whose interval passed must be forgotten")
+}
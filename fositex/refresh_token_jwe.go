@@ -0,0 +1,222 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package fositex
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+	foauth2 "github.com/ory/hydra/v2/fosite/handler/oauth2"
+)
+
+// JWERefreshTokenPrefix is the prefix of refresh tokens issued by the JWE
+// refresh token strategy. It distinguishes them from opaque refresh tokens,
+// which are prefixed with "ory_rt_".
+const JWERefreshTokenPrefix = "ory_rtj_"
+
+var _ foauth2.RefreshTokenStrategy = (*JWERefreshTokenStrategy)(nil)
+
+type (
+	// JWERefreshTokenStrategy issues self-contained refresh tokens. The grant
+	// is serialized into the token and encrypted using a key derived from the
+	// system secret, so the token can be verified without a database lookup.
+	//
+	// Because the token itself is needed to restore the grant, the token's
+	// "signature" is the token itself. Storage implementations recognize these
+	// signatures using IsJWERefreshToken and track revocations only.
+	JWERefreshTokenStrategy struct {
+		d jweRefreshTokenStrategyDependencies
+	}
+	jweRefreshTokenStrategyDependencies interface {
+		config.Provider
+	}
+
+	// JWERefreshTokenClaims is the payload of a JWE refresh token.
+	JWERefreshTokenClaims struct {
+		// ID uniquely identifies the token and is used to deny it once it was
+		// rotated.
+		ID string `json:"jti"`
+		// RequestID is the ID of the grant the token belongs to.
+		RequestID string `json:"rid"`
+		// ClientID is the ID of the client the token was issued to.
+		ClientID          string          `json:"cid"`
+		IssuedAt          int64           `json:"iat"`
+		ExpiresAt         int64           `json:"exp,omitempty"`
+		RequestedAt       time.Time       `json:"rat"`
+		RequestedScope    []string        `json:"rscp,omitempty"`
+		GrantedScope      []string        `json:"scp,omitempty"`
+		RequestedAudience []string        `json:"raud,omitempty"`
+		GrantedAudience   []string        `json:"aud,omitempty"`
+		Session           json.RawMessage `json:"sess"`
+	}
+)
+
+// NewJWERefreshTokenStrategy returns a new JWERefreshTokenStrategy.
+func NewJWERefreshTokenStrategy(d jweRefreshTokenStrategyDependencies) *JWERefreshTokenStrategy {
+	return &JWERefreshTokenStrategy{d: d}
+}
+
+// IsJWERefreshToken reports whether the token (or signature) was issued by
+// the JWE refresh token strategy.
+func IsJWERefreshToken(token string) bool {
+	return strings.HasPrefix(token, JWERefreshTokenPrefix)
+}
+
+// Expiry returns the expiry of the token or the zero time if the token does
+// not expire.
+func (c *JWERefreshTokenClaims) Expiry() time.Time {
+	if c.ExpiresAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(c.ExpiresAt, 0).UTC()
+}
+
+func (s *JWERefreshTokenStrategy) RefreshTokenSignature(_ context.Context, token string) string {
+	return token
+}
+
+func (s *JWERefreshTokenStrategy) GenerateRefreshToken(ctx context.Context, requester fosite.Requester) (token, signature string, err error) {
+	session, err := json.Marshal(requester.GetSession())
+	if err != nil {
+		return "", "", errors.WithStack(err)
+	}
+
+	// A downscoped access token does not narrow the scope of the refresh token.
+	grantedScope := requester.GetGrantedScopes()
+	if ar, ok := requester.(*fosite.AccessRequest); ok && len(ar.RefreshTokenGrantedScope) > 0 {
+		grantedScope = ar.RefreshTokenGrantedScope
+	}
+
+	now := time.Now().UTC()
+	claims := JWERefreshTokenClaims{
+		ID:                uuid.Must(uuid.NewV4()).String(),
+		RequestID:         requester.GetID(),
+		ClientID:          requester.GetClient().GetID(),
+		IssuedAt:          now.Unix(),
+		RequestedAt:       requester.GetRequestedAt().UTC(),
+		RequestedScope:    requester.GetRequestedScopes(),
+		GrantedScope:      grantedScope,
+		RequestedAudience: requester.GetRequestedAudience(),
+		GrantedAudience:   requester.GetGrantedAudience(),
+		Session:           session,
+	}
+
+	// Revocations are only remembered for the global refresh token lifespan,
+	// so JWE refresh tokens must not outlive it.
+	exp := requester.GetSession().GetExpiresAt(fosite.RefreshToken)
+	if lifespan := s.d.Config().GetRefreshTokenLifespan(ctx); lifespan > 0 {
+		if max := now.Add(lifespan); exp.IsZero() || exp.After(max) {
+			exp = max
+		}
+	}
+	if !exp.IsZero() {
+		claims.ExpiresAt = exp.Unix()
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", "", errors.WithStack(err)
+	}
+
+	key, err := s.d.Config().GetGlobalSecret(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	enc, err := jose.NewEncrypter(jose.A256GCM,
+		jose.Recipient{Algorithm: jose.DIRECT, Key: jweRefreshTokenKey(key)},
+		&jose.EncrypterOptions{Compression: jose.DEFLATE},
+	)
+	if err != nil {
+		return "", "", errors.WithStack(err)
+	}
+
+	obj, err := enc.Encrypt(payload)
+	if err != nil {
+		return "", "", errors.WithStack(err)
+	}
+
+	compact, err := obj.CompactSerialize()
+	if err != nil {
+		return "", "", errors.WithStack(err)
+	}
+
+	token = JWERefreshTokenPrefix + compact
+	return token, token, nil
+}
+
+func (s *JWERefreshTokenStrategy) ValidateRefreshToken(ctx context.Context, requester fosite.Requester, token string) error {
+	claims, err := s.Decode(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	if claims.RequestID != requester.GetID() {
+		return errors.WithStack(fosite.ErrTokenSignatureMismatch.WithDebug("The refresh token does not belong to this request."))
+	}
+
+	if exp := claims.Expiry(); !exp.IsZero() && exp.Before(time.Now().UTC()) {
+		return errors.WithStack(fosite.ErrTokenExpired.WithHintf("Refresh token expired at '%s'.", exp))
+	}
+
+	return nil
+}
+
+// Decode decrypts the token using the current or any of the rotated system
+// secrets and returns its claims. It does not check the token's expiry.
+func (s *JWERefreshTokenStrategy) Decode(ctx context.Context, token string) (*JWERefreshTokenClaims, error) {
+	if !IsJWERefreshToken(token) {
+		return nil, errors.WithStack(fosite.ErrInvalidTokenFormat)
+	}
+
+	obj, err := jose.ParseEncrypted(strings.TrimPrefix(token, JWERefreshTokenPrefix))
+	if err != nil {
+		return nil, errors.WithStack(fosite.ErrInvalidTokenFormat.WithWrap(err))
+	}
+	if obj.Header.Algorithm != string(jose.DIRECT) {
+		return nil, errors.WithStack(fosite.ErrInvalidTokenFormat.WithDebugf("Unexpected key management algorithm %q.", obj.Header.Algorithm))
+	}
+
+	global, err := s.d.Config().GetGlobalSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rotated, err := s.d.Config().GetRotatedGlobalSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range append([][]byte{global}, rotated...) {
+		payload, err := obj.Decrypt(jweRefreshTokenKey(key))
+		if err != nil {
+			continue
+		}
+
+		var claims JWERefreshTokenClaims
+		if err := json.Unmarshal(payload, &claims); err != nil {
+			return nil, errors.WithStack(fosite.ErrInvalidTokenFormat.WithWrap(err))
+		}
+		return &claims, nil
+	}
+
+	return nil, errors.WithStack(fosite.ErrTokenSignatureMismatch.WithDebug("The refresh token could not be decrypted."))
+}
+
+// jweRefreshTokenKey derives the content encryption key from a system secret,
+// so that the key is not shared with other uses of the secret.
+func jweRefreshTokenKey(secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte("hydra-jwe-refresh-token"))
+	return mac.Sum(nil)
+}
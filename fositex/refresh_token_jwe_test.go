@@ -0,0 +1,180 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package fositex
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/handler/openid"
+	"github.com/ory/x/configx"
+)
+
+func TestJWERefreshTokenStrategy(t *testing.T) {
+	ctx := t.Context()
+
+	newRequest := func(exp time.Time) *fosite.Request {
+		session := &openid.DefaultSession{Subject: "alice", ExpiresAt: map[fosite.TokenType]time.Time{}}
+		if !exp.IsZero() {
+			session.SetExpiresAt(fosite.RefreshToken, exp)
+		}
+		return &fosite.Request{
+			ID:              "request-id",
+			RequestedAt:     time.Now().UTC().Round(time.Second),
+			Client:          &client.Client{ID: "client-id"},
+			RequestedScope:  fosite.Arguments{"offline", "foo"},
+			GrantedScope:    fosite.Arguments{"offline"},
+			GrantedAudience: fosite.Arguments{"aud"},
+			Session:         session,
+		}
+	}
+
+	t.Run("case=round trip", func(t *testing.T) {
+		s := NewJWERefreshTokenStrategy(&stubConfigDeps{conf: newTestConfig(t)})
+		req := newRequest(time.Now().Add(time.Hour))
+
+		token, signature, err := s.GenerateRefreshToken(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, token, signature)
+		assert.True(t, IsJWERefreshToken(token))
+		assert.Equal(t, token, s.RefreshTokenSignature(ctx, token))
+		assert.NotContains(t, token, "alice", "the token must be encrypted")
+
+		claims, err := s.Decode(ctx, token)
+		require.NoError(t, err)
+		assert.NotEmpty(t, claims.ID)
+		assert.Equal(t, "request-id", claims.RequestID)
+		assert.Equal(t, "client-id", claims.ClientID)
+		assert.Equal(t, []string{"offline", "foo"}, claims.RequestedScope)
+		assert.Equal(t, []string{"offline"}, claims.GrantedScope)
+		assert.Equal(t, []string{"aud"}, claims.GrantedAudience)
+		assert.WithinDuration(t, req.RequestedAt, claims.RequestedAt, time.Second)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), claims.Expiry(), 2*time.Second)
+
+		var session openid.DefaultSession
+		require.NoError(t, json.Unmarshal(claims.Session, &session))
+		assert.Equal(t, "alice", session.Subject)
+
+		require.NoError(t, s.ValidateRefreshToken(ctx, req, token))
+	})
+
+	t.Run("case=downscoped access token", func(t *testing.T) {
+		s := NewJWERefreshTokenStrategy(&stubConfigDeps{conf: newTestConfig(t)})
+		req := &fosite.AccessRequest{Request: *newRequest(time.Now().Add(time.Hour))}
+		req.GrantedScope = fosite.Arguments{"foo"}
+		req.RefreshTokenGrantedScope = fosite.Arguments{"offline", "foo"}
+
+		token, _, err := s.GenerateRefreshToken(ctx, req)
+		require.NoError(t, err)
+
+		claims, err := s.Decode(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"offline", "foo"}, claims.GrantedScope, "the refresh token must keep its scope")
+	})
+
+	t.Run("case=tokens are unique", func(t *testing.T) {
+		s := NewJWERefreshTokenStrategy(&stubConfigDeps{conf: newTestConfig(t)})
+		req := newRequest(time.Now().Add(time.Hour))
+
+		a, _, err := s.GenerateRefreshToken(ctx, req)
+		require.NoError(t, err)
+		b, _, err := s.GenerateRefreshToken(ctx, req)
+		require.NoError(t, err)
+
+		ca, err := s.Decode(ctx, a)
+		require.NoError(t, err)
+		cb, err := s.Decode(ctx, b)
+		require.NoError(t, err)
+		assert.NotEqual(t, ca.ID, cb.ID)
+	})
+
+	t.Run("case=expiry is capped by the global refresh token lifespan", func(t *testing.T) {
+		s := NewJWERefreshTokenStrategy(&stubConfigDeps{conf: newTestConfig(t, configx.WithValue(config.KeyRefreshTokenLifespan, "1h"))})
+
+		for _, exp := range []time.Time{{}, time.Now().Add(24 * time.Hour)} {
+			token, _, err := s.GenerateRefreshToken(ctx, newRequest(exp))
+			require.NoError(t, err)
+			claims, err := s.Decode(ctx, token)
+			require.NoError(t, err)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), claims.Expiry(), 2*time.Second)
+		}
+	})
+
+	t.Run("case=tokens without expiry", func(t *testing.T) {
+		s := NewJWERefreshTokenStrategy(&stubConfigDeps{conf: newTestConfig(t, configx.WithValue(config.KeyRefreshTokenLifespan, "-1"))})
+
+		token, _, err := s.GenerateRefreshToken(ctx, newRequest(time.Time{}))
+		require.NoError(t, err)
+		claims, err := s.Decode(ctx, token)
+		require.NoError(t, err)
+		assert.True(t, claims.Expiry().IsZero())
+	})
+
+	t.Run("case=expired tokens are rejected", func(t *testing.T) {
+		s := NewJWERefreshTokenStrategy(&stubConfigDeps{conf: newTestConfig(t)})
+		req := newRequest(time.Now().Add(-time.Minute))
+
+		token, _, err := s.GenerateRefreshToken(ctx, req)
+		require.NoError(t, err)
+		assert.ErrorIs(t, s.ValidateRefreshToken(ctx, req, token), fosite.ErrTokenExpired)
+	})
+
+	t.Run("case=tokens of other requests are rejected", func(t *testing.T) {
+		s := NewJWERefreshTokenStrategy(&stubConfigDeps{conf: newTestConfig(t)})
+
+		token, _, err := s.GenerateRefreshToken(ctx, newRequest(time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+
+		other := newRequest(time.Now().Add(time.Hour))
+		other.ID = "other-request-id"
+		assert.ErrorIs(t, s.ValidateRefreshToken(ctx, other, token), fosite.ErrTokenSignatureMismatch)
+	})
+
+	t.Run("case=tokens survive secret rotation", func(t *testing.T) {
+		oldSecret := strings.Repeat("a", 32)
+		newSecret := strings.Repeat("b", 32)
+
+		token, _, err := NewJWERefreshTokenStrategy(&stubConfigDeps{conf: newTestConfig(t,
+			configx.WithValue(config.KeyGetSystemSecret, []string{oldSecret}),
+		)}).GenerateRefreshToken(ctx, newRequest(time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+
+		rotated := NewJWERefreshTokenStrategy(&stubConfigDeps{conf: newTestConfig(t,
+			configx.WithValue(config.KeyGetSystemSecret, []string{newSecret, oldSecret}),
+		)})
+		_, err = rotated.Decode(ctx, token)
+		require.NoError(t, err)
+
+		removed := NewJWERefreshTokenStrategy(&stubConfigDeps{conf: newTestConfig(t,
+			configx.WithValue(config.KeyGetSystemSecret, []string{newSecret}),
+		)})
+		_, err = removed.Decode(ctx, token)
+		assert.ErrorIs(t, err, fosite.ErrTokenSignatureMismatch)
+	})
+
+	t.Run("case=malformed tokens are rejected", func(t *testing.T) {
+		s := NewJWERefreshTokenStrategy(&stubConfigDeps{conf: newTestConfig(t)})
+
+		token, _, err := s.GenerateRefreshToken(ctx, newRequest(time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+
+		for _, tc := range []string{
+			"",
+			"ory_rt_foo.bar",
+			JWERefreshTokenPrefix + "foo",
+			token[:len(token)-4] + "AAAA",
+		} {
+			_, err := s.Decode(ctx, tc)
+			assert.Error(t, err, "%s", tc)
+		}
+	})
+}
@@ -16,13 +16,15 @@ import (
 var _ foauth2.CoreStrategy = (*TokenStrategy)(nil)
 
 type (
-	// TokenStrategy uses the correct token strategy (jwt, opaque) depending on the configuration.
+	// TokenStrategy uses the correct access token strategy (jwt, opaque) and
+	// refresh token strategy (jwe, opaque) depending on the configuration.
 	TokenStrategy struct {
 		d tokenStrategyDependencies
 	}
 	tokenStrategyDependencies interface {
 		OAuth2HMACStrategy() foauth2.CoreStrategy
 		OAuth2JWTStrategy() foauth2.AccessTokenStrategy
+		OAuth2JWERefreshTokenStrategy() *JWERefreshTokenStrategy
 		OAuth2AuthorizeCodeStrategy() foauth2.AuthorizeCodeStrategy
 		config.Provider
 	}
@@ -40,6 +42,24 @@ func (t TokenStrategy) gs(ctx context.Context, additionalSources ...config.Acces
 	return t.d.OAuth2HMACStrategy()
 }
 
+// rs returns the refresh token strategy configured for the requester.
+func (t TokenStrategy) rs(ctx context.Context, requester fosite.Requester) foauth2.RefreshTokenStrategy {
+	switch rts := t.d.Config().RefreshTokenStrategy(ctx, client.RefreshTokenStrategySource(requester.GetClient())); rts {
+	case config.RefreshTokenJWEStrategy:
+		return t.d.OAuth2JWERefreshTokenStrategy()
+	}
+	return t.d.OAuth2HMACStrategy()
+}
+
+// rsFor returns the refresh token strategy that issued the token. Tokens stay
+// valid when the configured strategy changes.
+func (t TokenStrategy) rsFor(token string) foauth2.RefreshTokenStrategy {
+	if IsJWERefreshToken(token) {
+		return t.d.OAuth2JWERefreshTokenStrategy()
+	}
+	return t.d.OAuth2HMACStrategy()
+}
+
 func (t TokenStrategy) AccessTokenSignature(_ context.Context, token string) string {
 	return genericSignature(token)
 }
@@ -53,15 +73,15 @@ func (t TokenStrategy) ValidateAccessToken(ctx context.Context, requester fosite
 }
 
 func (t TokenStrategy) RefreshTokenSignature(ctx context.Context, token string) string {
-	return t.d.OAuth2HMACStrategy().RefreshTokenSignature(ctx, token)
+	return t.rsFor(token).RefreshTokenSignature(ctx, token)
 }
 
 func (t TokenStrategy) GenerateRefreshToken(ctx context.Context, requester fosite.Requester) (token, signature string, err error) {
-	return t.d.OAuth2HMACStrategy().GenerateRefreshToken(ctx, requester)
+	return t.rs(ctx, requester).GenerateRefreshToken(ctx, requester)
 }
 
 func (t TokenStrategy) ValidateRefreshToken(ctx context.Context, requester fosite.Requester, token string) (err error) {
-	return t.d.OAuth2HMACStrategy().ValidateRefreshToken(ctx, requester, token)
+	return t.rsFor(token).ValidateRefreshToken(ctx, requester, token)
 }
 
 func (t TokenStrategy) AuthorizeCodeSignature(ctx context.Context, token string) string {
@@ -786,6 +786,114 @@ func (a *OAuth2APIService) DeleteOAuth2ClientExecute(r ApiDeleteOAuth2ClientRequ
 	return localVarHTTPResponse, nil
 }
 
+type ApiDeleteOAuth2ConsentHistoryRequest struct {
+	ctx        context.Context
+	ApiService *OAuth2APIService
+	subject    *string
+}
+
+// The subject to delete the consent history for.
+func (r ApiDeleteOAuth2ConsentHistoryRequest) Subject(subject string) ApiDeleteOAuth2ConsentHistoryRequest {
+	r.subject = &subject
+	return r
+}
+
+func (r ApiDeleteOAuth2ConsentHistoryRequest) Execute() (*http.Response, error) {
+	return r.ApiService.DeleteOAuth2ConsentHistoryExecute(r)
+}
+
+/*
+DeleteOAuth2ConsentHistory Delete the OAuth 2.0 Consent History of a Subject
+
+This endpoint irreversibly deletes the consent history of the subject, for example when the subject's
+personal data is erased. It does not revoke the subject's consent sessions.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiDeleteOAuth2ConsentHistoryRequest
+*/
+func (a *OAuth2APIService) DeleteOAuth2ConsentHistory(ctx context.Context) ApiDeleteOAuth2ConsentHistoryRequest {
+	return ApiDeleteOAuth2ConsentHistoryRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+func (a *OAuth2APIService) DeleteOAuth2ConsentHistoryExecute(r ApiDeleteOAuth2ConsentHistoryRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodDelete
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.DeleteOAuth2ConsentHistory")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/oauth2/auth/sessions/consent/history"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.subject == nil {
+		return nil, reportError("subject is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "subject", r.subject, "form", "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v ErrorOAuth2
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
 type ApiDeleteOAuth2TokenRequest struct {
 	ctx        context.Context
 	ApiService *OAuth2APIService
@@ -1121,6 +1229,132 @@ func (a *OAuth2APIService) DeleteTrustedOAuth2JwtGrantIssuerExecute(r ApiDeleteT
 	return localVarHTTPResponse, nil
 }
 
+type ApiEraseOAuth2SubjectRequest struct {
+	ctx        context.Context
+	ApiService *OAuth2APIService
+	subject    *string
+}
+
+// The subject to erase.
+func (r ApiEraseOAuth2SubjectRequest) Subject(subject string) ApiEraseOAuth2SubjectRequest {
+	r.subject = &subject
+	return r
+}
+
+func (r ApiEraseOAuth2SubjectRequest) Execute() (*SignedSubjectErasureReport, *http.Response, error) {
+	return r.ApiService.EraseOAuth2SubjectExecute(r)
+}
+
+/*
+EraseOAuth2Subject Erase all Data of an OAuth 2.0 Subject
+
+This endpoint irreversibly deletes all data stored about the subject in a single transaction: its login and
+consent sessions, flows, consent history, pairwise subject identifiers, and all tokens issued to it, including
+tokens issued to its pairwise identifiers. The tokens are revoked. OpenID Connect Back-channel logout is performed
+for all of the subject's login sessions.
+
+The response contains a report of the erasure, which is signed with the OpenID Connect ID token signing key
+whose public key is published at `/.well-known/jwks.json`. The report contains an HMAC of the subject keyed with the system secret
+instead of the subject itself, so that it can be kept as proof of the erasure.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiEraseOAuth2SubjectRequest
+*/
+func (a *OAuth2APIService) EraseOAuth2Subject(ctx context.Context) ApiEraseOAuth2SubjectRequest {
+	return ApiEraseOAuth2SubjectRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return SignedSubjectErasureReport
+func (a *OAuth2APIService) EraseOAuth2SubjectExecute(r ApiEraseOAuth2SubjectRequest) (*SignedSubjectErasureReport, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodDelete
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *SignedSubjectErasureReport
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.EraseOAuth2Subject")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/oauth2/auth/subjects"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.subject == nil {
+		return localVarReturnValue, nil, reportError("subject is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "subject", r.subject, "form", "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v ErrorOAuth2
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiGetOAuth2ClientRequest struct {
 	ctx        context.Context
 	ApiService *OAuth2APIService
@@ -1375,40 +1609,34 @@ func (a *OAuth2APIService) GetOAuth2ConsentRequestExecute(r ApiGetOAuth2ConsentR
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGetOAuth2LoginRequestRequest struct {
-	ctx            context.Context
-	ApiService     *OAuth2APIService
-	loginChallenge *string
+type ApiGetOAuth2DeviceRequestRequest struct {
+	ctx        context.Context
+	ApiService *OAuth2APIService
+	userCode   *string
 }
 
-// OAuth 2.0 Login Request Challenge
-func (r ApiGetOAuth2LoginRequestRequest) LoginChallenge(loginChallenge string) ApiGetOAuth2LoginRequestRequest {
-	r.loginChallenge = &loginChallenge
+// The user code shown to the end-user by the device.
+func (r ApiGetOAuth2DeviceRequestRequest) UserCode(userCode string) ApiGetOAuth2DeviceRequestRequest {
+	r.userCode = &userCode
 	return r
 }
 
-func (r ApiGetOAuth2LoginRequestRequest) Execute() (*OAuth2LoginRequest, *http.Response, error) {
-	return r.ApiService.GetOAuth2LoginRequestExecute(r)
+func (r ApiGetOAuth2DeviceRequestRequest) Execute() (*OAuth2DeviceRequest, *http.Response, error) {
+	return r.ApiService.GetOAuth2DeviceRequestExecute(r)
 }
 
 /*
-GetOAuth2LoginRequest Get OAuth 2.0 Login Request
-
-When an authorization code, hybrid, or implicit OAuth 2.0 Flow is initiated, Ory asks the login provider
-to authenticate the subject and then tell the Ory OAuth2 Service about it.
-
-Per default, the login provider is Ory itself. You may use a different login provider which needs to be a web-app
-you write and host, and it must be able to authenticate ("show the subject a login screen")
-a subject (in OAuth2 the proper name for subject is "resource owner").
+GetOAuth2DeviceRequest Get an OAuth 2.0 Device Authorization Request
 
-The authentication challenge is appended to the login provider URL to which the subject's user-agent (browser) is redirected to. The login
-provider uses that challenge to fetch information on the OAuth2 request and then accept or reject the requested authentication process.
+This endpoint returns the device authorization request of a user code, including the OAuth 2.0 Client which
+initiated it and whether the user code is still pending, was accepted, or was rejected. Expired requests are
+returned until they are flushed.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiGetOAuth2LoginRequestRequest
+	@return ApiGetOAuth2DeviceRequestRequest
 */
-func (a *OAuth2APIService) GetOAuth2LoginRequest(ctx context.Context) ApiGetOAuth2LoginRequestRequest {
-	return ApiGetOAuth2LoginRequestRequest{
+func (a *OAuth2APIService) GetOAuth2DeviceRequest(ctx context.Context) ApiGetOAuth2DeviceRequestRequest {
+	return ApiGetOAuth2DeviceRequestRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -1416,30 +1644,30 @@ func (a *OAuth2APIService) GetOAuth2LoginRequest(ctx context.Context) ApiGetOAut
 
 // Execute executes the request
 //
-//	@return OAuth2LoginRequest
-func (a *OAuth2APIService) GetOAuth2LoginRequestExecute(r ApiGetOAuth2LoginRequestRequest) (*OAuth2LoginRequest, *http.Response, error) {
+//	@return OAuth2DeviceRequest
+func (a *OAuth2APIService) GetOAuth2DeviceRequestExecute(r ApiGetOAuth2DeviceRequestRequest) (*OAuth2DeviceRequest, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue *OAuth2LoginRequest
+		localVarReturnValue *OAuth2DeviceRequest
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.GetOAuth2LoginRequest")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.GetOAuth2DeviceRequest")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/admin/oauth2/auth/requests/login"
+	localVarPath := localBasePath + "/admin/oauth2/auth/requests/device"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-	if r.loginChallenge == nil {
-		return localVarReturnValue, nil, reportError("loginChallenge is required and must be specified")
+	if r.userCode == nil {
+		return localVarReturnValue, nil, reportError("userCode is required and must be specified")
 	}
 
-	parameterAddToHeaderOrQuery(localVarQueryParams, "login_challenge", r.loginChallenge, "form", "")
+	parameterAddToHeaderOrQuery(localVarQueryParams, "user_code", r.userCode, "form", "")
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -1479,17 +1707,6 @@ func (a *OAuth2APIService) GetOAuth2LoginRequestExecute(r ApiGetOAuth2LoginReque
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		if localVarHTTPResponse.StatusCode == 410 {
-			var v OAuth2RedirectTo
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHTTPResponse, newErr
-			}
-			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
-			newErr.model = v
-			return localVarReturnValue, localVarHTTPResponse, newErr
-		}
 		var v ErrorOAuth2
 		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
@@ -1513,19 +1730,157 @@ func (a *OAuth2APIService) GetOAuth2LoginRequestExecute(r ApiGetOAuth2LoginReque
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiGetOAuth2LogoutRequestRequest struct {
-	ctx             context.Context
-	ApiService      *OAuth2APIService
-	logoutChallenge *string
+type ApiGetOAuth2LoginRequestRequest struct {
+	ctx            context.Context
+	ApiService     *OAuth2APIService
+	loginChallenge *string
 }
 
-func (r ApiGetOAuth2LogoutRequestRequest) LogoutChallenge(logoutChallenge string) ApiGetOAuth2LogoutRequestRequest {
-	r.logoutChallenge = &logoutChallenge
+// OAuth 2.0 Login Request Challenge
+func (r ApiGetOAuth2LoginRequestRequest) LoginChallenge(loginChallenge string) ApiGetOAuth2LoginRequestRequest {
+	r.loginChallenge = &loginChallenge
 	return r
 }
 
-func (r ApiGetOAuth2LogoutRequestRequest) Execute() (*OAuth2LogoutRequest, *http.Response, error) {
-	return r.ApiService.GetOAuth2LogoutRequestExecute(r)
+func (r ApiGetOAuth2LoginRequestRequest) Execute() (*OAuth2LoginRequest, *http.Response, error) {
+	return r.ApiService.GetOAuth2LoginRequestExecute(r)
+}
+
+/*
+GetOAuth2LoginRequest Get OAuth 2.0 Login Request
+
+When an authorization code, hybrid, or implicit OAuth 2.0 Flow is initiated, Ory asks the login provider
+to authenticate the subject and then tell the Ory OAuth2 Service about it.
+
+Per default, the login provider is Ory itself. You may use a different login provider which needs to be a web-app
+you write and host, and it must be able to authenticate ("show the subject a login screen")
+a subject (in OAuth2 the proper name for subject is "resource owner").
+
+The authentication challenge is appended to the login provider URL to which the subject's user-agent (browser) is redirected to. The login
+provider uses that challenge to fetch information on the OAuth2 request and then accept or reject the requested authentication process.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetOAuth2LoginRequestRequest
+*/
+func (a *OAuth2APIService) GetOAuth2LoginRequest(ctx context.Context) ApiGetOAuth2LoginRequestRequest {
+	return ApiGetOAuth2LoginRequestRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OAuth2LoginRequest
+func (a *OAuth2APIService) GetOAuth2LoginRequestExecute(r ApiGetOAuth2LoginRequestRequest) (*OAuth2LoginRequest, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OAuth2LoginRequest
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.GetOAuth2LoginRequest")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/oauth2/auth/requests/login"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.loginChallenge == nil {
+		return localVarReturnValue, nil, reportError("loginChallenge is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "login_challenge", r.loginChallenge, "form", "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 410 {
+			var v OAuth2RedirectTo
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		var v ErrorOAuth2
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetOAuth2LogoutRequestRequest struct {
+	ctx             context.Context
+	ApiService      *OAuth2APIService
+	logoutChallenge *string
+}
+
+func (r ApiGetOAuth2LogoutRequestRequest) LogoutChallenge(logoutChallenge string) ApiGetOAuth2LogoutRequestRequest {
+	r.logoutChallenge = &logoutChallenge
+	return r
+}
+
+func (r ApiGetOAuth2LogoutRequestRequest) Execute() (*OAuth2LogoutRequest, *http.Response, error) {
+	return r.ApiService.GetOAuth2LogoutRequestExecute(r)
 }
 
 /*
@@ -1631,6 +1986,138 @@ func (a *OAuth2APIService) GetOAuth2LogoutRequestExecute(r ApiGetOAuth2LogoutReq
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiGetOAuth2PairwiseSubjectRequest struct {
+	ctx             context.Context
+	ApiService      *OAuth2APIService
+	clientId        *string
+	pairwiseSubject *string
+}
+
+// The OAuth 2.0 Client the pairwise subject identifier was issued to.
+func (r ApiGetOAuth2PairwiseSubjectRequest) ClientId(clientId string) ApiGetOAuth2PairwiseSubjectRequest {
+	r.clientId = &clientId
+	return r
+}
+
+// The pairwise subject identifier to resolve.
+func (r ApiGetOAuth2PairwiseSubjectRequest) PairwiseSubject(pairwiseSubject string) ApiGetOAuth2PairwiseSubjectRequest {
+	r.pairwiseSubject = &pairwiseSubject
+	return r
+}
+
+func (r ApiGetOAuth2PairwiseSubjectRequest) Execute() (*PairwiseSubject, *http.Response, error) {
+	return r.ApiService.GetOAuth2PairwiseSubjectExecute(r)
+}
+
+/*
+GetOAuth2PairwiseSubject Resolve an OAuth 2.0 Pairwise Subject Identifier
+
+This endpoint returns the subject a pairwise subject identifier issued to an OAuth 2.0 Client stands for. Both the
+current and the previous identifiers recorded by a migration are resolved, as are identifiers computed with one of
+the salts configured at `oidc.subject_identifiers.pairwise.previous_salts`.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetOAuth2PairwiseSubjectRequest
+*/
+func (a *OAuth2APIService) GetOAuth2PairwiseSubject(ctx context.Context) ApiGetOAuth2PairwiseSubjectRequest {
+	return ApiGetOAuth2PairwiseSubjectRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return PairwiseSubject
+func (a *OAuth2APIService) GetOAuth2PairwiseSubjectExecute(r ApiGetOAuth2PairwiseSubjectRequest) (*PairwiseSubject, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *PairwiseSubject
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.GetOAuth2PairwiseSubject")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/oauth2/auth/subjects/pairwise"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.clientId == nil {
+		return localVarReturnValue, nil, reportError("clientId is required and must be specified")
+	}
+	if r.pairwiseSubject == nil {
+		return localVarReturnValue, nil, reportError("pairwiseSubject is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "client_id", r.clientId, "form", "")
+	parameterAddToHeaderOrQuery(localVarQueryParams, "pairwise_subject", r.pairwiseSubject, "form", "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v ErrorOAuth2
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiGetTrustedOAuth2JwtGrantIssuerRequest struct {
 	ctx        context.Context
 	ApiService *OAuth2APIService
@@ -1774,6 +2261,10 @@ The introspection endpoint allows to check if a token (both refresh and access)
 is neither expired nor revoked. If a token is active, additional information on the token will be included. You can
 set additional data for a token by setting `session.access_token` during the consent flow.
 
+If the request accepts `application/token-introspection+jwt`, the response is a JWT signed with the
+`hydra.jwt.introspection` key set as defined in RFC 9701. The resource server must authenticate
+with its OAuth 2.0 Client credentials, and its client ID is used as the audience of the JWT.
+
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiIntrospectOAuth2TokenRequest
 */
@@ -2027,16 +2518,304 @@ func (a *OAuth2APIService) ListOAuth2ClientsExecute(r ApiListOAuth2ClientsReques
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type ApiListOAuth2ConsentSessionsRequest struct {
-	ctx            context.Context
-	ApiService     *OAuth2APIService
-	subject        *string
-	pageSize       *int64
-	pageToken      *string
-	loginSessionId *string
-}
-
-// The subject to list the consent sessions for.
+type ApiListOAuth2ConsentHistoryRequest struct {
+	ctx        context.Context
+	ApiService *OAuth2APIService
+	subject    *string
+	pageSize   *int64
+	pageToken  *string
+}
+
+// The subject to list the consent history for.
+func (r ApiListOAuth2ConsentHistoryRequest) Subject(subject string) ApiListOAuth2ConsentHistoryRequest {
+	r.subject = &subject
+	return r
+}
+
+// Items per Page  This is the number of items per page to return. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
+func (r ApiListOAuth2ConsentHistoryRequest) PageSize(pageSize int64) ApiListOAuth2ConsentHistoryRequest {
+	r.pageSize = &pageSize
+	return r
+}
+
+// Next Page Token  The next page token. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
+func (r ApiListOAuth2ConsentHistoryRequest) PageToken(pageToken string) ApiListOAuth2ConsentHistoryRequest {
+	r.pageToken = &pageToken
+	return r
+}
+
+func (r ApiListOAuth2ConsentHistoryRequest) Execute() ([]ConsentHistoryEntry, *http.Response, error) {
+	return r.ApiService.ListOAuth2ConsentHistoryExecute(r)
+}
+
+/*
+ListOAuth2ConsentHistory List the OAuth 2.0 Consent History of a Subject
+
+This endpoint lists every consent the subject granted, modified or revoked, oldest first. Unlike the
+consent sessions, the history keeps revoked and expired consents. If the subject is unknown, the
+endpoint returns an empty JSON array with status code 200 OK.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiListOAuth2ConsentHistoryRequest
+*/
+func (a *OAuth2APIService) ListOAuth2ConsentHistory(ctx context.Context) ApiListOAuth2ConsentHistoryRequest {
+	return ApiListOAuth2ConsentHistoryRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []ConsentHistoryEntry
+func (a *OAuth2APIService) ListOAuth2ConsentHistoryExecute(r ApiListOAuth2ConsentHistoryRequest) ([]ConsentHistoryEntry, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []ConsentHistoryEntry
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.ListOAuth2ConsentHistory")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/oauth2/auth/sessions/consent/history"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.subject == nil {
+		return localVarReturnValue, nil, reportError("subject is required and must be specified")
+	}
+
+	if r.pageSize != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page_size", r.pageSize, "form", "")
+	} else {
+		var defaultValue int64 = 250
+		r.pageSize = &defaultValue
+	}
+	if r.pageToken != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page_token", r.pageToken, "form", "")
+	}
+	parameterAddToHeaderOrQuery(localVarQueryParams, "subject", r.subject, "form", "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v ErrorOAuth2
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiListOAuth2ConsentReceiptsRequest struct {
+	ctx        context.Context
+	ApiService *OAuth2APIService
+	subject    *string
+	pageSize   *int64
+	pageToken  *string
+}
+
+// The subject to list the consent history for.
+func (r ApiListOAuth2ConsentReceiptsRequest) Subject(subject string) ApiListOAuth2ConsentReceiptsRequest {
+	r.subject = &subject
+	return r
+}
+
+// Items per Page  This is the number of items per page to return. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
+func (r ApiListOAuth2ConsentReceiptsRequest) PageSize(pageSize int64) ApiListOAuth2ConsentReceiptsRequest {
+	r.pageSize = &pageSize
+	return r
+}
+
+// Next Page Token  The next page token. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
+func (r ApiListOAuth2ConsentReceiptsRequest) PageToken(pageToken string) ApiListOAuth2ConsentReceiptsRequest {
+	r.pageToken = &pageToken
+	return r
+}
+
+func (r ApiListOAuth2ConsentReceiptsRequest) Execute() ([]SignedConsentReceipt, *http.Response, error) {
+	return r.ApiService.ListOAuth2ConsentReceiptsExecute(r)
+}
+
+/*
+ListOAuth2ConsentReceipts Export the OAuth 2.0 Consent Receipts of a Subject
+
+This endpoint exports the consent history of the subject as consent receipts following the Kantara
+Initiative Consent Receipt Specification v1.1, one receipt per history entry. Each receipt is signed
+with the OpenID Connect ID token signing key, whose public key is published at `/.well-known/jwks.json`.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiListOAuth2ConsentReceiptsRequest
+*/
+func (a *OAuth2APIService) ListOAuth2ConsentReceipts(ctx context.Context) ApiListOAuth2ConsentReceiptsRequest {
+	return ApiListOAuth2ConsentReceiptsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []SignedConsentReceipt
+func (a *OAuth2APIService) ListOAuth2ConsentReceiptsExecute(r ApiListOAuth2ConsentReceiptsRequest) ([]SignedConsentReceipt, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []SignedConsentReceipt
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.ListOAuth2ConsentReceipts")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/oauth2/auth/sessions/consent/receipts"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.subject == nil {
+		return localVarReturnValue, nil, reportError("subject is required and must be specified")
+	}
+
+	if r.pageSize != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page_size", r.pageSize, "form", "")
+	} else {
+		var defaultValue int64 = 250
+		r.pageSize = &defaultValue
+	}
+	if r.pageToken != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page_token", r.pageToken, "form", "")
+	}
+	parameterAddToHeaderOrQuery(localVarQueryParams, "subject", r.subject, "form", "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v ErrorOAuth2
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiListOAuth2ConsentSessionsRequest struct {
+	ctx            context.Context
+	ApiService     *OAuth2APIService
+	subject        *string
+	pageSize       *int64
+	pageToken      *string
+	loginSessionId *string
+}
+
+// The subject to list the consent sessions for.
 func (r ApiListOAuth2ConsentSessionsRequest) Subject(subject string) ApiListOAuth2ConsentSessionsRequest {
 	r.subject = &subject
 	return r
@@ -2181,6 +2960,149 @@ func (a *OAuth2APIService) ListOAuth2ConsentSessionsExecute(r ApiListOAuth2Conse
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiListOAuth2PendingDeviceRequestsRequest struct {
+	ctx        context.Context
+	ApiService *OAuth2APIService
+	clientId   *string
+	pageSize   *int64
+	pageToken  *string
+}
+
+// The OAuth 2.0 Client to list the pending device authorization requests for.
+func (r ApiListOAuth2PendingDeviceRequestsRequest) ClientId(clientId string) ApiListOAuth2PendingDeviceRequestsRequest {
+	r.clientId = &clientId
+	return r
+}
+
+// Items per Page  This is the number of items per page to return. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
+func (r ApiListOAuth2PendingDeviceRequestsRequest) PageSize(pageSize int64) ApiListOAuth2PendingDeviceRequestsRequest {
+	r.pageSize = &pageSize
+	return r
+}
+
+// Next Page Token  The next page token. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
+func (r ApiListOAuth2PendingDeviceRequestsRequest) PageToken(pageToken string) ApiListOAuth2PendingDeviceRequestsRequest {
+	r.pageToken = &pageToken
+	return r
+}
+
+func (r ApiListOAuth2PendingDeviceRequestsRequest) Execute() ([]OAuth2DeviceRequest, *http.Response, error) {
+	return r.ApiService.ListOAuth2PendingDeviceRequestsExecute(r)
+}
+
+/*
+ListOAuth2PendingDeviceRequests List Pending OAuth 2.0 Device Authorization Requests of a Client
+
+This endpoint lists the device authorization requests of an OAuth 2.0 Client whose user code has neither been
+accepted nor rejected and has not expired yet, oldest first.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiListOAuth2PendingDeviceRequestsRequest
+*/
+func (a *OAuth2APIService) ListOAuth2PendingDeviceRequests(ctx context.Context) ApiListOAuth2PendingDeviceRequestsRequest {
+	return ApiListOAuth2PendingDeviceRequestsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []OAuth2DeviceRequest
+func (a *OAuth2APIService) ListOAuth2PendingDeviceRequestsExecute(r ApiListOAuth2PendingDeviceRequestsRequest) ([]OAuth2DeviceRequest, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []OAuth2DeviceRequest
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.ListOAuth2PendingDeviceRequests")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/oauth2/auth/requests/device/pending"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.clientId == nil {
+		return localVarReturnValue, nil, reportError("clientId is required and must be specified")
+	}
+
+	if r.pageSize != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page_size", r.pageSize, "form", "")
+	} else {
+		var defaultValue int64 = 250
+		r.pageSize = &defaultValue
+	}
+	if r.pageToken != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page_token", r.pageToken, "form", "")
+	}
+	parameterAddToHeaderOrQuery(localVarQueryParams, "client_id", r.clientId, "form", "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v ErrorOAuth2
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiListTrustedOAuth2JwtGrantIssuersRequest struct {
 	ctx        context.Context
 	ApiService *OAuth2APIService
@@ -2195,32 +3117,167 @@ func (r ApiListTrustedOAuth2JwtGrantIssuersRequest) PageSize(pageSize int64) Api
 	return r
 }
 
-// Next Page Token  The next page token. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
-func (r ApiListTrustedOAuth2JwtGrantIssuersRequest) PageToken(pageToken string) ApiListTrustedOAuth2JwtGrantIssuersRequest {
-	r.pageToken = &pageToken
-	return r
+// Next Page Token  The next page token. For details on pagination please head over to the [pagination documentation](https://www.ory.com/docs/ecosystem/api-design#pagination).
+func (r ApiListTrustedOAuth2JwtGrantIssuersRequest) PageToken(pageToken string) ApiListTrustedOAuth2JwtGrantIssuersRequest {
+	r.pageToken = &pageToken
+	return r
+}
+
+// If optional \&quot;issuer\&quot; is supplied, only jwt-bearer grants with this issuer will be returned.
+func (r ApiListTrustedOAuth2JwtGrantIssuersRequest) Issuer(issuer string) ApiListTrustedOAuth2JwtGrantIssuersRequest {
+	r.issuer = &issuer
+	return r
+}
+
+func (r ApiListTrustedOAuth2JwtGrantIssuersRequest) Execute() ([]TrustedOAuth2JwtGrantIssuer, *http.Response, error) {
+	return r.ApiService.ListTrustedOAuth2JwtGrantIssuersExecute(r)
+}
+
+/*
+ListTrustedOAuth2JwtGrantIssuers List Trusted OAuth2 JWT Bearer Grant Type Issuers
+
+Use this endpoint to list all trusted JWT Bearer Grant Type Issuers.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiListTrustedOAuth2JwtGrantIssuersRequest
+*/
+func (a *OAuth2APIService) ListTrustedOAuth2JwtGrantIssuers(ctx context.Context) ApiListTrustedOAuth2JwtGrantIssuersRequest {
+	return ApiListTrustedOAuth2JwtGrantIssuersRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []TrustedOAuth2JwtGrantIssuer
+func (a *OAuth2APIService) ListTrustedOAuth2JwtGrantIssuersExecute(r ApiListTrustedOAuth2JwtGrantIssuersRequest) ([]TrustedOAuth2JwtGrantIssuer, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []TrustedOAuth2JwtGrantIssuer
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.ListTrustedOAuth2JwtGrantIssuers")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/trust/grants/jwt-bearer/issuers"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.pageSize != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page_size", r.pageSize, "form", "")
+	} else {
+		var defaultValue int64 = 250
+		r.pageSize = &defaultValue
+	}
+	if r.pageToken != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page_token", r.pageToken, "form", "")
+	}
+	if r.issuer != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "issuer", r.issuer, "form", "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GenericError
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiMigrateOAuth2PairwiseSubjectsRequest struct {
+	ctx                      context.Context
+	ApiService               *OAuth2APIService
+	pairwiseSubjectMigration *PairwiseSubjectMigration
 }
 
-// If optional \&quot;issuer\&quot; is supplied, only jwt-bearer grants with this issuer will be returned.
-func (r ApiListTrustedOAuth2JwtGrantIssuersRequest) Issuer(issuer string) ApiListTrustedOAuth2JwtGrantIssuersRequest {
-	r.issuer = &issuer
+func (r ApiMigrateOAuth2PairwiseSubjectsRequest) PairwiseSubjectMigration(pairwiseSubjectMigration PairwiseSubjectMigration) ApiMigrateOAuth2PairwiseSubjectsRequest {
+	r.pairwiseSubjectMigration = &pairwiseSubjectMigration
 	return r
 }
 
-func (r ApiListTrustedOAuth2JwtGrantIssuersRequest) Execute() ([]TrustedOAuth2JwtGrantIssuer, *http.Response, error) {
-	return r.ApiService.ListTrustedOAuth2JwtGrantIssuersExecute(r)
+func (r ApiMigrateOAuth2PairwiseSubjectsRequest) Execute() ([]PairwiseSubject, *http.Response, error) {
+	return r.ApiService.MigrateOAuth2PairwiseSubjectsExecute(r)
 }
 
 /*
-ListTrustedOAuth2JwtGrantIssuers List Trusted OAuth2 JWT Bearer Grant Type Issuers
+MigrateOAuth2PairwiseSubjects Migrate the Pairwise Subject Identifiers of an OAuth 2.0 Client
 
-Use this endpoint to list all trusted JWT Bearer Grant Type Issuers.
+Changing the subject type, the sector identifier URI, or the redirect URIs of an OAuth 2.0 Client, or rotating the
+pairwise salt, changes the subject identifiers computed for the client. Identifiers already issued are kept, but
+subjects which never used the client since are issued new ones.
+
+This endpoint computes, for all subjects which used the client, the identifier issued before the change and the
+identifier computed from the client's current configuration. Per default, the client is issued the current
+identifiers from then on, and the previous identifiers are recorded so they can still be resolved and used as
+`id_token_hint`. With `keep_previous_identifiers`, the client keeps being issued the previous identifiers instead.
+
+Use `dry_run` to review the mapping before persisting it.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
-	@return ApiListTrustedOAuth2JwtGrantIssuersRequest
+	@return ApiMigrateOAuth2PairwiseSubjectsRequest
 */
-func (a *OAuth2APIService) ListTrustedOAuth2JwtGrantIssuers(ctx context.Context) ApiListTrustedOAuth2JwtGrantIssuersRequest {
-	return ApiListTrustedOAuth2JwtGrantIssuersRequest{
+func (a *OAuth2APIService) MigrateOAuth2PairwiseSubjects(ctx context.Context) ApiMigrateOAuth2PairwiseSubjectsRequest {
+	return ApiMigrateOAuth2PairwiseSubjectsRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -2228,40 +3285,31 @@ func (a *OAuth2APIService) ListTrustedOAuth2JwtGrantIssuers(ctx context.Context)
 
 // Execute executes the request
 //
-//	@return []TrustedOAuth2JwtGrantIssuer
-func (a *OAuth2APIService) ListTrustedOAuth2JwtGrantIssuersExecute(r ApiListTrustedOAuth2JwtGrantIssuersRequest) ([]TrustedOAuth2JwtGrantIssuer, *http.Response, error) {
+//	@return []PairwiseSubject
+func (a *OAuth2APIService) MigrateOAuth2PairwiseSubjectsExecute(r ApiMigrateOAuth2PairwiseSubjectsRequest) ([]PairwiseSubject, *http.Response, error) {
 	var (
-		localVarHTTPMethod  = http.MethodGet
+		localVarHTTPMethod  = http.MethodPost
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []TrustedOAuth2JwtGrantIssuer
+		localVarReturnValue []PairwiseSubject
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.ListTrustedOAuth2JwtGrantIssuers")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.MigrateOAuth2PairwiseSubjects")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/admin/trust/grants/jwt-bearer/issuers"
+	localVarPath := localBasePath + "/admin/oauth2/auth/subjects/pairwise/migrations"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
-
-	if r.pageSize != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "page_size", r.pageSize, "form", "")
-	} else {
-		var defaultValue int64 = 250
-		r.pageSize = &defaultValue
-	}
-	if r.pageToken != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "page_token", r.pageToken, "form", "")
-	}
-	if r.issuer != nil {
-		parameterAddToHeaderOrQuery(localVarQueryParams, "issuer", r.issuer, "form", "")
+	if r.pairwiseSubjectMigration == nil {
+		return localVarReturnValue, nil, reportError("pairwiseSubjectMigration is required and must be specified")
 	}
+
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -2277,6 +3325,8 @@ func (a *OAuth2APIService) ListTrustedOAuth2JwtGrantIssuersExecute(r ApiListTrus
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.pairwiseSubjectMigration
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -2299,7 +3349,7 @@ func (a *OAuth2APIService) ListTrustedOAuth2JwtGrantIssuersExecute(r ApiListTrus
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
-		var v GenericError
+		var v ErrorOAuth2
 		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
 			newErr.error = err.Error()
@@ -3095,6 +4145,127 @@ func (a *OAuth2APIService) RejectOAuth2ConsentRequestExecute(r ApiRejectOAuth2Co
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiRejectOAuth2DeviceRequestRequest struct {
+	ctx        context.Context
+	ApiService *OAuth2APIService
+	userCode   *string
+}
+
+// The user code shown to the end-user by the device.
+func (r ApiRejectOAuth2DeviceRequestRequest) UserCode(userCode string) ApiRejectOAuth2DeviceRequestRequest {
+	r.userCode = &userCode
+	return r
+}
+
+func (r ApiRejectOAuth2DeviceRequestRequest) Execute() (*OAuth2DeviceRequest, *http.Response, error) {
+	return r.ApiService.RejectOAuth2DeviceRequestExecute(r)
+}
+
+/*
+RejectOAuth2DeviceRequest Reject an OAuth 2.0 Device Authorization Request
+
+This endpoint denies a pending device authorization request. The user code can no longer be used, and the device
+polling the token endpoint receives an `access_denied` error. User codes which were already accepted can not be
+rejected; revoke the consent session instead.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiRejectOAuth2DeviceRequestRequest
+*/
+func (a *OAuth2APIService) RejectOAuth2DeviceRequest(ctx context.Context) ApiRejectOAuth2DeviceRequestRequest {
+	return ApiRejectOAuth2DeviceRequestRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return OAuth2DeviceRequest
+func (a *OAuth2APIService) RejectOAuth2DeviceRequestExecute(r ApiRejectOAuth2DeviceRequestRequest) (*OAuth2DeviceRequest, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *OAuth2DeviceRequest
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.RejectOAuth2DeviceRequest")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/oauth2/auth/requests/device/reject"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.userCode == nil {
+		return localVarReturnValue, nil, reportError("userCode is required and must be specified")
+	}
+
+	parameterAddToHeaderOrQuery(localVarQueryParams, "user_code", r.userCode, "form", "")
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v ErrorOAuth2
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiRejectOAuth2LoginRequestRequest struct {
 	ctx                 context.Context
 	ApiService          *OAuth2APIService
@@ -3340,6 +4511,130 @@ func (a *OAuth2APIService) RejectOAuth2LogoutRequestExecute(r ApiRejectOAuth2Log
 	return localVarHTTPResponse, nil
 }
 
+type ApiReplaceTrustedOAuth2JwtGrantIssuerRequest struct {
+	ctx                       context.Context
+	ApiService                *OAuth2APIService
+	id                        string
+	trustOAuth2JwtGrantIssuer *TrustOAuth2JwtGrantIssuer
+}
+
+func (r ApiReplaceTrustedOAuth2JwtGrantIssuerRequest) TrustOAuth2JwtGrantIssuer(trustOAuth2JwtGrantIssuer TrustOAuth2JwtGrantIssuer) ApiReplaceTrustedOAuth2JwtGrantIssuerRequest {
+	r.trustOAuth2JwtGrantIssuer = &trustOAuth2JwtGrantIssuer
+	return r
+}
+
+func (r ApiReplaceTrustedOAuth2JwtGrantIssuerRequest) Execute() (*TrustedOAuth2JwtGrantIssuer, *http.Response, error) {
+	return r.ApiService.ReplaceTrustedOAuth2JwtGrantIssuerExecute(r)
+}
+
+/*
+ReplaceTrustedOAuth2JwtGrantIssuer Replace Trusted OAuth2 JWT Bearer Grant Type Issuer
+
+Use this endpoint to replace a trusted JWT Bearer Grant Type Issuer. The ID is the one returned when you
+created the trust relationship and is kept.
+
+The trust relationship and its public key are replaced in a single transaction, so assertions of the
+issuer are never rejected because neither the previous nor the new trust relationship exists.
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param id The id of the grant to replace
+	@return ApiReplaceTrustedOAuth2JwtGrantIssuerRequest
+*/
+func (a *OAuth2APIService) ReplaceTrustedOAuth2JwtGrantIssuer(ctx context.Context, id string) ApiReplaceTrustedOAuth2JwtGrantIssuerRequest {
+	return ApiReplaceTrustedOAuth2JwtGrantIssuerRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+// Execute executes the request
+//
+//	@return TrustedOAuth2JwtGrantIssuer
+func (a *OAuth2APIService) ReplaceTrustedOAuth2JwtGrantIssuerExecute(r ApiReplaceTrustedOAuth2JwtGrantIssuerRequest) (*TrustedOAuth2JwtGrantIssuer, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPut
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *TrustedOAuth2JwtGrantIssuer
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "OAuth2APIService.ReplaceTrustedOAuth2JwtGrantIssuer")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/trust/grants/jwt-bearer/issuers/{id}"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterValueToString(r.id, "id")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.trustOAuth2JwtGrantIssuer
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v GenericError
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.error = formatErrorMessage(localVarHTTPResponse.Status, &v)
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiRevokeOAuth2ConsentSessionsRequest struct {
 	ctx              context.Context
 	ApiService       *OAuth2APIService
@@ -3347,6 +4642,7 @@ type ApiRevokeOAuth2ConsentSessionsRequest struct {
 	client           *string
 	consentRequestId *string
 	all              *bool
+	reason           *string
 }
 
 // OAuth 2.0 Consent Subject  The subject whose consent sessions should be deleted.
@@ -3373,6 +4669,12 @@ func (r ApiRevokeOAuth2ConsentSessionsRequest) All(all bool) ApiRevokeOAuth2Cons
 	return r
 }
 
+// Revocation Reason  The reason for the revocation, recorded in the consent history.
+func (r ApiRevokeOAuth2ConsentSessionsRequest) Reason(reason string) ApiRevokeOAuth2ConsentSessionsRequest {
+	r.reason = &reason
+	return r
+}
+
 func (r ApiRevokeOAuth2ConsentSessionsRequest) Execute() (*http.Response, error) {
 	return r.ApiService.RevokeOAuth2ConsentSessionsExecute(r)
 }
@@ -3382,6 +4684,7 @@ RevokeOAuth2ConsentSessions Revoke OAuth 2.0 Consent Sessions of a Subject
 
 This endpoint revokes a subject's granted consent sessions and invalidates all
 associated OAuth 2.0 Access Tokens. You may also only revoke sessions for a specific OAuth 2.0 Client ID.
+The revocation is recorded in the subject's consent history.
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiRevokeOAuth2ConsentSessionsRequest
@@ -3424,6 +4727,9 @@ func (a *OAuth2APIService) RevokeOAuth2ConsentSessionsExecute(r ApiRevokeOAuth2C
 	if r.all != nil {
 		parameterAddToHeaderOrQuery(localVarQueryParams, "all", r.all, "form", "")
 	}
+	if r.reason != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "reason", r.reason, "form", "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -650,6 +650,9 @@ GetOidcUserInfo OpenID Connect Userinfo
 This endpoint returns the payload of the ID Token, including `session.id_token` values, of
 the provided OAuth 2.0 Access Token's consent request.
 
+If a userinfo hook is configured, the claims are instead fetched from the hook on every request
+and filtered by the granted scopes. The hook may also return aggregated and distributed claims.
+
 In the case of authentication error, a WWW-Authenticate header might be set in the response
 with more information about the error. See [the spec](https://datatracker.ietf.org/doc/html/rfc6750#section-3)
 for more details about header format.
@@ -59,6 +59,10 @@ type APIClient struct {
 
 	OidcAPI *OidcAPIService
 
+	ScopeAPI *ScopeAPIService
+
+	TenantAPI *TenantAPIService
+
 	WellknownAPI *WellknownAPIService
 }
 
@@ -82,6 +86,8 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.MetadataAPI = (*MetadataAPIService)(&c.common)
 	c.OAuth2API = (*OAuth2APIService)(&c.common)
 	c.OidcAPI = (*OidcAPIService)(&c.common)
+	c.ScopeAPI = (*ScopeAPIService)(&c.common)
+	c.TenantAPI = (*TenantAPIService)(&c.common)
 	c.WellknownAPI = (*WellknownAPIService)(&c.common)
 
 	return c
@@ -22,10 +22,16 @@ var _ MappedNullable = &IntrospectedOAuth2Token{}
 
 // IntrospectedOAuth2Token Introspection contains an access token's session data as specified by [IETF RFC 7662](https://tools.ietf.org/html/rfc7662)
 type IntrospectedOAuth2Token struct {
+	// ACR is the Authentication Context Class Reference of the authentication which led to the token.
+	Acr *string `json:"acr,omitempty"`
 	// Active is a boolean indicator of whether or not the presented token is currently active.  The specifics of a token's \"active\" state will vary depending on the implementation of the authorization server and the information it keeps about its tokens, but a \"true\" value return for the \"active\" property will generally indicate that a given token has been issued by this authorization server, has not been revoked by the resource owner, and is within its given time window of validity (e.g., after its issuance time and before its expiration time).
 	Active bool `json:"active"`
+	// AMR are the Authentication Methods References of the authentication which led to the token.
+	Amr []string `json:"amr,omitempty"`
 	// Audience contains a list of the token's intended audiences.
 	Aud []string `json:"aud,omitempty"`
+	// AuthTime is the time when the End-User authentication occurred, as a UNIX timestamp.
+	AuthTime *int64 `json:"auth_time,omitempty"`
 	// ID is a client identifier for the OAuth 2.0 client that requested this token.
 	ClientId *string `json:"client_id,omitempty"`
 	// Expires at is an integer timestamp, measured in the number of seconds since January 1 1970 UTC, indicating when this token will expire.
@@ -42,6 +48,8 @@ type IntrospectedOAuth2Token struct {
 	ObfuscatedSubject *string `json:"obfuscated_subject,omitempty"`
 	// Scope is a JSON string containing a space-separated list of scopes associated with this token.
 	Scope *string `json:"scope,omitempty"`
+	// ScopeParameters are the parameters of the granted scopes which are instances of a scope template, keyed by scope.
+	ScopeParameters map[string]map[string]string `json:"scope_parameters,omitempty"`
 	// Subject of the token, as defined in JWT [RFC7519]. Usually a machine-readable identifier of the resource owner who authorized this token.
 	Sub *string `json:"sub,omitempty"`
 	// TokenType is the introspected token's type, typically `Bearer`.
@@ -72,6 +80,38 @@ func NewIntrospectedOAuth2TokenWithDefaults() *IntrospectedOAuth2Token {
 	return &this
 }
 
+// GetAcr returns the Acr field value if set, zero value otherwise.
+func (o *IntrospectedOAuth2Token) GetAcr() string {
+	if o == nil || IsNil(o.Acr) {
+		var ret string
+		return ret
+	}
+	return *o.Acr
+}
+
+// GetAcrOk returns a tuple with the Acr field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *IntrospectedOAuth2Token) GetAcrOk() (*string, bool) {
+	if o == nil || IsNil(o.Acr) {
+		return nil, false
+	}
+	return o.Acr, true
+}
+
+// HasAcr returns a boolean if a field has been set.
+func (o *IntrospectedOAuth2Token) HasAcr() bool {
+	if o != nil && !IsNil(o.Acr) {
+		return true
+	}
+
+	return false
+}
+
+// SetAcr gets a reference to the given string and assigns it to the Acr field.
+func (o *IntrospectedOAuth2Token) SetAcr(v string) {
+	o.Acr = &v
+}
+
 // GetActive returns the Active field value
 func (o *IntrospectedOAuth2Token) GetActive() bool {
 	if o == nil {
@@ -96,6 +136,38 @@ func (o *IntrospectedOAuth2Token) SetActive(v bool) {
 	o.Active = v
 }
 
+// GetAmr returns the Amr field value if set, zero value otherwise.
+func (o *IntrospectedOAuth2Token) GetAmr() []string {
+	if o == nil || IsNil(o.Amr) {
+		var ret []string
+		return ret
+	}
+	return o.Amr
+}
+
+// GetAmrOk returns a tuple with the Amr field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *IntrospectedOAuth2Token) GetAmrOk() ([]string, bool) {
+	if o == nil || IsNil(o.Amr) {
+		return nil, false
+	}
+	return o.Amr, true
+}
+
+// HasAmr returns a boolean if a field has been set.
+func (o *IntrospectedOAuth2Token) HasAmr() bool {
+	if o != nil && !IsNil(o.Amr) {
+		return true
+	}
+
+	return false
+}
+
+// SetAmr gets a reference to the given []string and assigns it to the Amr field.
+func (o *IntrospectedOAuth2Token) SetAmr(v []string) {
+	o.Amr = v
+}
+
 // GetAud returns the Aud field value if set, zero value otherwise.
 func (o *IntrospectedOAuth2Token) GetAud() []string {
 	if o == nil || IsNil(o.Aud) {
@@ -128,6 +200,38 @@ func (o *IntrospectedOAuth2Token) SetAud(v []string) {
 	o.Aud = v
 }
 
+// GetAuthTime returns the AuthTime field value if set, zero value otherwise.
+func (o *IntrospectedOAuth2Token) GetAuthTime() int64 {
+	if o == nil || IsNil(o.AuthTime) {
+		var ret int64
+		return ret
+	}
+	return *o.AuthTime
+}
+
+// GetAuthTimeOk returns a tuple with the AuthTime field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *IntrospectedOAuth2Token) GetAuthTimeOk() (*int64, bool) {
+	if o == nil || IsNil(o.AuthTime) {
+		return nil, false
+	}
+	return o.AuthTime, true
+}
+
+// HasAuthTime returns a boolean if a field has been set.
+func (o *IntrospectedOAuth2Token) HasAuthTime() bool {
+	if o != nil && !IsNil(o.AuthTime) {
+		return true
+	}
+
+	return false
+}
+
+// SetAuthTime gets a reference to the given int64 and assigns it to the AuthTime field.
+func (o *IntrospectedOAuth2Token) SetAuthTime(v int64) {
+	o.AuthTime = &v
+}
+
 // GetClientId returns the ClientId field value if set, zero value otherwise.
 func (o *IntrospectedOAuth2Token) GetClientId() string {
 	if o == nil || IsNil(o.ClientId) {
@@ -384,6 +488,38 @@ func (o *IntrospectedOAuth2Token) SetScope(v string) {
 	o.Scope = &v
 }
 
+// GetScopeParameters returns the ScopeParameters field value if set, zero value otherwise.
+func (o *IntrospectedOAuth2Token) GetScopeParameters() map[string]map[string]string {
+	if o == nil || IsNil(o.ScopeParameters) {
+		var ret map[string]map[string]string
+		return ret
+	}
+	return o.ScopeParameters
+}
+
+// GetScopeParametersOk returns a tuple with the ScopeParameters field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *IntrospectedOAuth2Token) GetScopeParametersOk() (map[string]map[string]string, bool) {
+	if o == nil || IsNil(o.ScopeParameters) {
+		return map[string]map[string]string{}, false
+	}
+	return o.ScopeParameters, true
+}
+
+// HasScopeParameters returns a boolean if a field has been set.
+func (o *IntrospectedOAuth2Token) HasScopeParameters() bool {
+	if o != nil && !IsNil(o.ScopeParameters) {
+		return true
+	}
+
+	return false
+}
+
+// SetScopeParameters gets a reference to the given map[string]map[string]string and assigns it to the ScopeParameters field.
+func (o *IntrospectedOAuth2Token) SetScopeParameters(v map[string]map[string]string) {
+	o.ScopeParameters = v
+}
+
 // GetSub returns the Sub field value if set, zero value otherwise.
 func (o *IntrospectedOAuth2Token) GetSub() string {
 	if o == nil || IsNil(o.Sub) {
@@ -522,10 +658,19 @@ func (o IntrospectedOAuth2Token) MarshalJSON() ([]byte, error) {
 
 func (o IntrospectedOAuth2Token) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Acr) {
+		toSerialize["acr"] = o.Acr
+	}
 	toSerialize["active"] = o.Active
+	if !IsNil(o.Amr) {
+		toSerialize["amr"] = o.Amr
+	}
 	if !IsNil(o.Aud) {
 		toSerialize["aud"] = o.Aud
 	}
+	if !IsNil(o.AuthTime) {
+		toSerialize["auth_time"] = o.AuthTime
+	}
 	if !IsNil(o.ClientId) {
 		toSerialize["client_id"] = o.ClientId
 	}
@@ -550,6 +695,9 @@ func (o IntrospectedOAuth2Token) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Scope) {
 		toSerialize["scope"] = o.Scope
 	}
+	if !IsNil(o.ScopeParameters) {
+		toSerialize["scope_parameters"] = o.ScopeParameters
+	}
 	if !IsNil(o.Sub) {
 		toSerialize["sub"] = o.Sub
 	}
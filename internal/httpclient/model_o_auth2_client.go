@@ -53,6 +53,8 @@ type OAuth2Client struct {
 	Contacts []string `json:"contacts,omitempty"`
 	// OAuth 2.0 Client Creation Date  CreatedAt returns the timestamp of the client's creation.
 	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// OpenID Connect Default ACR Values  Default requested Authentication Context Class Reference values, in order of preference. They are used when the authorization request does not contain the acr_values parameter.
+	DefaultAcrValues []string `json:"default_acr_values,omitempty"`
 	// Specify a time duration in milliseconds, seconds, minutes, hours.
 	DeviceAuthorizationGrantAccessTokenLifespan *string `json:"device_authorization_grant_access_token_lifespan,omitempty" validate:"regexp=^([0-9]+([.][0-9]+)?(ns|us|µs|ms|s|m|h))+$"`
 	// Specify a time duration in milliseconds, seconds, minutes, hours.
@@ -77,6 +79,8 @@ type OAuth2Client struct {
 	// OAuth 2.0 Client Logo URI  A URL string referencing the client's logo.
 	LogoUri  *string     `json:"logo_uri,omitempty"`
 	Metadata interface{} `json:"metadata,omitempty"`
+	// OpenID Connect Minimum ACR  The weakest Authentication Context Class Reference which is accepted for this client. The `acr` set when accepting the login request must be equal to it or ranked higher in `oidc.authentication_context.acr_values_supported`.
+	MinimumAcr *string `json:"minimum_acr,omitempty"`
 	// OAuth 2.0 Client Owner  Owner is a string identifying the owner of the OAuth 2.0 Client.
 	Owner *string `json:"owner,omitempty"`
 	// OAuth 2.0 Client Policy URI  PolicyURI is a URL string that points to a human-readable privacy policy document that describes how the deployment organization collects, uses, retains, and discloses personal data.
@@ -91,6 +95,8 @@ type OAuth2Client struct {
 	RefreshTokenGrantIdTokenLifespan *string `json:"refresh_token_grant_id_token_lifespan,omitempty" validate:"regexp=^([0-9]+([.][0-9]+)?(ns|us|µs|ms|s|m|h))+$"`
 	// Specify a time duration in milliseconds, seconds, minutes, hours.
 	RefreshTokenGrantRefreshTokenLifespan *string `json:"refresh_token_grant_refresh_token_lifespan,omitempty" validate:"regexp=^([0-9]+([.][0-9]+)?(ns|us|µs|ms|s|m|h))+$"`
+	// OAuth 2.0 Refresh Token Strategy  RefreshTokenStrategy is the strategy used to generate refresh tokens. Valid options are `opaque` and `jwe`. `jwe` refresh tokens are self-contained and encrypted, and only their revocation is stored in the database. Setting the strategy here overrides the global setting in `strategies.refresh_token`.
+	RefreshTokenStrategy *string `json:"refresh_token_strategy,omitempty"`
 	// OpenID Connect Dynamic Client Registration Access Token  RegistrationAccessToken can be used to update, get, or delete the OAuth2 Client. It is sent when creating a client using Dynamic Client Registration.
 	RegistrationAccessToken *string `json:"registration_access_token,omitempty"`
 	// OpenID Connect Dynamic Client Registration URL  RegistrationClientURI is the URL used to update, get, or delete the OAuth2 Client.
@@ -99,6 +105,8 @@ type OAuth2Client struct {
 	RequestObjectSigningAlg *string `json:"request_object_signing_alg,omitempty"`
 	// OpenID Connect Request URIs  Array of request_uri values that are pre-registered by the RP for use at the OP. Servers MAY cache the contents of the files referenced by these URIs and not retrieve them at the time they are used in a request. OPs can require that request_uri values used be pre-registered with the require_request_uri_registration discovery parameter.
 	RequestUris []string `json:"request_uris,omitempty"`
+	// OpenID Connect Require Auth Time  Boolean value specifying whether the auth_time Claim is REQUIRED. If true, the login request must be accepted with a known authentication time for the login and consent challenges to be redeemed.
+	RequireAuthTime *bool `json:"require_auth_time,omitempty"`
 	// OAuth 2.0 Client Response Types  An array of the OAuth 2.0 response type strings that the client can use at the authorization endpoint. Possible values are:  `code` for Authorization Code Grant. `token` or `id_token` or `token id_token` for OpenID Connect Implicit Grant (not recommended). `code token` or `code id_token` or `code token id_token` for OpenID Connect Hybrid Flow (not recommended).
 	ResponseTypes []string `json:"response_types,omitempty"`
 	// OAuth 2.0 Client Scope  Scope is a string containing a space-separated list of scope values (as described in Section 3.3 of OAuth 2.0 [RFC6749]) that the client can use when requesting access tokens.
@@ -111,6 +119,8 @@ type OAuth2Client struct {
 	SkipLogoutConsent *bool `json:"skip_logout_consent,omitempty"`
 	// OpenID Connect Subject Type  The `subject_types_supported` Discovery parameter contains a list of the supported subject_type values for this server. Valid types include `pairwise` and `public`.
 	SubjectType *string `json:"subject_type,omitempty"`
+	// OAuth 2.0 Token Claims Mapper URL  TokenClaimsMapperURL points to a Jsonnet mapper which is evaluated for every token request of this client to compute the access token `ext` claims and the ID token claims. Only `base64://`, `http://`, and `https://` URLs are allowed. Setting the mapper here overrides the global setting in `oauth2.token_claims_mapper.url`.
+	TokenClaimsMapperUrl *string `json:"token_claims_mapper_url,omitempty"`
 	// OAuth 2.0 Token Endpoint Authentication Method  Requested Client Authentication method for the Token Endpoint. The options are:  `client_secret_basic`: (default) Send `client_id` and `client_secret` as `application/x-www-form-urlencoded` encoded in the HTTP Authorization header. `client_secret_post`: Send `client_id` and `client_secret` as `application/x-www-form-urlencoded` in the HTTP body. `private_key_jwt`: Use JSON Web Tokens to authenticate the client. `none`: Used for public clients (native apps, mobile apps) which can not have secrets.
 	TokenEndpointAuthMethod *string `json:"token_endpoint_auth_method,omitempty"`
 	// OAuth 2.0 Token Endpoint Signing Algorithm  Requested Client Authentication signing algorithm for the Token Endpoint.
@@ -656,6 +666,38 @@ func (o *OAuth2Client) SetCreatedAt(v time.Time) {
 	o.CreatedAt = &v
 }
 
+// GetDefaultAcrValues returns the DefaultAcrValues field value if set, zero value otherwise.
+func (o *OAuth2Client) GetDefaultAcrValues() []string {
+	if o == nil || IsNil(o.DefaultAcrValues) {
+		var ret []string
+		return ret
+	}
+	return o.DefaultAcrValues
+}
+
+// GetDefaultAcrValuesOk returns a tuple with the DefaultAcrValues field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetDefaultAcrValuesOk() ([]string, bool) {
+	if o == nil || IsNil(o.DefaultAcrValues) {
+		return nil, false
+	}
+	return o.DefaultAcrValues, true
+}
+
+// HasDefaultAcrValues returns a boolean if a field has been set.
+func (o *OAuth2Client) HasDefaultAcrValues() bool {
+	if o != nil && !IsNil(o.DefaultAcrValues) {
+		return true
+	}
+
+	return false
+}
+
+// SetDefaultAcrValues gets a reference to the given []string and assigns it to the DefaultAcrValues field.
+func (o *OAuth2Client) SetDefaultAcrValues(v []string) {
+	o.DefaultAcrValues = v
+}
+
 // GetDeviceAuthorizationGrantAccessTokenLifespan returns the DeviceAuthorizationGrantAccessTokenLifespan field value if set, zero value otherwise.
 func (o *OAuth2Client) GetDeviceAuthorizationGrantAccessTokenLifespan() string {
 	if o == nil || IsNil(o.DeviceAuthorizationGrantAccessTokenLifespan) {
@@ -1073,6 +1115,38 @@ func (o *OAuth2Client) SetMetadata(v interface{}) {
 	o.Metadata = v
 }
 
+// GetMinimumAcr returns the MinimumAcr field value if set, zero value otherwise.
+func (o *OAuth2Client) GetMinimumAcr() string {
+	if o == nil || IsNil(o.MinimumAcr) {
+		var ret string
+		return ret
+	}
+	return *o.MinimumAcr
+}
+
+// GetMinimumAcrOk returns a tuple with the MinimumAcr field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetMinimumAcrOk() (*string, bool) {
+	if o == nil || IsNil(o.MinimumAcr) {
+		return nil, false
+	}
+	return o.MinimumAcr, true
+}
+
+// HasMinimumAcr returns a boolean if a field has been set.
+func (o *OAuth2Client) HasMinimumAcr() bool {
+	if o != nil && !IsNil(o.MinimumAcr) {
+		return true
+	}
+
+	return false
+}
+
+// SetMinimumAcr gets a reference to the given string and assigns it to the MinimumAcr field.
+func (o *OAuth2Client) SetMinimumAcr(v string) {
+	o.MinimumAcr = &v
+}
+
 // GetOwner returns the Owner field value if set, zero value otherwise.
 func (o *OAuth2Client) GetOwner() string {
 	if o == nil || IsNil(o.Owner) {
@@ -1297,6 +1371,38 @@ func (o *OAuth2Client) SetRefreshTokenGrantRefreshTokenLifespan(v string) {
 	o.RefreshTokenGrantRefreshTokenLifespan = &v
 }
 
+// GetRefreshTokenStrategy returns the RefreshTokenStrategy field value if set, zero value otherwise.
+func (o *OAuth2Client) GetRefreshTokenStrategy() string {
+	if o == nil || IsNil(o.RefreshTokenStrategy) {
+		var ret string
+		return ret
+	}
+	return *o.RefreshTokenStrategy
+}
+
+// GetRefreshTokenStrategyOk returns a tuple with the RefreshTokenStrategy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetRefreshTokenStrategyOk() (*string, bool) {
+	if o == nil || IsNil(o.RefreshTokenStrategy) {
+		return nil, false
+	}
+	return o.RefreshTokenStrategy, true
+}
+
+// HasRefreshTokenStrategy returns a boolean if a field has been set.
+func (o *OAuth2Client) HasRefreshTokenStrategy() bool {
+	if o != nil && !IsNil(o.RefreshTokenStrategy) {
+		return true
+	}
+
+	return false
+}
+
+// SetRefreshTokenStrategy gets a reference to the given string and assigns it to the RefreshTokenStrategy field.
+func (o *OAuth2Client) SetRefreshTokenStrategy(v string) {
+	o.RefreshTokenStrategy = &v
+}
+
 // GetRegistrationAccessToken returns the RegistrationAccessToken field value if set, zero value otherwise.
 func (o *OAuth2Client) GetRegistrationAccessToken() string {
 	if o == nil || IsNil(o.RegistrationAccessToken) {
@@ -1425,6 +1531,38 @@ func (o *OAuth2Client) SetRequestUris(v []string) {
 	o.RequestUris = v
 }
 
+// GetRequireAuthTime returns the RequireAuthTime field value if set, zero value otherwise.
+func (o *OAuth2Client) GetRequireAuthTime() bool {
+	if o == nil || IsNil(o.RequireAuthTime) {
+		var ret bool
+		return ret
+	}
+	return *o.RequireAuthTime
+}
+
+// GetRequireAuthTimeOk returns a tuple with the RequireAuthTime field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetRequireAuthTimeOk() (*bool, bool) {
+	if o == nil || IsNil(o.RequireAuthTime) {
+		return nil, false
+	}
+	return o.RequireAuthTime, true
+}
+
+// HasRequireAuthTime returns a boolean if a field has been set.
+func (o *OAuth2Client) HasRequireAuthTime() bool {
+	if o != nil && !IsNil(o.RequireAuthTime) {
+		return true
+	}
+
+	return false
+}
+
+// SetRequireAuthTime gets a reference to the given bool and assigns it to the RequireAuthTime field.
+func (o *OAuth2Client) SetRequireAuthTime(v bool) {
+	o.RequireAuthTime = &v
+}
+
 // GetResponseTypes returns the ResponseTypes field value if set, zero value otherwise.
 func (o *OAuth2Client) GetResponseTypes() []string {
 	if o == nil || IsNil(o.ResponseTypes) {
@@ -1617,6 +1755,38 @@ func (o *OAuth2Client) SetSubjectType(v string) {
 	o.SubjectType = &v
 }
 
+// GetTokenClaimsMapperUrl returns the TokenClaimsMapperUrl field value if set, zero value otherwise.
+func (o *OAuth2Client) GetTokenClaimsMapperUrl() string {
+	if o == nil || IsNil(o.TokenClaimsMapperUrl) {
+		var ret string
+		return ret
+	}
+	return *o.TokenClaimsMapperUrl
+}
+
+// GetTokenClaimsMapperUrlOk returns a tuple with the TokenClaimsMapperUrl field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2Client) GetTokenClaimsMapperUrlOk() (*string, bool) {
+	if o == nil || IsNil(o.TokenClaimsMapperUrl) {
+		return nil, false
+	}
+	return o.TokenClaimsMapperUrl, true
+}
+
+// HasTokenClaimsMapperUrl returns a boolean if a field has been set.
+func (o *OAuth2Client) HasTokenClaimsMapperUrl() bool {
+	if o != nil && !IsNil(o.TokenClaimsMapperUrl) {
+		return true
+	}
+
+	return false
+}
+
+// SetTokenClaimsMapperUrl gets a reference to the given string and assigns it to the TokenClaimsMapperUrl field.
+func (o *OAuth2Client) SetTokenClaimsMapperUrl(v string) {
+	o.TokenClaimsMapperUrl = &v
+}
+
 // GetTokenEndpointAuthMethod returns the TokenEndpointAuthMethod field value if set, zero value otherwise.
 func (o *OAuth2Client) GetTokenEndpointAuthMethod() string {
 	if o == nil || IsNil(o.TokenEndpointAuthMethod) {
@@ -1835,6 +2005,9 @@ func (o OAuth2Client) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.CreatedAt) {
 		toSerialize["created_at"] = o.CreatedAt
 	}
+	if !IsNil(o.DefaultAcrValues) {
+		toSerialize["default_acr_values"] = o.DefaultAcrValues
+	}
 	if !IsNil(o.DeviceAuthorizationGrantAccessTokenLifespan) {
 		toSerialize["device_authorization_grant_access_token_lifespan"] = o.DeviceAuthorizationGrantAccessTokenLifespan
 	}
@@ -1874,6 +2047,9 @@ func (o OAuth2Client) ToMap() (map[string]interface{}, error) {
 	if o.Metadata != nil {
 		toSerialize["metadata"] = o.Metadata
 	}
+	if !IsNil(o.MinimumAcr) {
+		toSerialize["minimum_acr"] = o.MinimumAcr
+	}
 	if !IsNil(o.Owner) {
 		toSerialize["owner"] = o.Owner
 	}
@@ -1895,6 +2071,9 @@ func (o OAuth2Client) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.RefreshTokenGrantRefreshTokenLifespan) {
 		toSerialize["refresh_token_grant_refresh_token_lifespan"] = o.RefreshTokenGrantRefreshTokenLifespan
 	}
+	if !IsNil(o.RefreshTokenStrategy) {
+		toSerialize["refresh_token_strategy"] = o.RefreshTokenStrategy
+	}
 	if !IsNil(o.RegistrationAccessToken) {
 		toSerialize["registration_access_token"] = o.RegistrationAccessToken
 	}
@@ -1907,6 +2086,9 @@ func (o OAuth2Client) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.RequestUris) {
 		toSerialize["request_uris"] = o.RequestUris
 	}
+	if !IsNil(o.RequireAuthTime) {
+		toSerialize["require_auth_time"] = o.RequireAuthTime
+	}
 	if !IsNil(o.ResponseTypes) {
 		toSerialize["response_types"] = o.ResponseTypes
 	}
@@ -1925,6 +2107,9 @@ func (o OAuth2Client) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.SubjectType) {
 		toSerialize["subject_type"] = o.SubjectType
 	}
+	if !IsNil(o.TokenClaimsMapperUrl) {
+		toSerialize["token_claims_mapper_url"] = o.TokenClaimsMapperUrl
+	}
 	if !IsNil(o.TokenEndpointAuthMethod) {
 		toSerialize["token_endpoint_auth_method"] = o.TokenEndpointAuthMethod
 	}
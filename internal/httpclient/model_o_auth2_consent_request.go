@@ -43,6 +43,10 @@ type OAuth2ConsentRequest struct {
 	RequestedAccessTokenAudience []string `json:"requested_access_token_audience,omitempty"`
 	// RequestedScope contains the OAuth 2.0 Scope requested by the OAuth 2.0 Client.
 	RequestedScope []string `json:"requested_scope,omitempty"`
+	// RequestedScopeMetadata contains the scope registry entries of the requested scopes. Requested scopes which are not registered are omitted.
+	RequestedScopeMetadata []Scope `json:"requested_scope_metadata,omitempty"`
+	// RequestedScopeParameters contains the parameters of the requested scopes which are instances of a scope template, keyed by scope.
+	RequestedScopeParameters map[string]map[string]string `json:"requested_scope_parameters,omitempty"`
 	// Skip, if true, implies that the client has requested the same scopes from the same user previously. If true, you must not ask the user to grant the requested scopes. You must however either allow or deny the consent request using the usual API call.
 	Skip *bool `json:"skip,omitempty"`
 	// Subject is the user ID of the end-user that authenticated. Now, that end user needs to grant or deny the scope requested by the OAuth 2.0 client.
@@ -446,6 +450,70 @@ func (o *OAuth2ConsentRequest) SetRequestedScope(v []string) {
 	o.RequestedScope = v
 }
 
+// GetRequestedScopeMetadata returns the RequestedScopeMetadata field value if set, zero value otherwise.
+func (o *OAuth2ConsentRequest) GetRequestedScopeMetadata() []Scope {
+	if o == nil || IsNil(o.RequestedScopeMetadata) {
+		var ret []Scope
+		return ret
+	}
+	return o.RequestedScopeMetadata
+}
+
+// GetRequestedScopeMetadataOk returns a tuple with the RequestedScopeMetadata field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2ConsentRequest) GetRequestedScopeMetadataOk() ([]Scope, bool) {
+	if o == nil || IsNil(o.RequestedScopeMetadata) {
+		return nil, false
+	}
+	return o.RequestedScopeMetadata, true
+}
+
+// HasRequestedScopeMetadata returns a boolean if a field has been set.
+func (o *OAuth2ConsentRequest) HasRequestedScopeMetadata() bool {
+	if o != nil && !IsNil(o.RequestedScopeMetadata) {
+		return true
+	}
+
+	return false
+}
+
+// SetRequestedScopeMetadata gets a reference to the given []Scope and assigns it to the RequestedScopeMetadata field.
+func (o *OAuth2ConsentRequest) SetRequestedScopeMetadata(v []Scope) {
+	o.RequestedScopeMetadata = v
+}
+
+// GetRequestedScopeParameters returns the RequestedScopeParameters field value if set, zero value otherwise.
+func (o *OAuth2ConsentRequest) GetRequestedScopeParameters() map[string]map[string]string {
+	if o == nil || IsNil(o.RequestedScopeParameters) {
+		var ret map[string]map[string]string
+		return ret
+	}
+	return o.RequestedScopeParameters
+}
+
+// GetRequestedScopeParametersOk returns a tuple with the RequestedScopeParameters field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2ConsentRequest) GetRequestedScopeParametersOk() (map[string]map[string]string, bool) {
+	if o == nil || IsNil(o.RequestedScopeParameters) {
+		return map[string]map[string]string{}, false
+	}
+	return o.RequestedScopeParameters, true
+}
+
+// HasRequestedScopeParameters returns a boolean if a field has been set.
+func (o *OAuth2ConsentRequest) HasRequestedScopeParameters() bool {
+	if o != nil && !IsNil(o.RequestedScopeParameters) {
+		return true
+	}
+
+	return false
+}
+
+// SetRequestedScopeParameters gets a reference to the given map[string]map[string]string and assigns it to the RequestedScopeParameters field.
+func (o *OAuth2ConsentRequest) SetRequestedScopeParameters(v map[string]map[string]string) {
+	o.RequestedScopeParameters = v
+}
+
 // GetSkip returns the Skip field value if set, zero value otherwise.
 func (o *OAuth2ConsentRequest) GetSkip() bool {
 	if o == nil || IsNil(o.Skip) {
@@ -554,6 +622,12 @@ func (o OAuth2ConsentRequest) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.RequestedScope) {
 		toSerialize["requested_scope"] = o.RequestedScope
 	}
+	if !IsNil(o.RequestedScopeMetadata) {
+		toSerialize["requested_scope_metadata"] = o.RequestedScopeMetadata
+	}
+	if !IsNil(o.RequestedScopeParameters) {
+		toSerialize["requested_scope_parameters"] = o.RequestedScopeParameters
+	}
 	if !IsNil(o.Skip) {
 		toSerialize["skip"] = o.Skip
 	}
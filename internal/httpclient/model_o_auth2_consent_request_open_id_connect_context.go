@@ -21,7 +21,8 @@ var _ MappedNullable = &OAuth2ConsentRequestOpenIDConnectContext{}
 // OAuth2ConsentRequestOpenIDConnectContext struct for OAuth2ConsentRequestOpenIDConnectContext
 type OAuth2ConsentRequestOpenIDConnectContext struct {
 	// ACRValues is the Authentication AuthorizationContext Class Reference requested in the OAuth 2.0 Authorization request. It is a parameter defined by OpenID Connect and expresses which level of authentication (e.g. 2FA) is required.  OpenID Connect defines it as follows: > Requested Authentication AuthorizationContext Class Reference values. Space-separated string that specifies the acr values that the Authorization Server is being requested to use for processing this Authentication Request, with the values appearing in order of preference. The Authentication AuthorizationContext Class satisfied by the authentication performed is returned as the acr Claim Value, as specified in Section 2. The acr Claim is requested as a Voluntary Claim by this parameter.
-	AcrValues []string `json:"acr_values,omitempty"`
+	AcrValues []string                    `json:"acr_values,omitempty"`
+	Claims    *OAuth2ConsentRequestClaims `json:"claims,omitempty"`
 	// Display is a string value that specifies how the Authorization Server displays the authentication and consent user interface pages to the End-User. The defined values are: page: The Authorization Server SHOULD display the authentication and consent UI consistent with a full User Agent page view. If the display parameter is not specified, this is the default display mode. popup: The Authorization Server SHOULD display the authentication and consent UI consistent with a popup User Agent window. The popup User Agent window should be of an appropriate size for a login-focused dialog and should not obscure the entire window that it is popping up over. touch: The Authorization Server SHOULD display the authentication and consent UI consistent with a device that leverages a touch interface. wap: The Authorization Server SHOULD display the authentication and consent UI consistent with a \"feature phone\" type display.  The Authorization Server MAY also attempt to detect the capabilities of the User Agent and present an appropriate display.
 	Display *string `json:"display,omitempty"`
 	// IDTokenHintClaims are the claims of the ID Token previously issued by the Authorization Server being passed as a hint about the End-User's current or past authenticated session with the Client.
@@ -81,6 +82,38 @@ func (o *OAuth2ConsentRequestOpenIDConnectContext) SetAcrValues(v []string) {
 	o.AcrValues = v
 }
 
+// GetClaims returns the Claims field value if set, zero value otherwise.
+func (o *OAuth2ConsentRequestOpenIDConnectContext) GetClaims() OAuth2ConsentRequestClaims {
+	if o == nil || IsNil(o.Claims) {
+		var ret OAuth2ConsentRequestClaims
+		return ret
+	}
+	return *o.Claims
+}
+
+// GetClaimsOk returns a tuple with the Claims field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OAuth2ConsentRequestOpenIDConnectContext) GetClaimsOk() (*OAuth2ConsentRequestClaims, bool) {
+	if o == nil || IsNil(o.Claims) {
+		return nil, false
+	}
+	return o.Claims, true
+}
+
+// HasClaims returns a boolean if a field has been set.
+func (o *OAuth2ConsentRequestOpenIDConnectContext) HasClaims() bool {
+	if o != nil && !IsNil(o.Claims) {
+		return true
+	}
+
+	return false
+}
+
+// SetClaims gets a reference to the given OAuth2ConsentRequestClaims and assigns it to the Claims field.
+func (o *OAuth2ConsentRequestOpenIDConnectContext) SetClaims(v OAuth2ConsentRequestClaims) {
+	o.Claims = &v
+}
+
 // GetDisplay returns the Display field value if set, zero value otherwise.
 func (o *OAuth2ConsentRequestOpenIDConnectContext) GetDisplay() string {
 	if o == nil || IsNil(o.Display) {
@@ -222,6 +255,9 @@ func (o OAuth2ConsentRequestOpenIDConnectContext) ToMap() (map[string]interface{
 	if !IsNil(o.AcrValues) {
 		toSerialize["acr_values"] = o.AcrValues
 	}
+	if !IsNil(o.Claims) {
+		toSerialize["claims"] = o.Claims
+	}
 	if !IsNil(o.Display) {
 		toSerialize["display"] = o.Display
 	}
@@ -22,6 +22,8 @@ var _ MappedNullable = &OidcConfiguration{}
 
 // OidcConfiguration Includes links to several endpoints (for example `/oauth2/token`) and exposes information on supported signature algorithms among others.
 type OidcConfiguration struct {
+	// OpenID Connect Supported Authentication Context Class References  JSON array containing a list of the Authentication Context Class References that this OP supports.
+	AcrValuesSupported []string `json:"acr_values_supported,omitempty"`
 	// OAuth 2.0 Authorization Endpoint URL
 	AuthorizationEndpoint string `json:"authorization_endpoint"`
 	// OpenID Connect Back-Channel Logout Session Required  Boolean value specifying whether the OP can pass a sid (session ID) Claim in the Logout Token to identify the RP session with the OP. If supported, the sid Claim is also included in ID Tokens issued by the OP
@@ -117,6 +119,38 @@ func NewOidcConfigurationWithDefaults() *OidcConfiguration {
 	return &this
 }
 
+// GetAcrValuesSupported returns the AcrValuesSupported field value if set, zero value otherwise.
+func (o *OidcConfiguration) GetAcrValuesSupported() []string {
+	if o == nil || IsNil(o.AcrValuesSupported) {
+		var ret []string
+		return ret
+	}
+	return o.AcrValuesSupported
+}
+
+// GetAcrValuesSupportedOk returns a tuple with the AcrValuesSupported field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *OidcConfiguration) GetAcrValuesSupportedOk() ([]string, bool) {
+	if o == nil || IsNil(o.AcrValuesSupported) {
+		return nil, false
+	}
+	return o.AcrValuesSupported, true
+}
+
+// HasAcrValuesSupported returns a boolean if a field has been set.
+func (o *OidcConfiguration) HasAcrValuesSupported() bool {
+	if o != nil && !IsNil(o.AcrValuesSupported) {
+		return true
+	}
+
+	return false
+}
+
+// SetAcrValuesSupported gets a reference to the given []string and assigns it to the AcrValuesSupported field.
+func (o *OidcConfiguration) SetAcrValuesSupported(v []string) {
+	o.AcrValuesSupported = v
+}
+
 // GetAuthorizationEndpoint returns the AuthorizationEndpoint field value
 func (o *OidcConfiguration) GetAuthorizationEndpoint() string {
 	if o == nil {
@@ -1071,6 +1105,9 @@ func (o OidcConfiguration) MarshalJSON() ([]byte, error) {
 
 func (o OidcConfiguration) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AcrValuesSupported) {
+		toSerialize["acr_values_supported"] = o.AcrValuesSupported
+	}
 	toSerialize["authorization_endpoint"] = o.AuthorizationEndpoint
 	if !IsNil(o.BackchannelLogoutSessionSupported) {
 		toSerialize["backchannel_logout_session_supported"] = o.BackchannelLogoutSessionSupported
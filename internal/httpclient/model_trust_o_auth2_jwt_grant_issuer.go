@@ -25,11 +25,19 @@ var _ MappedNullable = &TrustOAuth2JwtGrantIssuer{}
 type TrustOAuth2JwtGrantIssuer struct {
 	// The \"allow_any_subject\" indicates that the issuer is allowed to have any principal as the subject of the JWT.
 	AllowAnySubject *bool `json:"allow_any_subject,omitempty"`
+	// The \"claim_conditions\" map claim paths of the JWT assertion to the values the claim may have. Nested claims are separated by dots, and a claim containing a list matches if one of its elements is allowed. Assertions not satisfying all conditions are rejected.
+	ClaimConditions *map[string][]string `json:"claim_conditions,omitempty"`
+	// The \"claims_mapper\" is a Jsonnet snippet which receives the claims of the JWT assertion as `std.extVar('ctx').claims` and returns an object with the optional fields \"subject\", \"scope\" and \"ext\". The \"subject\" replaces the subject of the access token, the \"scope\" restricts the scopes which may be requested, and \"ext\" is added to the access token claims. The mapper may reject the assertion using `error`.
+	ClaimsMapper *string `json:"claims_mapper,omitempty"`
 	// The \"expires_at\" indicates, when grant will expire, so we will reject assertion from \"issuer\" targeting \"subject\".
 	ExpiresAt time.Time `json:"expires_at"`
 	// The \"issuer\" identifies the principal that issued the JWT assertion (same as \"iss\" claim in JWT).
-	Issuer string     `json:"issuer"`
-	Jwk    JsonWebKey `json:"jwk"`
+	Issuer string      `json:"issuer"`
+	Jwk    *JsonWebKey `json:"jwk,omitempty"`
+	// The \"jwks_uri\" is the URL of the JSON Web Key Set published by \"issuer\". Its keys are used to check the JWT assertion signature and are refreshed when the issuer rotates them. Can not be used together with \"jwk\" or \"oidc_discovery\".
+	JwksUri *string `json:"jwks_uri,omitempty"`
+	// The \"oidc_discovery\" indicates that the JSON Web Key Set URL is discovered from the OpenID Connect Discovery document of \"issuer\" when the trust relationship is created. Can not be used together with \"jwk\" or \"jwks_uri\".
+	OidcDiscovery *bool `json:"oidc_discovery,omitempty"`
 	// The \"scope\" contains list of scope values (as described in Section 3.3 of OAuth 2.0 [RFC6749])
 	Scope []string `json:"scope"`
 	// The \"subject\" identifies the principal that is the subject of the JWT.
@@ -42,11 +50,10 @@ type _TrustOAuth2JwtGrantIssuer TrustOAuth2JwtGrantIssuer
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewTrustOAuth2JwtGrantIssuer(expiresAt time.Time, issuer string, jwk JsonWebKey, scope []string) *TrustOAuth2JwtGrantIssuer {
+func NewTrustOAuth2JwtGrantIssuer(expiresAt time.Time, issuer string, scope []string) *TrustOAuth2JwtGrantIssuer {
 	this := TrustOAuth2JwtGrantIssuer{}
 	this.ExpiresAt = expiresAt
 	this.Issuer = issuer
-	this.Jwk = jwk
 	this.Scope = scope
 	return &this
 }
@@ -91,6 +98,70 @@ func (o *TrustOAuth2JwtGrantIssuer) SetAllowAnySubject(v bool) {
 	o.AllowAnySubject = &v
 }
 
+// GetClaimConditions returns the ClaimConditions field value if set, zero value otherwise.
+func (o *TrustOAuth2JwtGrantIssuer) GetClaimConditions() map[string][]string {
+	if o == nil || IsNil(o.ClaimConditions) {
+		var ret map[string][]string
+		return ret
+	}
+	return *o.ClaimConditions
+}
+
+// GetClaimConditionsOk returns a tuple with the ClaimConditions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustOAuth2JwtGrantIssuer) GetClaimConditionsOk() (*map[string][]string, bool) {
+	if o == nil || IsNil(o.ClaimConditions) {
+		return nil, false
+	}
+	return o.ClaimConditions, true
+}
+
+// HasClaimConditions returns a boolean if a field has been set.
+func (o *TrustOAuth2JwtGrantIssuer) HasClaimConditions() bool {
+	if o != nil && !IsNil(o.ClaimConditions) {
+		return true
+	}
+
+	return false
+}
+
+// SetClaimConditions gets a reference to the given map[string][]string and assigns it to the ClaimConditions field.
+func (o *TrustOAuth2JwtGrantIssuer) SetClaimConditions(v map[string][]string) {
+	o.ClaimConditions = &v
+}
+
+// GetClaimsMapper returns the ClaimsMapper field value if set, zero value otherwise.
+func (o *TrustOAuth2JwtGrantIssuer) GetClaimsMapper() string {
+	if o == nil || IsNil(o.ClaimsMapper) {
+		var ret string
+		return ret
+	}
+	return *o.ClaimsMapper
+}
+
+// GetClaimsMapperOk returns a tuple with the ClaimsMapper field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustOAuth2JwtGrantIssuer) GetClaimsMapperOk() (*string, bool) {
+	if o == nil || IsNil(o.ClaimsMapper) {
+		return nil, false
+	}
+	return o.ClaimsMapper, true
+}
+
+// HasClaimsMapper returns a boolean if a field has been set.
+func (o *TrustOAuth2JwtGrantIssuer) HasClaimsMapper() bool {
+	if o != nil && !IsNil(o.ClaimsMapper) {
+		return true
+	}
+
+	return false
+}
+
+// SetClaimsMapper gets a reference to the given string and assigns it to the ClaimsMapper field.
+func (o *TrustOAuth2JwtGrantIssuer) SetClaimsMapper(v string) {
+	o.ClaimsMapper = &v
+}
+
 // GetExpiresAt returns the ExpiresAt field value
 func (o *TrustOAuth2JwtGrantIssuer) GetExpiresAt() time.Time {
 	if o == nil {
@@ -139,28 +210,100 @@ func (o *TrustOAuth2JwtGrantIssuer) SetIssuer(v string) {
 	o.Issuer = v
 }
 
-// GetJwk returns the Jwk field value
+// GetJwk returns the Jwk field value if set, zero value otherwise.
 func (o *TrustOAuth2JwtGrantIssuer) GetJwk() JsonWebKey {
-	if o == nil {
+	if o == nil || IsNil(o.Jwk) {
 		var ret JsonWebKey
 		return ret
 	}
-
-	return o.Jwk
+	return *o.Jwk
 }
 
-// GetJwkOk returns a tuple with the Jwk field value
+// GetJwkOk returns a tuple with the Jwk field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *TrustOAuth2JwtGrantIssuer) GetJwkOk() (*JsonWebKey, bool) {
-	if o == nil {
+	if o == nil || IsNil(o.Jwk) {
 		return nil, false
 	}
-	return &o.Jwk, true
+	return o.Jwk, true
 }
 
-// SetJwk sets field value
+// HasJwk returns a boolean if a field has been set.
+func (o *TrustOAuth2JwtGrantIssuer) HasJwk() bool {
+	if o != nil && !IsNil(o.Jwk) {
+		return true
+	}
+
+	return false
+}
+
+// SetJwk gets a reference to the given JsonWebKey and assigns it to the Jwk field.
 func (o *TrustOAuth2JwtGrantIssuer) SetJwk(v JsonWebKey) {
-	o.Jwk = v
+	o.Jwk = &v
+}
+
+// GetJwksUri returns the JwksUri field value if set, zero value otherwise.
+func (o *TrustOAuth2JwtGrantIssuer) GetJwksUri() string {
+	if o == nil || IsNil(o.JwksUri) {
+		var ret string
+		return ret
+	}
+	return *o.JwksUri
+}
+
+// GetJwksUriOk returns a tuple with the JwksUri field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustOAuth2JwtGrantIssuer) GetJwksUriOk() (*string, bool) {
+	if o == nil || IsNil(o.JwksUri) {
+		return nil, false
+	}
+	return o.JwksUri, true
+}
+
+// HasJwksUri returns a boolean if a field has been set.
+func (o *TrustOAuth2JwtGrantIssuer) HasJwksUri() bool {
+	if o != nil && !IsNil(o.JwksUri) {
+		return true
+	}
+
+	return false
+}
+
+// SetJwksUri gets a reference to the given string and assigns it to the JwksUri field.
+func (o *TrustOAuth2JwtGrantIssuer) SetJwksUri(v string) {
+	o.JwksUri = &v
+}
+
+// GetOidcDiscovery returns the OidcDiscovery field value if set, zero value otherwise.
+func (o *TrustOAuth2JwtGrantIssuer) GetOidcDiscovery() bool {
+	if o == nil || IsNil(o.OidcDiscovery) {
+		var ret bool
+		return ret
+	}
+	return *o.OidcDiscovery
+}
+
+// GetOidcDiscoveryOk returns a tuple with the OidcDiscovery field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustOAuth2JwtGrantIssuer) GetOidcDiscoveryOk() (*bool, bool) {
+	if o == nil || IsNil(o.OidcDiscovery) {
+		return nil, false
+	}
+	return o.OidcDiscovery, true
+}
+
+// HasOidcDiscovery returns a boolean if a field has been set.
+func (o *TrustOAuth2JwtGrantIssuer) HasOidcDiscovery() bool {
+	if o != nil && !IsNil(o.OidcDiscovery) {
+		return true
+	}
+
+	return false
+}
+
+// SetOidcDiscovery gets a reference to the given bool and assigns it to the OidcDiscovery field.
+func (o *TrustOAuth2JwtGrantIssuer) SetOidcDiscovery(v bool) {
+	o.OidcDiscovery = &v
 }
 
 // GetScope returns the Scope field value
@@ -232,9 +375,23 @@ func (o TrustOAuth2JwtGrantIssuer) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.AllowAnySubject) {
 		toSerialize["allow_any_subject"] = o.AllowAnySubject
 	}
+	if !IsNil(o.ClaimConditions) {
+		toSerialize["claim_conditions"] = o.ClaimConditions
+	}
+	if !IsNil(o.ClaimsMapper) {
+		toSerialize["claims_mapper"] = o.ClaimsMapper
+	}
 	toSerialize["expires_at"] = o.ExpiresAt
 	toSerialize["issuer"] = o.Issuer
-	toSerialize["jwk"] = o.Jwk
+	if !IsNil(o.Jwk) {
+		toSerialize["jwk"] = o.Jwk
+	}
+	if !IsNil(o.JwksUri) {
+		toSerialize["jwks_uri"] = o.JwksUri
+	}
+	if !IsNil(o.OidcDiscovery) {
+		toSerialize["oidc_discovery"] = o.OidcDiscovery
+	}
 	toSerialize["scope"] = o.Scope
 	if !IsNil(o.Subject) {
 		toSerialize["subject"] = o.Subject
@@ -249,7 +406,6 @@ func (o *TrustOAuth2JwtGrantIssuer) UnmarshalJSON(data []byte) (err error) {
 	requiredProperties := []string{
 		"expires_at",
 		"issuer",
-		"jwk",
 		"scope",
 	}
 
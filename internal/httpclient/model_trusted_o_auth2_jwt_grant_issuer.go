@@ -23,13 +23,19 @@ var _ MappedNullable = &TrustedOAuth2JwtGrantIssuer{}
 type TrustedOAuth2JwtGrantIssuer struct {
 	// The \"allow_any_subject\" indicates that the issuer is allowed to have any principal as the subject of the JWT.
 	AllowAnySubject *bool `json:"allow_any_subject,omitempty"`
+	// The \"claim_conditions\" map claim paths of the JWT assertion to the values the claim may have.
+	ClaimConditions *map[string][]string `json:"claim_conditions,omitempty"`
+	// The \"claims_mapper\" is a Jsonnet snippet deriving the subject, scope and ext claims of the access token.
+	ClaimsMapper *string `json:"claims_mapper,omitempty"`
 	// The \"created_at\" indicates, when grant was created.
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	// The \"expires_at\" indicates, when grant will expire, so we will reject assertion from \"issuer\" targeting \"subject\".
 	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 	Id        *string    `json:"id,omitempty"`
 	// The \"issuer\" identifies the principal that issued the JWT assertion (same as \"iss\" claim in JWT).
-	Issuer    *string                          `json:"issuer,omitempty"`
+	Issuer *string `json:"issuer,omitempty"`
+	// The \"jwks_uri\" is the URL of the JSON Web Key Set published by \"issuer\", if the trust relationship is not backed by \"public_key\".
+	JwksUri   *string                          `json:"jwks_uri,omitempty"`
 	PublicKey *TrustedOAuth2JwtGrantJsonWebKey `json:"public_key,omitempty"`
 	// The \"scope\" contains list of scope values (as described in Section 3.3 of OAuth 2.0 [RFC6749])
 	Scope []string `json:"scope,omitempty"`
@@ -86,6 +92,70 @@ func (o *TrustedOAuth2JwtGrantIssuer) SetAllowAnySubject(v bool) {
 	o.AllowAnySubject = &v
 }
 
+// GetClaimConditions returns the ClaimConditions field value if set, zero value otherwise.
+func (o *TrustedOAuth2JwtGrantIssuer) GetClaimConditions() map[string][]string {
+	if o == nil || IsNil(o.ClaimConditions) {
+		var ret map[string][]string
+		return ret
+	}
+	return *o.ClaimConditions
+}
+
+// GetClaimConditionsOk returns a tuple with the ClaimConditions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) GetClaimConditionsOk() (*map[string][]string, bool) {
+	if o == nil || IsNil(o.ClaimConditions) {
+		return nil, false
+	}
+	return o.ClaimConditions, true
+}
+
+// HasClaimConditions returns a boolean if a field has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) HasClaimConditions() bool {
+	if o != nil && !IsNil(o.ClaimConditions) {
+		return true
+	}
+
+	return false
+}
+
+// SetClaimConditions gets a reference to the given map[string][]string and assigns it to the ClaimConditions field.
+func (o *TrustedOAuth2JwtGrantIssuer) SetClaimConditions(v map[string][]string) {
+	o.ClaimConditions = &v
+}
+
+// GetClaimsMapper returns the ClaimsMapper field value if set, zero value otherwise.
+func (o *TrustedOAuth2JwtGrantIssuer) GetClaimsMapper() string {
+	if o == nil || IsNil(o.ClaimsMapper) {
+		var ret string
+		return ret
+	}
+	return *o.ClaimsMapper
+}
+
+// GetClaimsMapperOk returns a tuple with the ClaimsMapper field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) GetClaimsMapperOk() (*string, bool) {
+	if o == nil || IsNil(o.ClaimsMapper) {
+		return nil, false
+	}
+	return o.ClaimsMapper, true
+}
+
+// HasClaimsMapper returns a boolean if a field has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) HasClaimsMapper() bool {
+	if o != nil && !IsNil(o.ClaimsMapper) {
+		return true
+	}
+
+	return false
+}
+
+// SetClaimsMapper gets a reference to the given string and assigns it to the ClaimsMapper field.
+func (o *TrustedOAuth2JwtGrantIssuer) SetClaimsMapper(v string) {
+	o.ClaimsMapper = &v
+}
+
 // GetCreatedAt returns the CreatedAt field value if set, zero value otherwise.
 func (o *TrustedOAuth2JwtGrantIssuer) GetCreatedAt() time.Time {
 	if o == nil || IsNil(o.CreatedAt) {
@@ -214,6 +284,38 @@ func (o *TrustedOAuth2JwtGrantIssuer) SetIssuer(v string) {
 	o.Issuer = &v
 }
 
+// GetJwksUri returns the JwksUri field value if set, zero value otherwise.
+func (o *TrustedOAuth2JwtGrantIssuer) GetJwksUri() string {
+	if o == nil || IsNil(o.JwksUri) {
+		var ret string
+		return ret
+	}
+	return *o.JwksUri
+}
+
+// GetJwksUriOk returns a tuple with the JwksUri field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) GetJwksUriOk() (*string, bool) {
+	if o == nil || IsNil(o.JwksUri) {
+		return nil, false
+	}
+	return o.JwksUri, true
+}
+
+// HasJwksUri returns a boolean if a field has been set.
+func (o *TrustedOAuth2JwtGrantIssuer) HasJwksUri() bool {
+	if o != nil && !IsNil(o.JwksUri) {
+		return true
+	}
+
+	return false
+}
+
+// SetJwksUri gets a reference to the given string and assigns it to the JwksUri field.
+func (o *TrustedOAuth2JwtGrantIssuer) SetJwksUri(v string) {
+	o.JwksUri = &v
+}
+
 // GetPublicKey returns the PublicKey field value if set, zero value otherwise.
 func (o *TrustedOAuth2JwtGrantIssuer) GetPublicKey() TrustedOAuth2JwtGrantJsonWebKey {
 	if o == nil || IsNil(o.PublicKey) {
@@ -323,6 +425,12 @@ func (o TrustedOAuth2JwtGrantIssuer) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.AllowAnySubject) {
 		toSerialize["allow_any_subject"] = o.AllowAnySubject
 	}
+	if !IsNil(o.ClaimConditions) {
+		toSerialize["claim_conditions"] = o.ClaimConditions
+	}
+	if !IsNil(o.ClaimsMapper) {
+		toSerialize["claims_mapper"] = o.ClaimsMapper
+	}
 	if !IsNil(o.CreatedAt) {
 		toSerialize["created_at"] = o.CreatedAt
 	}
@@ -335,6 +443,9 @@ func (o TrustedOAuth2JwtGrantIssuer) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Issuer) {
 		toSerialize["issuer"] = o.Issuer
 	}
+	if !IsNil(o.JwksUri) {
+		toSerialize["jwks_uri"] = o.JwksUri
+	}
 	if !IsNil(o.PublicKey) {
 		toSerialize["public_key"] = o.PublicKey
 	}
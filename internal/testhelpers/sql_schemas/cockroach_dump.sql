@@ -1,4 +1,4 @@
--- migrations hash: 14de04dcdbd3e9b00922807be554550705bf44b836351e0eeffdac2871efff8c5cc087a08319b435d9a66cb6d9b16eed397a786833efa790956304dadc6229d8
+-- migrations hash: 8eb78811ec2007f739759da0d692f8a49261f5484c27e38bfc1d132ccac24595a1250d807d203c325fb9685919e6f0185e151b3b521b9a5331d0bba8f4bcfdc2
 
 CREATE TABLE public.schema_migration (
 	version VARCHAR(48) NOT NULL,
@@ -71,6 +71,11 @@ CREATE TABLE public.hydra_client (
 	device_authorization_grant_access_token_lifespan INT8 NULL,
 	device_authorization_grant_refresh_token_lifespan INT8 NULL,
 	rotated_secrets JSONB NULL,
+	token_claims_mapper_url STRING NULL,
+	refresh_token_strategy VARCHAR(10) NOT NULL DEFAULT '':::STRING,
+	default_acr_values JSONB NULL,
+	require_auth_time BOOL NOT NULL DEFAULT false,
+	minimum_acr VARCHAR(255) NOT NULL DEFAULT '':::STRING,
 	CONSTRAINT hydra_client_pkey PRIMARY KEY (id ASC, nid ASC),
 	UNIQUE INDEX hydra_client_id_key (id ASC, nid ASC),
 	UNIQUE INDEX hydra_client_pk_key (pk ASC)
@@ -97,6 +102,7 @@ CREATE TABLE public.hydra_oauth2_authentication_session (
 	nid UUID NOT NULL,
 	identity_provider_session_id VARCHAR(40) NULL,
 	expires_at TIMESTAMP NULL,
+	acr VARCHAR(255) NOT NULL DEFAULT '':::STRING,
 	CONSTRAINT hydra_oauth2_authentication_session_pkey PRIMARY KEY (id ASC),
 	INDEX hydra_oauth2_authentication_session_subject_idx (subject ASC, nid ASC)
 );
@@ -234,6 +240,21 @@ CREATE TABLE public.hydra_oauth2_refresh (
 	INDEX hydra_oauth2_refresh_requested_at_idx (nid ASC, requested_at ASC),
 	INDEX hydra_oauth2_refresh_nid_subject_idx (nid ASC, subject ASC, client_id ASC)
 );
+CREATE TABLE public.hydra_oauth2_refresh_deny_list (
+	id VARCHAR(40) NOT NULL,
+	nid UUID NOT NULL,
+	expires_at TIMESTAMP NULL,
+	CONSTRAINT hydra_oauth2_refresh_deny_list_pkey PRIMARY KEY (id ASC, nid ASC),
+	INDEX hydra_oauth2_refresh_deny_list_expires_at_idx (nid ASC, expires_at ASC)
+);
+CREATE TABLE public.hydra_oauth2_revocation_feed (
+	id UUID NOT NULL,
+	nid UUID NOT NULL,
+	request_id VARCHAR(40) NOT NULL,
+	revoked_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	CONSTRAINT hydra_oauth2_revocation_feed_pkey PRIMARY KEY (id ASC),
+	INDEX hydra_oauth2_revocation_feed_revoked_at_idx (nid ASC, revoked_at ASC)
+);
 CREATE TABLE public.hydra_oauth2_code (
 	signature VARCHAR(255) NOT NULL,
 	request_id VARCHAR(40) NOT NULL,
@@ -306,16 +327,39 @@ CREATE TABLE public.hydra_oauth2_trusted_jwt_bearer_issuer (
 	issuer VARCHAR(255) NOT NULL,
 	subject VARCHAR(255) NOT NULL,
 	scope STRING NOT NULL,
-	key_set VARCHAR(255) NOT NULL,
-	key_id VARCHAR(255) NOT NULL,
+	key_set VARCHAR(255) NULL,
+	key_id VARCHAR(255) NULL,
 	created_at TIMESTAMP NOT NULL DEFAULT now():::TIMESTAMP,
 	expires_at TIMESTAMP NOT NULL DEFAULT now():::TIMESTAMP,
 	nid UUID NOT NULL,
 	allow_any_subject BOOL NOT NULL DEFAULT false,
+	jwks_uri VARCHAR(2048) NOT NULL DEFAULT '':::STRING,
+	claim_conditions STRING NULL,
+	claims_mapper STRING NULL,
 	CONSTRAINT "primary" PRIMARY KEY (id ASC),
 	INDEX hydra_oauth2_trusted_jwt_bearer_issuer_expires_at_idx (expires_at ASC),
 	INDEX hydra_oauth2_trusted_jwt_bearer_issuer_nid_idx (id ASC, nid ASC),
-	UNIQUE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_nid_uq_idx (nid ASC, key_id ASC, issuer ASC, subject ASC)
+	UNIQUE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_nid_uq_idx (nid ASC, key_id ASC, issuer ASC, subject ASC),
+	UNIQUE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_remote_uq_idx (nid ASC, issuer ASC, subject ASC) WHERE key_id IS NULL
+);
+CREATE TABLE public.hydra_tenant (
+	id UUID NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	hosts STRING NOT NULL,
+	path_prefix VARCHAR(255) NOT NULL DEFAULT '':::STRING,
+	config STRING NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	CONSTRAINT hydra_tenant_pkey PRIMARY KEY (id ASC),
+	UNIQUE INDEX hydra_tenant_name_idx (name ASC)
+);
+CREATE TABLE public.hydra_janitor_lease (
+	name VARCHAR(64) NOT NULL,
+	holder VARCHAR(255) NOT NULL DEFAULT '':::STRING,
+	expires_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	paused BOOL NOT NULL DEFAULT false,
+	last_run_at TIMESTAMP NULL,
+	CONSTRAINT hydra_janitor_lease_pkey PRIMARY KEY (name ASC)
 );
 CREATE TABLE public.hydra_oauth2_device_auth_codes (
 	device_code_signature VARCHAR(255) NOT NULL,
@@ -341,6 +385,51 @@ CREATE TABLE public.hydra_oauth2_device_auth_codes (
 	INDEX hydra_oauth2_device_auth_codes_challenge_id_idx (challenge_id ASC),
 	UNIQUE INDEX hydra_oauth2_device_auth_codes_user_code_signature_idx (nid ASC, user_code_signature ASC)
 );
+CREATE TABLE public.hydra_oauth2_scope (
+	id UUID NOT NULL,
+	nid UUID NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	description STRING NOT NULL,
+	display_names STRING NOT NULL,
+	sensitivity VARCHAR(10) NOT NULL DEFAULT 'low':::STRING,
+	default_audience STRING NOT NULL,
+	requires_consent BOOL NOT NULL DEFAULT false,
+	created_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	CONSTRAINT hydra_oauth2_scope_pkey PRIMARY KEY (id ASC),
+	UNIQUE INDEX hydra_oauth2_scope_name_idx (nid ASC, name ASC)
+);
+CREATE TABLE public.hydra_oauth2_consent_history (
+	id UUID NOT NULL,
+	nid UUID NOT NULL,
+	event VARCHAR(10) NOT NULL,
+	subject VARCHAR(255) NOT NULL,
+	client_id VARCHAR(255) NOT NULL,
+	consent_request_id VARCHAR(40) NOT NULL,
+	granted_scope STRING NOT NULL,
+	granted_audience STRING NOT NULL,
+	remember BOOL NOT NULL DEFAULT false,
+	remember_for INT8 NOT NULL DEFAULT 0:::INT8,
+	consented_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	expires_at TIMESTAMP NULL,
+	revocation_reason STRING NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	CONSTRAINT hydra_oauth2_consent_history_pkey PRIMARY KEY (id ASC),
+	INDEX hydra_oauth2_consent_history_subject_idx (nid ASC, subject ASC, created_at ASC, id ASC)
+);
+CREATE TABLE public.hydra_oauth2_pairwise_subject (
+	nid UUID NOT NULL,
+	client_id VARCHAR(255) NOT NULL,
+	subject VARCHAR(255) NOT NULL,
+	pairwise_subject VARCHAR(255) NOT NULL,
+	previous_pairwise_subject VARCHAR(255) NOT NULL DEFAULT '':::STRING,
+	sector_identifier STRING NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT current_timestamp():::TIMESTAMP,
+	CONSTRAINT hydra_oauth2_pairwise_subject_pkey PRIMARY KEY (nid ASC, client_id ASC, subject ASC),
+	UNIQUE INDEX hydra_oauth2_pairwise_subject_pairwise_idx (nid ASC, client_id ASC, pairwise_subject ASC),
+	INDEX hydra_oauth2_pairwise_subject_previous_idx (nid ASC, client_id ASC, previous_pairwise_subject ASC),
+	INDEX hydra_oauth2_pairwise_subject_subject_idx (nid ASC, subject ASC)
+);
 ALTER TABLE public.hydra_client ADD CONSTRAINT hydra_client_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_jwk ADD CONSTRAINT hydra_jwk_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_oauth2_authentication_session ADD CONSTRAINT hydra_oauth2_authentication_session_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
@@ -357,6 +446,8 @@ ALTER TABLE public.hydra_oauth2_access ADD CONSTRAINT hydra_oauth2_access_client
 ALTER TABLE public.hydra_oauth2_refresh ADD CONSTRAINT hydra_oauth2_refresh_challenge_id_fk FOREIGN KEY (challenge_id) REFERENCES public.hydra_oauth2_flow(consent_challenge_id) ON DELETE CASCADE;
 ALTER TABLE public.hydra_oauth2_refresh ADD CONSTRAINT hydra_oauth2_refresh_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_oauth2_refresh ADD CONSTRAINT hydra_oauth2_refresh_client_id_fk FOREIGN KEY (client_id, nid) REFERENCES public.hydra_client(id, nid) ON DELETE CASCADE;
+ALTER TABLE public.hydra_oauth2_refresh_deny_list ADD CONSTRAINT hydra_oauth2_refresh_deny_list_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
+ALTER TABLE public.hydra_oauth2_revocation_feed ADD CONSTRAINT hydra_oauth2_revocation_feed_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_oauth2_code ADD CONSTRAINT hydra_oauth2_code_challenge_id_fk FOREIGN KEY (challenge_id) REFERENCES public.hydra_oauth2_flow(consent_challenge_id) ON DELETE CASCADE;
 ALTER TABLE public.hydra_oauth2_code ADD CONSTRAINT hydra_oauth2_code_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_oauth2_code ADD CONSTRAINT hydra_oauth2_code_client_id_fk FOREIGN KEY (client_id, nid) REFERENCES public.hydra_client(id, nid) ON DELETE CASCADE;
@@ -369,9 +460,14 @@ ALTER TABLE public.hydra_oauth2_pkce ADD CONSTRAINT hydra_oauth2_pkce_client_id_
 ALTER TABLE public.hydra_oauth2_jti_blacklist ADD CONSTRAINT hydra_oauth2_jti_blacklist_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_oauth2_trusted_jwt_bearer_issuer ADD CONSTRAINT hydra_oauth2_trusted_jwt_bearer_issuer_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_oauth2_trusted_jwt_bearer_issuer ADD CONSTRAINT fk_key_set_ref_hydra_jwk FOREIGN KEY (key_set, key_id, nid) REFERENCES public.hydra_jwk(sid, kid, nid) ON DELETE CASCADE;
+ALTER TABLE public.hydra_tenant ADD CONSTRAINT hydra_tenant_id_fkey FOREIGN KEY (id) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_oauth2_device_auth_codes ADD CONSTRAINT hydra_oauth2_device_auth_codes_client_id_nid_fkey FOREIGN KEY (client_id, nid) REFERENCES public.hydra_client(id, nid) ON DELETE CASCADE;
 ALTER TABLE public.hydra_oauth2_device_auth_codes ADD CONSTRAINT hydra_oauth2_device_auth_codes_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_oauth2_device_auth_codes ADD CONSTRAINT hydra_oauth2_device_auth_codes_challenge_id_fkey FOREIGN KEY (challenge_id) REFERENCES public.hydra_oauth2_flow(consent_challenge_id) ON DELETE CASCADE;
+ALTER TABLE public.hydra_oauth2_scope ADD CONSTRAINT hydra_oauth2_scope_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
+ALTER TABLE public.hydra_oauth2_consent_history ADD CONSTRAINT hydra_oauth2_consent_history_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
+ALTER TABLE public.hydra_oauth2_pairwise_subject ADD CONSTRAINT hydra_oauth2_pairwise_subject_client_id_nid_fkey FOREIGN KEY (client_id, nid) REFERENCES public.hydra_client(id, nid) ON DELETE CASCADE;
+ALTER TABLE public.hydra_oauth2_pairwise_subject ADD CONSTRAINT hydra_oauth2_pairwise_subject_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON DELETE CASCADE ON UPDATE RESTRICT;
 ALTER TABLE public.hydra_client VALIDATE CONSTRAINT hydra_client_nid_fk_idx;
 ALTER TABLE public.hydra_jwk VALIDATE CONSTRAINT hydra_jwk_nid_fk_idx;
 ALTER TABLE public.hydra_oauth2_authentication_session VALIDATE CONSTRAINT hydra_oauth2_authentication_session_nid_fk_idx;
@@ -388,6 +484,8 @@ ALTER TABLE public.hydra_oauth2_access VALIDATE CONSTRAINT hydra_oauth2_access_c
 ALTER TABLE public.hydra_oauth2_refresh VALIDATE CONSTRAINT hydra_oauth2_refresh_challenge_id_fk;
 ALTER TABLE public.hydra_oauth2_refresh VALIDATE CONSTRAINT hydra_oauth2_refresh_nid_fk_idx;
 ALTER TABLE public.hydra_oauth2_refresh VALIDATE CONSTRAINT hydra_oauth2_refresh_client_id_fk;
+ALTER TABLE public.hydra_oauth2_refresh_deny_list VALIDATE CONSTRAINT hydra_oauth2_refresh_deny_list_nid_fkey;
+ALTER TABLE public.hydra_oauth2_revocation_feed VALIDATE CONSTRAINT hydra_oauth2_revocation_feed_nid_fkey;
 ALTER TABLE public.hydra_oauth2_code VALIDATE CONSTRAINT hydra_oauth2_code_challenge_id_fk;
 ALTER TABLE public.hydra_oauth2_code VALIDATE CONSTRAINT hydra_oauth2_code_nid_fk_idx;
 ALTER TABLE public.hydra_oauth2_code VALIDATE CONSTRAINT hydra_oauth2_code_client_id_fk;
@@ -400,7 +498,12 @@ ALTER TABLE public.hydra_oauth2_pkce VALIDATE CONSTRAINT hydra_oauth2_pkce_clien
 ALTER TABLE public.hydra_oauth2_jti_blacklist VALIDATE CONSTRAINT hydra_oauth2_jti_blacklist_nid_fk_idx;
 ALTER TABLE public.hydra_oauth2_trusted_jwt_bearer_issuer VALIDATE CONSTRAINT hydra_oauth2_trusted_jwt_bearer_issuer_nid_fk_idx;
 ALTER TABLE public.hydra_oauth2_trusted_jwt_bearer_issuer VALIDATE CONSTRAINT fk_key_set_ref_hydra_jwk;
+ALTER TABLE public.hydra_tenant VALIDATE CONSTRAINT hydra_tenant_id_fkey;
 ALTER TABLE public.hydra_oauth2_device_auth_codes VALIDATE CONSTRAINT hydra_oauth2_device_auth_codes_client_id_nid_fkey;
 ALTER TABLE public.hydra_oauth2_device_auth_codes VALIDATE CONSTRAINT hydra_oauth2_device_auth_codes_nid_fkey;
 ALTER TABLE public.hydra_oauth2_device_auth_codes VALIDATE CONSTRAINT hydra_oauth2_device_auth_codes_challenge_id_fkey;
 
+ALTER TABLE public.hydra_oauth2_scope VALIDATE CONSTRAINT hydra_oauth2_scope_nid_fkey;
+ALTER TABLE public.hydra_oauth2_consent_history VALIDATE CONSTRAINT hydra_oauth2_consent_history_nid_fkey;
+ALTER TABLE public.hydra_oauth2_pairwise_subject VALIDATE CONSTRAINT hydra_oauth2_pairwise_subject_client_id_nid_fkey;
+ALTER TABLE public.hydra_oauth2_pairwise_subject VALIDATE CONSTRAINT hydra_oauth2_pairwise_subject_nid_fkey;
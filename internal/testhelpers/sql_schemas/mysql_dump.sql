@@ -1,4 +1,4 @@
--- migrations hash: 14de04dcdbd3e9b00922807be554550705bf44b836351e0eeffdac2871efff8c5cc087a08319b435d9a66cb6d9b16eed397a786833efa790956304dadc6229d8
+-- migrations hash: 8eb78811ec2007f739759da0d692f8a49261f5484c27e38bfc1d132ccac24595a1250d807d203c325fb9685919e6f0185e151b3b521b9a5331d0bba8f4bcfdc2
 
 
 /*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */;
@@ -72,6 +72,11 @@ CREATE TABLE `hydra_client` (
   `device_authorization_grant_access_token_lifespan` bigint DEFAULT NULL,
   `device_authorization_grant_refresh_token_lifespan` bigint DEFAULT NULL,
   `rotated_secrets` json DEFAULT NULL,
+  `token_claims_mapper_url` text,
+  `refresh_token_strategy` varchar(10) NOT NULL DEFAULT '',
+  `default_acr_values` json DEFAULT NULL,
+  `require_auth_time` tinyint(1) NOT NULL DEFAULT '0',
+  `minimum_acr` varchar(255) NOT NULL DEFAULT '',
   PRIMARY KEY (`id`,`nid`),
   UNIQUE KEY `hydra_client_id_key` (`id`,`nid`),
   KEY `pk_deprecated` (`pk_deprecated`),
@@ -80,6 +85,19 @@ CREATE TABLE `hydra_client` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+DROP TABLE IF EXISTS `hydra_janitor_lease`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_janitor_lease` (
+  `name` varchar(64) NOT NULL,
+  `holder` varchar(255) NOT NULL DEFAULT '',
+  `expires_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `paused` tinyint(1) NOT NULL DEFAULT '0',
+  `last_run_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 DROP TABLE IF EXISTS `hydra_jwk`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!50503 SET character_set_client = utf8mb4 */;
@@ -145,6 +163,7 @@ CREATE TABLE `hydra_oauth2_authentication_session` (
   `nid` char(36) NOT NULL,
   `identity_provider_session_id` varchar(40) DEFAULT NULL,
   `expires_at` timestamp NULL DEFAULT NULL,
+  `acr` varchar(255) NOT NULL DEFAULT '',
   PRIMARY KEY (`id`),
   KEY `hydra_oauth2_authentication_session_sub_idx` (`subject`),
   KEY `hydra_oauth2_authentication_session_nid_fk_idx` (`nid`),
@@ -182,6 +201,30 @@ CREATE TABLE `hydra_oauth2_code` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+DROP TABLE IF EXISTS `hydra_oauth2_consent_history`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_oauth2_consent_history` (
+  `id` char(36) NOT NULL,
+  `nid` char(36) NOT NULL,
+  `event` varchar(10) NOT NULL,
+  `subject` varchar(255) NOT NULL,
+  `client_id` varchar(255) NOT NULL,
+  `consent_request_id` varchar(40) NOT NULL,
+  `granted_scope` text NOT NULL,
+  `granted_audience` text NOT NULL,
+  `remember` tinyint(1) NOT NULL DEFAULT '0',
+  `remember_for` int NOT NULL DEFAULT '0',
+  `consented_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `expires_at` timestamp NULL DEFAULT NULL,
+  `revocation_reason` text NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `hydra_oauth2_consent_history_subject_idx` (`nid`,`subject`,`created_at`,`id`),
+  CONSTRAINT `hydra_oauth2_consent_history_ibfk_1` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 DROP TABLE IF EXISTS `hydra_oauth2_device_auth_codes`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!50503 SET character_set_client = utf8mb4 */;
@@ -367,6 +410,27 @@ CREATE TABLE `hydra_oauth2_oidc` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+DROP TABLE IF EXISTS `hydra_oauth2_pairwise_subject`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_oauth2_pairwise_subject` (
+  `nid` char(36) NOT NULL,
+  `client_id` varchar(255) NOT NULL,
+  `subject` varchar(255) NOT NULL,
+  `pairwise_subject` varchar(255) NOT NULL,
+  `previous_pairwise_subject` varchar(255) NOT NULL DEFAULT '',
+  `sector_identifier` text NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`nid`,`client_id`,`subject`),
+  UNIQUE KEY `hydra_oauth2_pairwise_subject_pairwise_idx` (`nid`,`client_id`,`pairwise_subject`),
+  KEY `hydra_oauth2_pairwise_subject_ibfk_1` (`client_id`,`nid`),
+  KEY `hydra_oauth2_pairwise_subject_previous_idx` (`nid`,`client_id`,`previous_pairwise_subject`),
+  KEY `hydra_oauth2_pairwise_subject_subject_idx` (`nid`,`subject`),
+  CONSTRAINT `hydra_oauth2_pairwise_subject_ibfk_1` FOREIGN KEY (`client_id`, `nid`) REFERENCES `hydra_client` (`id`, `nid`) ON DELETE CASCADE,
+  CONSTRAINT `hydra_oauth2_pairwise_subject_ibfk_2` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 DROP TABLE IF EXISTS `hydra_oauth2_pkce`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!50503 SET character_set_client = utf8mb4 */;
@@ -432,6 +496,53 @@ CREATE TABLE `hydra_oauth2_refresh` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+DROP TABLE IF EXISTS `hydra_oauth2_refresh_deny_list`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_oauth2_refresh_deny_list` (
+  `id` varchar(40) NOT NULL,
+  `nid` char(36) NOT NULL,
+  `expires_at` timestamp NULL DEFAULT NULL,
+  PRIMARY KEY (`id`,`nid`),
+  KEY `hydra_oauth2_refresh_deny_list_expires_at_idx` (`nid`,`expires_at`),
+  CONSTRAINT `hydra_oauth2_refresh_deny_list_ibfk_1` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+DROP TABLE IF EXISTS `hydra_oauth2_revocation_feed`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_oauth2_revocation_feed` (
+  `id` char(36) NOT NULL,
+  `nid` char(36) NOT NULL,
+  `request_id` varchar(40) NOT NULL,
+  `revoked_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `hydra_oauth2_revocation_feed_revoked_at_idx` (`nid`,`revoked_at`),
+  CONSTRAINT `hydra_oauth2_revocation_feed_ibfk_1` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
+DROP TABLE IF EXISTS `hydra_oauth2_scope`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_oauth2_scope` (
+  `id` char(36) NOT NULL,
+  `nid` char(36) NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `description` text NOT NULL,
+  `display_names` text NOT NULL,
+  `sensitivity` varchar(10) NOT NULL DEFAULT 'low',
+  `default_audience` text NOT NULL,
+  `requires_consent` tinyint(1) NOT NULL DEFAULT '0',
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `hydra_oauth2_scope_name_idx` (`nid`,`name`),
+  CONSTRAINT `hydra_oauth2_scope_ibfk_1` FOREIGN KEY (`nid`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 DROP TABLE IF EXISTS `hydra_oauth2_trusted_jwt_bearer_issuer`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!50503 SET character_set_client = utf8mb4 */;
@@ -440,14 +551,18 @@ CREATE TABLE `hydra_oauth2_trusted_jwt_bearer_issuer` (
   `issuer` varchar(255) NOT NULL,
   `subject` varchar(255) NOT NULL,
   `scope` text NOT NULL,
-  `key_set` varchar(255) NOT NULL,
-  `key_id` varchar(255) CHARACTER SET ascii COLLATE ascii_general_ci NOT NULL,
+  `key_set` varchar(255) DEFAULT NULL,
+  `key_id` varchar(255) CHARACTER SET ascii COLLATE ascii_general_ci DEFAULT NULL,
   `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `expires_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `nid` char(36) NOT NULL,
   `allow_any_subject` tinyint(1) NOT NULL DEFAULT '0',
+  `jwks_uri` varchar(2048) NOT NULL DEFAULT '',
+  `claim_conditions` text,
+  `claims_mapper` text,
   PRIMARY KEY (`id`),
   UNIQUE KEY `hydra_oauth2_trusted_jwt_bearer_issuer_nid_uq_idx` (`nid`,`key_id`,`issuer`,`subject`),
+  UNIQUE KEY `hydra_oauth2_trusted_jwt_bearer_issuer_remote_uq_idx` (`nid`,`issuer`,`subject`,((case when (`key_id` is null) then 1 end))),
   KEY `hydra_oauth2_trusted_jwt_bearer_issuer_ibfk_1` (`key_set`,`key_id`,`nid`),
   KEY `hydra_oauth2_trusted_jwt_bearer_issuer_expires_at_idx` (`expires_at`),
   KEY `hydra_oauth2_trusted_jwt_bearer_issuer_nid_idx` (`id`,`nid`),
@@ -456,6 +571,23 @@ CREATE TABLE `hydra_oauth2_trusted_jwt_bearer_issuer` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
 
+DROP TABLE IF EXISTS `hydra_tenant`;
+/*!40101 SET @saved_cs_client     = @@character_set_client */;
+/*!50503 SET character_set_client = utf8mb4 */;
+CREATE TABLE `hydra_tenant` (
+  `id` char(36) NOT NULL,
+  `name` varchar(255) NOT NULL,
+  `hosts` text NOT NULL,
+  `path_prefix` varchar(255) NOT NULL DEFAULT '',
+  `config` text NOT NULL,
+  `created_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `hydra_tenant_name_idx` (`name`),
+  CONSTRAINT `hydra_tenant_ibfk_1` FOREIGN KEY (`id`) REFERENCES `networks` (`id`) ON DELETE CASCADE ON UPDATE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci;
+/*!40101 SET character_set_client = @saved_cs_client */;
+
 DROP TABLE IF EXISTS `networks`;
 /*!40101 SET @saved_cs_client     = @@character_set_client */;
 /*!50503 SET character_set_client = utf8mb4 */;
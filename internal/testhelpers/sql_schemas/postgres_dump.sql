@@ -1,4 +1,4 @@
--- migrations hash: 14de04dcdbd3e9b00922807be554550705bf44b836351e0eeffdac2871efff8c5cc087a08319b435d9a66cb6d9b16eed397a786833efa790956304dadc6229d8
+-- migrations hash: 8eb78811ec2007f739759da0d692f8a49261f5484c27e38bfc1d132ccac24595a1250d807d203c325fb9685919e6f0185e151b3b521b9a5331d0bba8f4bcfdc2
 
 
 
@@ -77,7 +77,12 @@ CREATE TABLE public.hydra_client (
     device_authorization_grant_id_token_lifespan bigint,
     device_authorization_grant_access_token_lifespan bigint,
     device_authorization_grant_refresh_token_lifespan bigint,
-    rotated_secrets jsonb
+    rotated_secrets jsonb,
+    token_claims_mapper_url text,
+    refresh_token_strategy character varying(10) DEFAULT ''::character varying NOT NULL,
+    default_acr_values jsonb,
+    require_auth_time boolean DEFAULT false NOT NULL,
+    minimum_acr character varying(255) DEFAULT ''::character varying NOT NULL
 );
 
 ALTER TABLE public.hydra_client OWNER TO postgres;
@@ -94,6 +99,16 @@ ALTER SEQUENCE public.hydra_client_pk_seq OWNER TO postgres;
 
 ALTER SEQUENCE public.hydra_client_pk_seq OWNED BY public.hydra_client.pk_deprecated;
 
+CREATE TABLE public.hydra_janitor_lease (
+    name character varying(64) NOT NULL,
+    holder character varying(255) DEFAULT ''::character varying NOT NULL,
+    expires_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    paused boolean DEFAULT false NOT NULL,
+    last_run_at timestamp without time zone
+);
+
+ALTER TABLE public.hydra_janitor_lease OWNER TO postgres;
+
 CREATE TABLE public.hydra_jwk (
     sid character varying(255) NOT NULL,
     kid character varying(255) NOT NULL,
@@ -146,7 +161,8 @@ CREATE TABLE public.hydra_oauth2_authentication_session (
     remember boolean DEFAULT false NOT NULL,
     nid uuid NOT NULL,
     identity_provider_session_id character varying(40),
-    expires_at timestamp without time zone
+    expires_at timestamp without time zone,
+    acr character varying(255) DEFAULT ''::character varying NOT NULL
 );
 
 ALTER TABLE public.hydra_oauth2_authentication_session OWNER TO postgres;
@@ -171,6 +187,25 @@ CREATE TABLE public.hydra_oauth2_code (
 
 ALTER TABLE public.hydra_oauth2_code OWNER TO postgres;
 
+CREATE TABLE public.hydra_oauth2_consent_history (
+    id uuid NOT NULL,
+    nid uuid NOT NULL,
+    event character varying(10) NOT NULL,
+    subject character varying(255) NOT NULL,
+    client_id character varying(255) NOT NULL,
+    consent_request_id character varying(40) NOT NULL,
+    granted_scope text NOT NULL,
+    granted_audience text NOT NULL,
+    remember boolean DEFAULT false NOT NULL,
+    remember_for integer DEFAULT 0 NOT NULL,
+    consented_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    expires_at timestamp without time zone,
+    revocation_reason text NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE public.hydra_oauth2_consent_history OWNER TO postgres;
+
 CREATE TABLE public.hydra_oauth2_device_auth_codes (
     device_code_signature character varying(255) NOT NULL,
     user_code_signature character varying(255) NOT NULL,
@@ -305,6 +340,18 @@ CREATE TABLE public.hydra_oauth2_oidc (
 
 ALTER TABLE public.hydra_oauth2_oidc OWNER TO postgres;
 
+CREATE TABLE public.hydra_oauth2_pairwise_subject (
+    nid uuid NOT NULL,
+    client_id character varying(255) NOT NULL,
+    subject character varying(255) NOT NULL,
+    pairwise_subject character varying(255) NOT NULL,
+    previous_pairwise_subject character varying(255) DEFAULT ''::character varying NOT NULL,
+    sector_identifier text NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE public.hydra_oauth2_pairwise_subject OWNER TO postgres;
+
 CREATE TABLE public.hydra_oauth2_pkce (
     signature character varying(255) NOT NULL,
     request_id character varying(40) NOT NULL,
@@ -348,21 +395,68 @@ CREATE TABLE public.hydra_oauth2_refresh (
 
 ALTER TABLE public.hydra_oauth2_refresh OWNER TO postgres;
 
+CREATE TABLE public.hydra_oauth2_refresh_deny_list (
+    id character varying(40) NOT NULL,
+    nid uuid NOT NULL,
+    expires_at timestamp without time zone
+);
+
+ALTER TABLE public.hydra_oauth2_refresh_deny_list OWNER TO postgres;
+
+CREATE TABLE public.hydra_oauth2_revocation_feed (
+    id uuid NOT NULL,
+    nid uuid NOT NULL,
+    request_id character varying(40) NOT NULL,
+    revoked_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE public.hydra_oauth2_revocation_feed OWNER TO postgres;
+
+CREATE TABLE public.hydra_oauth2_scope (
+    id uuid NOT NULL,
+    nid uuid NOT NULL,
+    name character varying(255) NOT NULL,
+    description text NOT NULL,
+    display_names text NOT NULL,
+    sensitivity character varying(10) DEFAULT 'low'::character varying NOT NULL,
+    default_audience text NOT NULL,
+    requires_consent boolean DEFAULT false NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE public.hydra_oauth2_scope OWNER TO postgres;
+
 CREATE TABLE public.hydra_oauth2_trusted_jwt_bearer_issuer (
     id uuid NOT NULL,
     issuer character varying(255) NOT NULL,
     subject character varying(255) NOT NULL,
     scope text NOT NULL,
-    key_set character varying(255) NOT NULL,
-    key_id character varying(255) NOT NULL,
+    key_set character varying(255),
+    key_id character varying(255),
     created_at timestamp without time zone DEFAULT now() NOT NULL,
     expires_at timestamp without time zone DEFAULT now() NOT NULL,
     nid uuid NOT NULL,
-    allow_any_subject boolean DEFAULT false NOT NULL
+    allow_any_subject boolean DEFAULT false NOT NULL,
+    jwks_uri character varying(2048) DEFAULT ''::character varying NOT NULL,
+    claim_conditions text,
+    claims_mapper text
 );
 
 ALTER TABLE public.hydra_oauth2_trusted_jwt_bearer_issuer OWNER TO postgres;
 
+CREATE TABLE public.hydra_tenant (
+    id uuid NOT NULL,
+    name character varying(255) NOT NULL,
+    hosts text NOT NULL,
+    path_prefix character varying(255) DEFAULT ''::character varying NOT NULL,
+    config text NOT NULL,
+    created_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp without time zone DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+ALTER TABLE public.hydra_tenant OWNER TO postgres;
+
 CREATE TABLE public.networks (
     id uuid NOT NULL,
     created_at timestamp without time zone NOT NULL,
@@ -385,6 +479,9 @@ ALTER TABLE ONLY public.hydra_jwk ALTER COLUMN pk_deprecated SET DEFAULT nextval
 ALTER TABLE ONLY public.hydra_client
     ADD CONSTRAINT hydra_client_pkey PRIMARY KEY (id, nid);
 
+ALTER TABLE ONLY public.hydra_janitor_lease
+    ADD CONSTRAINT hydra_janitor_lease_pkey PRIMARY KEY (name);
+
 ALTER TABLE ONLY public.hydra_jwk
     ADD CONSTRAINT hydra_jwk_pkey PRIMARY KEY (pk);
 
@@ -397,6 +494,9 @@ ALTER TABLE ONLY public.hydra_oauth2_authentication_session
 ALTER TABLE ONLY public.hydra_oauth2_code
     ADD CONSTRAINT hydra_oauth2_code_pkey PRIMARY KEY (signature);
 
+ALTER TABLE ONLY public.hydra_oauth2_consent_history
+    ADD CONSTRAINT hydra_oauth2_consent_history_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY public.hydra_oauth2_device_auth_codes
     ADD CONSTRAINT hydra_oauth2_device_auth_codes_pkey PRIMARY KEY (device_code_signature, nid);
 
@@ -415,18 +515,33 @@ ALTER TABLE ONLY public.hydra_oauth2_obfuscated_authentication_session
 ALTER TABLE ONLY public.hydra_oauth2_oidc
     ADD CONSTRAINT hydra_oauth2_oidc_pkey PRIMARY KEY (signature);
 
+ALTER TABLE ONLY public.hydra_oauth2_pairwise_subject
+    ADD CONSTRAINT hydra_oauth2_pairwise_subject_pkey PRIMARY KEY (nid, client_id, subject);
+
 ALTER TABLE ONLY public.hydra_oauth2_pkce
     ADD CONSTRAINT hydra_oauth2_pkce_pkey PRIMARY KEY (signature);
 
 ALTER TABLE ONLY public.hydra_oauth2_refresh
     ADD CONSTRAINT hydra_oauth2_refresh_pkey PRIMARY KEY (signature);
 
+ALTER TABLE ONLY public.hydra_oauth2_refresh_deny_list
+    ADD CONSTRAINT hydra_oauth2_refresh_deny_list_pkey PRIMARY KEY (id, nid);
+
+ALTER TABLE ONLY public.hydra_oauth2_revocation_feed
+    ADD CONSTRAINT hydra_oauth2_revocation_feed_pkey PRIMARY KEY (id);
+
+ALTER TABLE ONLY public.hydra_oauth2_scope
+    ADD CONSTRAINT hydra_oauth2_scope_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY public.hydra_oauth2_trusted_jwt_bearer_issuer
     ADD CONSTRAINT hydra_oauth2_trusted_jwt_bearer_issue_issuer_subject_key_id_key UNIQUE (issuer, subject, key_id, nid);
 
 ALTER TABLE ONLY public.hydra_oauth2_trusted_jwt_bearer_issuer
     ADD CONSTRAINT hydra_oauth2_trusted_jwt_bearer_issuer_pkey PRIMARY KEY (id);
 
+ALTER TABLE ONLY public.hydra_tenant
+    ADD CONSTRAINT hydra_tenant_pkey PRIMARY KEY (id);
+
 ALTER TABLE ONLY public.networks
     ADD CONSTRAINT networks_pkey PRIMARY KEY (id);
 
@@ -454,6 +569,8 @@ CREATE INDEX hydra_oauth2_code_challenge_id_idx ON public.hydra_oauth2_code USIN
 
 CREATE INDEX hydra_oauth2_code_client_id_idx ON public.hydra_oauth2_code USING btree (client_id, nid);
 
+CREATE INDEX hydra_oauth2_consent_history_subject_idx ON public.hydra_oauth2_consent_history USING btree (nid, subject, created_at, id);
+
 CREATE INDEX hydra_oauth2_device_auth_codes_challenge_id_idx ON public.hydra_oauth2_device_auth_codes USING btree (challenge_id);
 
 CREATE INDEX hydra_oauth2_device_auth_codes_client_id_idx ON public.hydra_oauth2_device_auth_codes USING btree (client_id, nid);
@@ -490,6 +607,12 @@ CREATE INDEX hydra_oauth2_oidc_challenge_id_idx ON public.hydra_oauth2_oidc USIN
 
 CREATE INDEX hydra_oauth2_oidc_client_id_idx ON public.hydra_oauth2_oidc USING btree (client_id, nid);
 
+CREATE UNIQUE INDEX hydra_oauth2_pairwise_subject_pairwise_idx ON public.hydra_oauth2_pairwise_subject USING btree (nid, client_id, pairwise_subject);
+
+CREATE INDEX hydra_oauth2_pairwise_subject_previous_idx ON public.hydra_oauth2_pairwise_subject USING btree (nid, client_id, previous_pairwise_subject);
+
+CREATE INDEX hydra_oauth2_pairwise_subject_subject_idx ON public.hydra_oauth2_pairwise_subject USING btree (nid, subject);
+
 CREATE INDEX hydra_oauth2_pkce_challenge_id_idx ON public.hydra_oauth2_pkce USING btree (challenge_id);
 
 CREATE INDEX hydra_oauth2_pkce_client_id_idx ON public.hydra_oauth2_pkce USING btree (client_id, nid);
@@ -498,18 +621,28 @@ CREATE INDEX hydra_oauth2_refresh_challenge_id_idx ON public.hydra_oauth2_refres
 
 CREATE INDEX hydra_oauth2_refresh_client_id_idx ON public.hydra_oauth2_refresh USING btree (client_id, nid);
 
+CREATE INDEX hydra_oauth2_refresh_deny_list_expires_at_idx ON public.hydra_oauth2_refresh_deny_list USING btree (nid, expires_at);
+
 CREATE INDEX hydra_oauth2_refresh_nid_subject_idx ON public.hydra_oauth2_refresh USING btree (nid, subject, client_id);
 
 CREATE INDEX hydra_oauth2_refresh_request_id_idx ON public.hydra_oauth2_refresh USING btree (request_id);
 
+CREATE INDEX hydra_oauth2_revocation_feed_revoked_at_idx ON public.hydra_oauth2_revocation_feed USING btree (nid, revoked_at);
+
 CREATE INDEX hydra_oauth2_refresh_requested_at_idx ON public.hydra_oauth2_refresh USING btree (nid, requested_at);
 
+CREATE UNIQUE INDEX hydra_oauth2_scope_name_idx ON public.hydra_oauth2_scope USING btree (nid, name);
+
 CREATE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_expires_at_idx ON public.hydra_oauth2_trusted_jwt_bearer_issuer USING btree (expires_at);
 
 CREATE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_nid_idx ON public.hydra_oauth2_trusted_jwt_bearer_issuer USING btree (id, nid);
 
 CREATE UNIQUE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_nid_uq_idx ON public.hydra_oauth2_trusted_jwt_bearer_issuer USING btree (nid, key_id, issuer, subject);
 
+CREATE UNIQUE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_remote_uq_idx ON public.hydra_oauth2_trusted_jwt_bearer_issuer USING btree (nid, issuer, subject) WHERE (key_id IS NULL);
+
+CREATE UNIQUE INDEX hydra_tenant_name_idx ON public.hydra_tenant USING btree (name);
+
 CREATE UNIQUE INDEX schema_migration_version_idx ON public.schema_migration USING btree (version);
 
 CREATE INDEX schema_migration_version_self_idx ON public.schema_migration USING btree (version_self);
@@ -541,6 +674,9 @@ ALTER TABLE ONLY public.hydra_oauth2_code
 ALTER TABLE ONLY public.hydra_oauth2_code
     ADD CONSTRAINT hydra_oauth2_code_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
 
+ALTER TABLE ONLY public.hydra_oauth2_consent_history
+    ADD CONSTRAINT hydra_oauth2_consent_history_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
 ALTER TABLE ONLY public.hydra_oauth2_device_auth_codes
     ADD CONSTRAINT hydra_oauth2_device_auth_codes_challenge_id_fkey FOREIGN KEY (challenge_id) REFERENCES public.hydra_oauth2_flow(consent_challenge_id) ON DELETE CASCADE;
 
@@ -583,6 +719,12 @@ ALTER TABLE ONLY public.hydra_oauth2_oidc
 ALTER TABLE ONLY public.hydra_oauth2_oidc
     ADD CONSTRAINT hydra_oauth2_oidc_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
 
+ALTER TABLE ONLY public.hydra_oauth2_pairwise_subject
+    ADD CONSTRAINT hydra_oauth2_pairwise_subject_client_id_nid_fkey FOREIGN KEY (client_id, nid) REFERENCES public.hydra_client(id, nid) ON DELETE CASCADE;
+
+ALTER TABLE ONLY public.hydra_oauth2_pairwise_subject
+    ADD CONSTRAINT hydra_oauth2_pairwise_subject_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
 ALTER TABLE ONLY public.hydra_oauth2_pkce
     ADD CONSTRAINT hydra_oauth2_pkce_challenge_id_fk FOREIGN KEY (challenge_id) REFERENCES public.hydra_oauth2_flow(consent_challenge_id) ON DELETE CASCADE;
 
@@ -601,10 +743,22 @@ ALTER TABLE ONLY public.hydra_oauth2_refresh
 ALTER TABLE ONLY public.hydra_oauth2_refresh
     ADD CONSTRAINT hydra_oauth2_refresh_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
 
+ALTER TABLE ONLY public.hydra_oauth2_refresh_deny_list
+    ADD CONSTRAINT hydra_oauth2_refresh_deny_list_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
+ALTER TABLE ONLY public.hydra_oauth2_revocation_feed
+    ADD CONSTRAINT hydra_oauth2_revocation_feed_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
+ALTER TABLE ONLY public.hydra_oauth2_scope
+    ADD CONSTRAINT hydra_oauth2_scope_nid_fkey FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
 ALTER TABLE ONLY public.hydra_oauth2_trusted_jwt_bearer_issuer
     ADD CONSTRAINT hydra_oauth2_trusted_jwt_bearer_issuer_key_set_fkey FOREIGN KEY (key_set, key_id, nid) REFERENCES public.hydra_jwk(sid, kid, nid) ON DELETE CASCADE;
 
 ALTER TABLE ONLY public.hydra_oauth2_trusted_jwt_bearer_issuer
     ADD CONSTRAINT hydra_oauth2_trusted_jwt_bearer_issuer_nid_fk_idx FOREIGN KEY (nid) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
 
+ALTER TABLE ONLY public.hydra_tenant
+    ADD CONSTRAINT hydra_tenant_id_fkey FOREIGN KEY (id) REFERENCES public.networks(id) ON UPDATE RESTRICT ON DELETE CASCADE;
+
 SET search_path TO public;
@@ -1,4 +1,4 @@
--- migrations hash: 14de04dcdbd3e9b00922807be554550705bf44b836351e0eeffdac2871efff8c5cc087a08319b435d9a66cb6d9b16eed397a786833efa790956304dadc6229d8
+-- migrations hash: 8eb78811ec2007f739759da0d692f8a49261f5484c27e38bfc1d132ccac24595a1250d807d203c325fb9685919e6f0185e151b3b521b9a5331d0bba8f4bcfdc2
 
 CREATE TABLE "hydra_client"
 (
@@ -52,9 +52,17 @@ CREATE TABLE "hydra_client"
   refresh_token_grant_access_token_lifespan       BIGINT NULL DEFAULT NULL,
   refresh_token_grant_refresh_token_lifespan      BIGINT NULL DEFAULT NULL,
   skip_consent                                    BOOLEAN      NOT NULL DEFAULT false,
-  nid                                             CHAR(36)     NOT NULL, skip_logout_consent BOOLEAN NULL, device_authorization_grant_id_token_lifespan BIGINT NULL DEFAULT NULL, device_authorization_grant_access_token_lifespan BIGINT NULL DEFAULT NULL, device_authorization_grant_refresh_token_lifespan BIGINT NULL DEFAULT NULL, rotated_secrets JSONB NULL,
+  nid                                             CHAR(36)     NOT NULL, skip_logout_consent BOOLEAN NULL, device_authorization_grant_id_token_lifespan BIGINT NULL DEFAULT NULL, device_authorization_grant_access_token_lifespan BIGINT NULL DEFAULT NULL, device_authorization_grant_refresh_token_lifespan BIGINT NULL DEFAULT NULL, rotated_secrets JSONB NULL, token_claims_mapper_url TEXT NULL, refresh_token_strategy VARCHAR(10) NOT NULL DEFAULT '', default_acr_values JSONB NULL, require_auth_time BOOLEAN NOT NULL DEFAULT false, minimum_acr VARCHAR(255) NOT NULL DEFAULT '',
   PRIMARY KEY (id, nid)
 );
+CREATE TABLE hydra_janitor_lease
+(
+  name        VARCHAR(64)  NOT NULL PRIMARY KEY,
+  holder      VARCHAR(255) NOT NULL DEFAULT '',
+  expires_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  paused      BOOLEAN      NOT NULL DEFAULT FALSE,
+  last_run_at TIMESTAMP    NULL
+);
 CREATE TABLE "hydra_jwk" (
     sid             VARCHAR(255) NOT NULL,
     kid             VARCHAR(255) NOT NULL,
@@ -96,7 +104,7 @@ CREATE TABLE "hydra_oauth2_authentication_session" (
     authenticated_at TIMESTAMP    NULL,
     subject          VARCHAR(255) NOT NULL,
     nid              CHAR(36)     NOT NULL,
-    remember         INTEGER      NOT NULL DEFAULT false, identity_provider_session_id VARCHAR(40), expires_at TIMESTAMP NULL,
+    remember         INTEGER      NOT NULL DEFAULT false, identity_provider_session_id VARCHAR(40), expires_at TIMESTAMP NULL, acr VARCHAR(255) NOT NULL DEFAULT '',
     CHECK (nid != '00000000-0000-0000-0000-000000000000')
 );
 CREATE INDEX hydra_oauth2_authentication_session_subject_idx ON hydra_oauth2_authentication_session (subject, nid);
@@ -119,6 +127,26 @@ CREATE TABLE "hydra_oauth2_code" (
 );
 CREATE INDEX hydra_oauth2_code_challenge_id_idx ON hydra_oauth2_code (challenge_id, nid);
 CREATE INDEX hydra_oauth2_code_client_id_idx ON hydra_oauth2_code (client_id, nid);
+CREATE TABLE hydra_oauth2_consent_history
+(
+  id                 UUID         NOT NULL PRIMARY KEY,
+  nid                UUID         NOT NULL,
+  event              VARCHAR(10)  NOT NULL,
+  subject            VARCHAR(255) NOT NULL,
+  client_id          VARCHAR(255) NOT NULL,
+  consent_request_id VARCHAR(40)  NOT NULL,
+  granted_scope      TEXT         NOT NULL,
+  granted_audience   TEXT         NOT NULL,
+  remember           BOOLEAN      NOT NULL DEFAULT FALSE,
+  remember_for       INTEGER      NOT NULL DEFAULT 0,
+  consented_at       TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at         TIMESTAMP    NULL,
+  revocation_reason  TEXT         NOT NULL,
+  created_at         TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+CREATE INDEX hydra_oauth2_consent_history_subject_idx ON hydra_oauth2_consent_history (nid, subject, created_at, id);
 CREATE TABLE hydra_oauth2_device_auth_codes
 (
   device_code_signature VARCHAR(255)  NOT NULL,
@@ -266,6 +294,23 @@ CREATE TABLE "hydra_oauth2_oidc" (
 );
 CREATE INDEX hydra_oauth2_oidc_challenge_id_idx ON hydra_oauth2_oidc (challenge_id, nid);
 CREATE INDEX hydra_oauth2_oidc_client_id_idx ON hydra_oauth2_oidc (client_id, nid);
+CREATE TABLE hydra_oauth2_pairwise_subject
+(
+  nid                       UUID         NOT NULL,
+  client_id                 VARCHAR(255) NOT NULL,
+  subject                   VARCHAR(255) NOT NULL,
+  pairwise_subject          VARCHAR(255) NOT NULL,
+  previous_pairwise_subject VARCHAR(255) NOT NULL DEFAULT '',
+  sector_identifier         TEXT         NOT NULL,
+  created_at                TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (nid, client_id, subject),
+  FOREIGN KEY (client_id, nid) REFERENCES hydra_client (id, nid) ON DELETE CASCADE,
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX hydra_oauth2_pairwise_subject_pairwise_idx ON hydra_oauth2_pairwise_subject (nid, client_id, pairwise_subject);
+CREATE INDEX hydra_oauth2_pairwise_subject_previous_idx ON hydra_oauth2_pairwise_subject (nid, client_id, previous_pairwise_subject);
+CREATE INDEX hydra_oauth2_pairwise_subject_subject_idx ON hydra_oauth2_pairwise_subject (nid, subject);
 CREATE TABLE "hydra_oauth2_pkce" (
     signature          VARCHAR(255) NOT NULL PRIMARY KEY,
     request_id         VARCHAR(40)  NOT NULL,
@@ -304,24 +349,75 @@ CREATE TABLE "hydra_oauth2_refresh" (
 );
 CREATE INDEX hydra_oauth2_refresh_challenge_id_idx ON hydra_oauth2_refresh (challenge_id, nid);
 CREATE INDEX hydra_oauth2_refresh_client_id_idx ON hydra_oauth2_refresh (client_id, nid);
+CREATE TABLE hydra_oauth2_refresh_deny_list
+(
+  id         VARCHAR(40) NOT NULL,
+  nid        UUID        NOT NULL,
+  expires_at TIMESTAMP   NULL,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE,
+  PRIMARY KEY (id, nid)
+);
+CREATE INDEX hydra_oauth2_refresh_deny_list_expires_at_idx ON hydra_oauth2_refresh_deny_list (nid, expires_at);
 CREATE INDEX hydra_oauth2_refresh_nid_subject_idx ON hydra_oauth2_refresh (nid ASC, subject ASC, client_id ASC);
 CREATE INDEX hydra_oauth2_refresh_request_id_idx ON hydra_oauth2_refresh (request_id, nid);
 CREATE INDEX hydra_oauth2_refresh_requested_at_idx ON hydra_oauth2_refresh (nid, requested_at);
+CREATE TABLE hydra_oauth2_revocation_feed
+(
+  id         UUID        NOT NULL PRIMARY KEY,
+  nid        UUID        NOT NULL,
+  request_id VARCHAR(40) NOT NULL,
+  revoked_at TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+CREATE INDEX hydra_oauth2_revocation_feed_revoked_at_idx ON hydra_oauth2_revocation_feed (nid, revoked_at);
+CREATE TABLE hydra_oauth2_scope
+(
+  id               UUID         NOT NULL PRIMARY KEY,
+  nid              UUID         NOT NULL,
+  name             VARCHAR(255) NOT NULL,
+  description      TEXT         NOT NULL,
+  display_names    TEXT         NOT NULL,
+  sensitivity      VARCHAR(10)  NOT NULL DEFAULT 'low',
+  default_audience TEXT         NOT NULL,
+  requires_consent BOOLEAN      NOT NULL DEFAULT FALSE,
+  created_at       TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX hydra_oauth2_scope_name_idx ON hydra_oauth2_scope (nid, name);
 CREATE TABLE "hydra_oauth2_trusted_jwt_bearer_issuer" (
-    id         VARCHAR(36) PRIMARY KEY,
-    issuer     VARCHAR(255) NOT NULL,
-    subject    VARCHAR(255) NOT NULL,
-    scope      TEXT         NOT NULL,
-    key_set    varchar(255) NOT NULL,
-    key_id     varchar(255) NOT NULL,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    expires_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    nid        CHAR(36)     NOT NULL, allow_any_subject INTEGER NOT NULL DEFAULT FALSE,
-    UNIQUE (issuer, subject, key_id, nid),
+    id                VARCHAR(36)   PRIMARY KEY,
+    issuer            VARCHAR(255)  NOT NULL,
+    subject           VARCHAR(255)  NOT NULL,
+    scope             TEXT          NOT NULL,
+    key_set           VARCHAR(255)  NULL,
+    key_id            VARCHAR(255)  NULL,
+    created_at        TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at        TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    nid               CHAR(36)      NOT NULL,
+    allow_any_subject INTEGER       NOT NULL DEFAULT FALSE,
+    jwks_uri          VARCHAR(2048) NOT NULL DEFAULT '', claim_conditions TEXT NULL, claims_mapper TEXT NULL,
     FOREIGN KEY (key_set, key_id, nid) REFERENCES hydra_jwk (sid, kid, nid) ON DELETE CASCADE
 );
 CREATE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_expires_at_idx ON hydra_oauth2_trusted_jwt_bearer_issuer (expires_at);
 CREATE UNIQUE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_nid_uq_idx ON hydra_oauth2_trusted_jwt_bearer_issuer (nid ASC, key_id ASC, issuer ASC, subject ASC);
+CREATE UNIQUE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_remote_uq_idx ON hydra_oauth2_trusted_jwt_bearer_issuer (nid, issuer, subject) WHERE key_id IS NULL;
+CREATE TABLE hydra_tenant
+(
+  id          UUID         NOT NULL PRIMARY KEY,
+  name        VARCHAR(255) NOT NULL,
+  hosts       TEXT         NOT NULL,
+  path_prefix VARCHAR(255) NOT NULL DEFAULT '',
+  config      TEXT         NOT NULL,
+  created_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (id) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX hydra_tenant_name_idx ON hydra_tenant (name);
 CREATE TABLE "networks" (
   "id" TEXT PRIMARY KEY,
   "created_at" DATETIME NOT NULL,
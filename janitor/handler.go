@@ -0,0 +1,194 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package janitor
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ory/x/httprouterx"
+)
+
+const (
+	JanitorHandlerPath = "/janitor"
+)
+
+// Janitor Status
+//
+// The status of the janitor which deletes expired and inactive records when enabled in
+// the configuration.
+//
+// swagger:model janitorStatus
+type Status struct {
+	// Enabled is true if the janitor is enabled on the node serving the request.
+	Enabled bool `json:"enabled"`
+
+	// Paused is true if the janitor has been paused. A paused janitor does not run on any node.
+	Paused bool `json:"paused"`
+
+	// Healthy is false if the last run on the node serving the request failed, or if no
+	// node completed a run for more than twice the configured interval.
+	Healthy bool `json:"healthy"`
+
+	// Node is the name of the node serving the request.
+	Node string `json:"node"`
+
+	// Leader is the name of the node holding the janitor lease, if any. Only this node runs
+	// the janitor.
+	Leader string `json:"leader,omitempty"`
+
+	// LeaseExpiresAt is the time the leader's lease expires unless it is extended.
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"`
+
+	// LastRunAt is the time the last run completed successfully on any node.
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+
+	// Running is true if the janitor is running on the node serving the request.
+	Running bool `json:"running"`
+
+	// Routine is the cleanup routine which is running on the node serving the request.
+	Routine string `json:"routine,omitempty"`
+
+	// Progress is the share of cleanup routines completed by the current or last run on
+	// the node serving the request, between 0 and 1.
+	Progress float64 `json:"progress"`
+
+	// LastError is the error of the last run on the node serving the request, if it failed.
+	LastError string `json:"last_error,omitempty"`
+}
+
+type Handler struct {
+	r InternalRegistry
+}
+
+func NewHandler(r InternalRegistry) *Handler {
+	return &Handler{r: r}
+}
+
+func (h *Handler) SetRoutes(admin *httprouterx.RouterAdmin) {
+	admin.GET(JanitorHandlerPath, h.getJanitorStatus)
+	admin.GET(JanitorHandlerPath+"/health", h.getJanitorHealth)
+	admin.PUT(JanitorHandlerPath+"/pause", h.pauseJanitor)
+	admin.PUT(JanitorHandlerPath+"/resume", h.resumeJanitor)
+}
+
+// swagger:route GET /admin/janitor janitor getJanitorStatus
+//
+// # Get Janitor Status
+//
+// Returns the status of the janitor. The progress of a run is only reported by the node running it, see the
+// `leader` field.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: janitorStatus
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) getJanitorStatus(w http.ResponseWriter, r *http.Request) {
+	s, err := h.r.Janitor().Status(r.Context())
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, s)
+}
+
+// swagger:route GET /admin/janitor/health janitor getJanitorHealth
+//
+// # Check Janitor Health
+//
+// Returns the status of the janitor with status code 200 if it is healthy, or with status code 503 if the
+// last run on the node serving the request failed or if no node completed a run for more than twice the
+// configured interval. Paused and disabled janitors are reported as healthy.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: janitorStatus
+//	  503: janitorStatus
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) getJanitorHealth(w http.ResponseWriter, r *http.Request) {
+	s, err := h.r.Janitor().Status(r.Context())
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	code := http.StatusOK
+	if !s.Healthy {
+		code = http.StatusServiceUnavailable
+	}
+	h.r.Writer().WriteCode(w, r, code, s)
+}
+
+// swagger:route PUT /admin/janitor/pause janitor pauseJanitor
+//
+// # Pause Janitor
+//
+// Pauses the janitor on all nodes. A running cleanup is canceled within a few seconds. Records which have
+// already been deleted are not restored.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: janitorStatus
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) pauseJanitor(w http.ResponseWriter, r *http.Request) {
+	h.setPaused(w, r, true)
+}
+
+// swagger:route PUT /admin/janitor/resume janitor resumeJanitor
+//
+// # Resume Janitor
+//
+// Resumes a paused janitor. The next run starts once the configured interval elapsed since the last run.
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: janitorStatus
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) resumeJanitor(w http.ResponseWriter, r *http.Request) {
+	h.setPaused(w, r, false)
+}
+
+func (h *Handler) setPaused(w http.ResponseWriter, r *http.Request, paused bool) {
+	if err := h.r.JanitorManager().SetJanitorPaused(r.Context(), paused); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	s, err := h.r.Janitor().Status(r.Context())
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, s)
+}
@@ -0,0 +1,366 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package janitor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// leaseTTL is how long the lease is valid unless it is extended. Nodes
+	// extend their lease every leaseRenewInterval, so if the node running the
+	// janitor stops, another node takes over after at most leaseTTL.
+	leaseTTL           = time.Minute
+	leaseRenewInterval = leaseTTL / 4
+)
+
+var (
+	runs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "hydra",
+		Subsystem: "janitor",
+		Name:      "runs_total",
+		Help:      "Number of janitor runs by result.",
+	}, []string{"result"})
+	runDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "hydra",
+		Subsystem: "janitor",
+		Name:      "run_duration_seconds",
+		Help:      "Duration of janitor runs.",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 8),
+	})
+	lastSuccess = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "hydra",
+		Subsystem: "janitor",
+		Name:      "last_success_timestamp_seconds",
+		Help:      "Time the last successful janitor run on this node finished.",
+	})
+	progress = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "hydra",
+		Subsystem: "janitor",
+		Name:      "progress_ratio",
+		Help:      "Share of cleanup routines completed by the current or last janitor run on this node.",
+	})
+	leader = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "hydra",
+		Subsystem: "janitor",
+		Name:      "leader",
+		Help:      "Whether this node holds the janitor lease.",
+	})
+	paused = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "hydra",
+		Subsystem: "janitor",
+		Name:      "paused",
+		Help:      "Whether the janitor has been paused.",
+	})
+)
+
+// Results reported by the hydra_janitor_runs_total metric.
+const (
+	runResultSuccess  = "success"
+	runResultError    = "error"
+	runResultCanceled = "canceled"
+)
+
+type (
+	// Janitor deletes expired and inactive records periodically, like the
+	// `hydra janitor` command does. If several nodes run the janitor, only the
+	// node holding the janitor lease cleans up, see Watch.
+	Janitor struct {
+		d      InternalRegistry
+		holder string
+
+		mu       sync.RWMutex
+		run      runState
+		watching atomic.Bool
+	}
+	runState struct {
+		routine    string
+		completed  int
+		total      int
+		startedAt  time.Time
+		finishedAt time.Time
+		err        error
+	}
+	routine struct {
+		name string
+		ctx  context.Context
+		run  func(ctx context.Context) error
+	}
+	activeRun struct {
+		cancel context.CancelFunc
+		done   chan struct{}
+	}
+)
+
+func NewJanitor(d InternalRegistry) *Janitor {
+	host, _ := os.Hostname()
+	return &Janitor{d: d, holder: fmt.Sprintf("%s/%s", host, uuid.Must(uuid.NewV4()))}
+}
+
+// Holder returns the name this node uses when holding the lease.
+func (j *Janitor) Holder() string {
+	return j.holder
+}
+
+// Watch runs the janitor every configured interval while this node holds the
+// lease, until the context is canceled. It returns immediately if the janitor
+// is disabled or already being watched.
+//
+// The lease is acquired or extended every leaseRenewInterval. A run is
+// canceled if the lease is lost or the janitor is paused.
+func (j *Janitor) Watch(ctx context.Context) {
+	if !j.d.Config().JanitorEnabled(ctx) || !j.watching.CompareAndSwap(false, true) {
+		return
+	}
+	defer j.watching.Store(false)
+
+	var active *activeRun
+	defer func() {
+		if active != nil {
+			active.cancel()
+			<-active.done
+		}
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+		if err := j.d.JanitorManager().ReleaseJanitorLease(ctx, j.holder); err != nil {
+			j.d.Logger().WithError(err).Warn("Unable to release the janitor lease, another node takes over once it expired.")
+		}
+		leader.Set(0)
+	}()
+
+	ticker := time.NewTicker(leaseRenewInterval)
+	defer ticker.Stop()
+
+	for {
+		if active != nil {
+			select {
+			case <-active.done:
+				active = nil
+			default:
+			}
+		}
+
+		lease, err := j.d.JanitorManager().AcquireJanitorLease(ctx, j.holder, leaseTTL)
+		if err != nil {
+			j.d.Logger().WithError(err).Warn("Unable to acquire the janitor lease.")
+		}
+		held := err == nil && lease.HeldBy(j.holder, time.Now())
+		leader.Set(boolToFloat(held))
+		if err == nil {
+			paused.Set(boolToFloat(lease.Paused))
+		}
+
+		switch {
+		case active != nil && (!held || lease.Paused):
+			active.cancel()
+			<-active.done
+			active = nil
+		case active == nil && held && !lease.Paused && j.due(ctx, lease):
+			active = j.start(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// due returns whether the interval elapsed since the last run on any node,
+// or since the last attempt on this node if it failed.
+func (j *Janitor) due(ctx context.Context, lease *Lease) bool {
+	last := time.Time(lease.LastRunAt)
+	j.mu.RLock()
+	if j.run.startedAt.After(last) {
+		last = j.run.startedAt
+	}
+	j.mu.RUnlock()
+	return time.Since(last) >= j.d.Config().JanitorInterval(ctx)
+}
+
+func (j *Janitor) start(ctx context.Context) *activeRun {
+	ctx, cancel := context.WithCancel(ctx)
+	active := &activeRun{cancel: cancel, done: make(chan struct{})}
+	go func() {
+		defer close(active.done)
+		if err := j.Run(ctx); err != nil {
+			j.d.Logger().WithError(err).Error("The janitor run failed.")
+			return
+		}
+		if err := j.d.JanitorManager().SetJanitorLastRun(ctx, j.holder, time.Now()); err != nil {
+			j.d.Logger().WithError(err).Warn("Unable to record the janitor run, the next run may start early.")
+		}
+	}()
+	return active
+}
+
+// Run deletes expired and inactive records of the default network and, in
+// multi-tenant mode, of all tenants once. It does not acquire the lease.
+func (j *Janitor) Run(ctx context.Context) (err error) {
+	routines, err := j.routines(ctx)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	j.mu.Lock()
+	j.run = runState{total: len(routines), startedAt: start}
+	j.mu.Unlock()
+	progress.Set(0)
+
+	defer func() {
+		result := runResultSuccess
+		if errors.Is(ctx.Err(), context.Canceled) {
+			result = runResultCanceled
+		} else if err != nil {
+			result = runResultError
+		} else {
+			lastSuccess.SetToCurrentTime()
+		}
+		runs.WithLabelValues(result).Inc()
+		runDuration.Observe(time.Since(start).Seconds())
+
+		j.mu.Lock()
+		j.run.routine, j.run.finishedAt, j.run.err = "", time.Now(), err
+		j.mu.Unlock()
+	}()
+
+	for i, r := range routines {
+		j.mu.Lock()
+		j.run.routine, j.run.completed = r.name, i
+		j.mu.Unlock()
+
+		if err := r.run(r.ctx); err != nil {
+			return errors.Wrapf(err, "could not clean up %s", r.name)
+		}
+
+		j.mu.Lock()
+		j.run.completed = i + 1
+		j.mu.Unlock()
+		progress.Set(float64(i+1) / float64(len(routines)))
+	}
+
+	j.d.Logger().
+		WithField("duration", time.Since(start).String()).
+		WithField("routines", len(routines)).
+		Info("The janitor run completed successfully.")
+	return nil
+}
+
+func (j *Janitor) routines(ctx context.Context) ([]routine, error) {
+	c, m := j.d.Config(), j.d.JanitorManager()
+	notAfter := time.Now().Add(-c.JanitorKeepIfYounger(ctx))
+	limit, batchSize := c.JanitorLimit(ctx), c.JanitorBatchSize(ctx)
+
+	networks := []context.Context{ctx}
+	if c.MultitenancyEnabled(ctx) {
+		tenants, err := j.d.TenantResolver().Contexts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, tenants...)
+	}
+
+	flush := func(name string, f func(context.Context, time.Time, int, int) error) []routine {
+		rs := make([]routine, len(networks))
+		for i, ctx := range networks {
+			rs[i] = routine{name: name, ctx: ctx, run: func(ctx context.Context) error {
+				return f(ctx, notAfter, limit, batchSize)
+			}}
+		}
+		return rs
+	}
+
+	var rs []routine
+	if c.JanitorTokens(ctx) {
+		// Partitions are shared by all networks.
+		rs = append(rs, routine{name: "token partitions", ctx: ctx, run: func(ctx context.Context) error {
+			return m.FlushExpiredTokenPartitions(ctx, notAfter)
+		}})
+		rs = append(rs, flush("access tokens", m.FlushInactiveAccessTokens)...)
+		rs = append(rs, flush("refresh tokens", m.FlushInactiveRefreshTokens)...)
+	}
+	if c.JanitorRequests(ctx) {
+		// Partitions are shared by all networks.
+		rs = append(rs, routine{name: "flow partitions", ctx: ctx, run: func(ctx context.Context) error {
+			return m.FlushExpiredFlowPartitions(ctx, notAfter)
+		}})
+		rs = append(rs, flush("login-consent requests", m.FlushInactiveLoginConsentRequests)...)
+	}
+	if c.JanitorGrants(ctx) {
+		rs = append(rs, flush("grants", m.FlushInactiveGrants)...)
+	}
+	return rs, nil
+}
+
+// Status returns the janitor's status. The progress of the current run is
+// only known to the node running it.
+func (j *Janitor) Status(ctx context.Context) (*Status, error) {
+	lease, err := j.d.JanitorManager().GetJanitorLease(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	s := &Status{
+		Enabled: j.d.Config().JanitorEnabled(ctx),
+		Paused:  lease.Paused,
+		Node:    j.holder,
+	}
+	if lease.Holder != "" && lease.ExpiresAt.After(now) {
+		s.Leader = lease.Holder
+		s.LeaseExpiresAt = &lease.ExpiresAt
+	}
+	if t := time.Time(lease.LastRunAt); !t.IsZero() {
+		s.LastRunAt = &t
+	}
+
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	if !j.run.startedAt.IsZero() {
+		s.Running = j.run.finishedAt.IsZero()
+		s.Routine = j.run.routine
+		if j.run.total > 0 {
+			s.Progress = float64(j.run.completed) / float64(j.run.total)
+		}
+		if j.run.err != nil {
+			s.LastError = j.run.err.Error()
+		}
+	}
+
+	s.Healthy = j.healthy(ctx, s, now)
+	return s, nil
+}
+
+// healthy returns false if the last run on this node failed, or if no node
+// completed a run for more than twice the configured interval.
+func (j *Janitor) healthy(ctx context.Context, s *Status, now time.Time) bool {
+	if !s.Enabled || s.Paused {
+		return true
+	} else if s.LastError != "" {
+		return false
+	} else if s.LastRunAt == nil {
+		return true
+	}
+	return now.Sub(*s.LastRunAt) <= 2*j.d.Config().JanitorInterval(ctx)+leaseTTL
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,143 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package janitor_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/handler/openid"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/janitor"
+	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/httprouterx"
+)
+
+func TestLease(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	m := testhelpers.NewRegistryMemory(t).JanitorManager()
+
+	l, err := m.AcquireJanitorLease(ctx, "a", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, l.HeldBy("a", time.Now()))
+
+	l, err = m.AcquireJanitorLease(ctx, "b", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, l.HeldBy("b", time.Now()), "the lease is held by another node")
+	assert.True(t, l.HeldBy("a", time.Now()))
+
+	l, err = m.AcquireJanitorLease(ctx, "a", time.Hour)
+	require.NoError(t, err)
+	assert.True(t, l.ExpiresAt.After(time.Now().Add(time.Minute)), "the holder extends its lease")
+
+	require.NoError(t, m.SetJanitorLastRun(ctx, "b", time.Now()))
+	l, err = m.GetJanitorLease(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, l.LastRunAt, "only the holder records runs")
+
+	require.NoError(t, m.ReleaseJanitorLease(ctx, "a"))
+	l, err = m.AcquireJanitorLease(ctx, "b", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, l.HeldBy("b", time.Now()), "released leases are acquired by other nodes")
+
+	require.NoError(t, m.SetJanitorPaused(ctx, true))
+	l, err = m.GetJanitorLease(ctx)
+	require.NoError(t, err)
+	assert.True(t, l.Paused)
+}
+
+func TestJanitor(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyJanitorEnabled:      true,
+		config.KeyJanitorRequests:     false,
+		config.KeyAccessTokenLifespan: time.Minute,
+	})))
+
+	cl := &client.Client{ID: uuid.Must(uuid.NewV4()).String()}
+	require.NoError(t, reg.Persister().CreateClient(ctx, cl))
+
+	createToken := func(requestedAt time.Time) string {
+		r := fosite.NewRequest()
+		r.ID = uuid.Must(uuid.NewV4()).String()
+		r.Client = cl
+		r.RequestedAt = requestedAt.UTC().Round(time.Second)
+		r.Session = &oauth2.Session{DefaultSession: &openid.DefaultSession{Subject: "sub"}}
+		signature := uuid.Must(uuid.NewV4()).String()
+		require.NoError(t, reg.Persister().CreateAccessTokenSession(ctx, signature, r))
+		return signature
+	}
+	expired, active := createToken(time.Now().Add(-time.Hour)), createToken(time.Now())
+
+	router := httprouterx.NewRouterAdminWithPrefix()
+	janitor.NewHandler(reg).SetRoutes(router)
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	do := func(t *testing.T, method, path string, expectedCode int) janitor.Status {
+		req, err := http.NewRequestWithContext(ctx, method, ts.URL+"/admin/janitor"+path, nil)
+		require.NoError(t, err)
+		res, err := ts.Client().Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, expectedCode, res.StatusCode)
+
+		var s janitor.Status
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&s))
+		return s
+	}
+
+	t.Run("case=run deletes expired records", func(t *testing.T) {
+		require.NoError(t, reg.Janitor().Run(ctx))
+
+		_, err := reg.Persister().GetAccessTokenSession(ctx, expired, &oauth2.Session{})
+		assert.ErrorIs(t, err, fosite.ErrNotFound)
+		_, err = reg.Persister().GetAccessTokenSession(ctx, active, &oauth2.Session{})
+		assert.NoError(t, err)
+
+		s := do(t, "GET", "", http.StatusOK)
+		assert.True(t, s.Enabled)
+		assert.True(t, s.Healthy)
+		assert.False(t, s.Running)
+		assert.Equal(t, 1.0, s.Progress)
+		assert.Empty(t, s.LastError)
+		assert.Equal(t, reg.Janitor().Holder(), s.Node)
+	})
+
+	t.Run("case=janitor is paused and resumed", func(t *testing.T) {
+		assert.True(t, do(t, "PUT", "/pause", http.StatusOK).Paused)
+		assert.True(t, do(t, "GET", "/health", http.StatusOK).Paused)
+		assert.False(t, do(t, "PUT", "/resume", http.StatusOK).Paused)
+	})
+
+	t.Run("case=failed runs are unhealthy", func(t *testing.T) {
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		require.Error(t, reg.Janitor().Run(canceled))
+
+		s := do(t, "GET", "/health", http.StatusServiceUnavailable)
+		assert.False(t, s.Healthy)
+		assert.NotEmpty(t, s.LastError)
+
+		require.NoError(t, reg.Janitor().Run(ctx))
+		do(t, "GET", "/health", http.StatusOK)
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package janitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/ory/x/sqlxx"
+)
+
+// Lease is held by the node running the janitor. It is shared by all networks.
+type Lease struct {
+	Name      string         `db:"name"`
+	Holder    string         `db:"holder"`
+	ExpiresAt time.Time      `db:"expires_at"`
+	Paused    bool           `db:"paused"`
+	LastRunAt sqlxx.NullTime `db:"last_run_at"`
+}
+
+func (Lease) TableName() string {
+	return "hydra_janitor_lease"
+}
+
+// HeldBy returns whether the lease is held by the holder at the given time.
+func (l *Lease) HeldBy(holder string, now time.Time) bool {
+	return l.Holder == holder && l.ExpiresAt.After(now)
+}
+
+type Manager interface {
+	// AcquireJanitorLease acquires the lease for the holder, or extends it if
+	// the holder already holds it. The returned lease is held by another node
+	// if that node's lease has not expired yet.
+	AcquireJanitorLease(ctx context.Context, holder string, ttl time.Duration) (*Lease, error)
+	// ReleaseJanitorLease releases the lease if it is held by the holder.
+	ReleaseJanitorLease(ctx context.Context, holder string) error
+	GetJanitorLease(ctx context.Context) (*Lease, error)
+	// SetJanitorPaused pauses or resumes the janitor on all nodes.
+	SetJanitorPaused(ctx context.Context, paused bool) error
+	// SetJanitorLastRun records when the holder last completed a run.
+	SetJanitorLastRun(ctx context.Context, holder string, at time.Time) error
+
+	FlushExpiredTokenPartitions(ctx context.Context, notAfter time.Time) error
+	FlushExpiredFlowPartitions(ctx context.Context, notAfter time.Time) error
+	FlushInactiveAccessTokens(ctx context.Context, notAfter time.Time, limit int, batchSize int) error
+	FlushInactiveRefreshTokens(ctx context.Context, notAfter time.Time, limit int, batchSize int) error
+	FlushInactiveLoginConsentRequests(ctx context.Context, notAfter time.Time, limit int, batchSize int) error
+	FlushInactiveGrants(ctx context.Context, notAfter time.Time, limit int, batchSize int) error
+}
@@ -0,0 +1,24 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package janitor
+
+import (
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/tenant"
+	"github.com/ory/x/httpx"
+	"github.com/ory/x/logrusx"
+)
+
+type InternalRegistry interface {
+	httpx.WriterProvider
+	logrusx.Provider
+	config.Provider
+	tenant.Registry
+	Registry
+}
+
+type Registry interface {
+	Janitor() *Janitor
+	JanitorManager() Manager
+}
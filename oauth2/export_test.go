@@ -0,0 +1,13 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+// WaitForHookCaches blocks until all buffered hook cache writes are applied.
+func WaitForHookCaches() {
+	hookCaches.Lock()
+	defer hookCaches.Unlock()
+	for _, wait := range hookCaches.wait {
+		wait()
+	}
+}
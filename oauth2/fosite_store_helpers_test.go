@@ -443,6 +443,107 @@ func testHelperRevokeAccessToken(x *driver.RegistrySQL) func(t *testing.T) {
 	}
 }
 
+func testHelperJWERefreshTokens(x *driver.RegistrySQL) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx := t.Context()
+		m := x.OAuth2Storage()
+		mockRequestForeignKey(t, "", x)
+
+		newToken := func(t *testing.T, r *fosite.Request) string {
+			_, signature, err := x.OAuth2JWERefreshTokenStrategy().GenerateRefreshToken(ctx, r)
+			require.NoError(t, err)
+			require.NoError(t, m.CreateRefreshTokenSession(ctx, signature, "", r))
+			return signature
+		}
+
+		t.Run("case=restores the request from the token", func(t *testing.T) {
+			r := newDefaultRequest(t, uuid.Must(uuid.NewV4()).String())
+			signature := newToken(t, r)
+
+			res, err := m.GetRefreshTokenSession(ctx, signature, oauth2.NewTestSession(t, "bar"))
+			require.NoError(t, err)
+			assert.Equal(t, r.GetID(), res.GetID())
+			assert.Equal(t, r.GetClient().GetID(), res.GetClient().GetID())
+			AssertObjectKeysEqual(t, r, res, "RequestedScope", "GrantedScope", "RequestedAudience", "GrantedAudience", "Session")
+		})
+
+		t.Run("case=rotation denies the token and removes the access tokens", func(t *testing.T) {
+			r := newDefaultRequest(t, uuid.Must(uuid.NewV4()).String())
+			signature := newToken(t, r)
+			accessSignature := uuid.Must(uuid.NewV4()).String()
+			require.NoError(t, m.CreateAccessTokenSession(ctx, accessSignature, r))
+
+			require.NoError(t, m.RotateRefreshToken(ctx, r.GetID(), signature))
+
+			_, err := m.GetAccessTokenSession(ctx, accessSignature, nil)
+			assert.ErrorIs(t, err, fosite.ErrNotFound)
+
+			res, err := m.GetRefreshTokenSession(ctx, signature, oauth2.NewTestSession(t, "bar"))
+			assert.ErrorIs(t, err, fosite.ErrInactiveToken)
+			require.NotNil(t, res, "the request is needed for reuse detection")
+			assert.Equal(t, r.GetID(), res.GetID())
+
+			assert.ErrorIs(t, m.RotateRefreshToken(ctx, r.GetID(), signature), fosite.ErrSerializationFailure)
+
+			next := newToken(t, r)
+			_, err = m.GetRefreshTokenSession(ctx, next, oauth2.NewTestSession(t, "bar"))
+			require.NoError(t, err, "tokens issued by the rotation stay valid")
+		})
+
+		t.Run("case=revocation denies all tokens of the request", func(t *testing.T) {
+			r := newDefaultRequest(t, uuid.Must(uuid.NewV4()).String())
+			first, second := newToken(t, r), newToken(t, r)
+
+			require.NoError(t, m.RevokeRefreshToken(ctx, r.GetID()))
+			require.NoError(t, m.RevokeRefreshToken(ctx, r.GetID()), "revoking twice is fine")
+
+			for _, signature := range []string{first, second} {
+				_, err := m.GetRefreshTokenSession(ctx, signature, oauth2.NewTestSession(t, "bar"))
+				assert.ErrorIs(t, err, fosite.ErrNotFound, "tokens of revoked requests are not reported as reused")
+			}
+
+			require.NoError(t, m.RotateRefreshToken(ctx, r.GetID(), first))
+			_, err := m.GetRefreshTokenSession(ctx, first, oauth2.NewTestSession(t, "bar"))
+			assert.ErrorIs(t, err, fosite.ErrInactiveToken, "rotated tokens are reported as reused")
+		})
+
+		t.Run("case=deletion denies the token", func(t *testing.T) {
+			r := newDefaultRequest(t, uuid.Must(uuid.NewV4()).String())
+			signature, other := newToken(t, r), newToken(t, r)
+
+			require.NoError(t, m.DeleteRefreshTokenSession(ctx, signature))
+			require.NoError(t, m.DeleteRefreshTokenSession(ctx, signature), "deleting twice is fine")
+
+			_, err := m.GetRefreshTokenSession(ctx, signature, oauth2.NewTestSession(t, "bar"))
+			assert.ErrorIs(t, err, fosite.ErrInactiveToken)
+			_, err = m.GetRefreshTokenSession(ctx, other, oauth2.NewTestSession(t, "bar"))
+			assert.NoError(t, err)
+		})
+
+		t.Run("case=tampered tokens are not found", func(t *testing.T) {
+			signature := newToken(t, newDefaultRequest(t, uuid.Must(uuid.NewV4()).String()))
+
+			_, err := m.GetRefreshTokenSession(ctx, signature[:len(signature)-4]+"AAAA", oauth2.NewTestSession(t, "bar"))
+			assert.ErrorIs(t, err, fosite.ErrNotFound)
+		})
+
+		t.Run("case=flush removes deny-list entries of expired tokens", func(t *testing.T) {
+			r := newDefaultRequest(t, uuid.Must(uuid.NewV4()).String())
+			r.Session.SetExpiresAt(fosite.RefreshToken, time.Now().Add(-time.Minute))
+			signature := newToken(t, r)
+
+			require.NoError(t, m.RotateRefreshToken(ctx, r.GetID(), signature))
+			_, err := m.GetRefreshTokenSession(ctx, signature, oauth2.NewTestSession(t, "bar"))
+			require.ErrorIs(t, err, fosite.ErrInactiveToken)
+
+			require.NoError(t, m.FlushInactiveRefreshTokens(ctx, time.Now(), 100, 10))
+			_, err = m.GetRefreshTokenSession(ctx, signature, oauth2.NewTestSession(t, "bar"))
+			require.NoError(t, err, "the token is rejected because it expired, the deny-list entry is no longer needed")
+			assert.ErrorIs(t, x.OAuth2JWERefreshTokenStrategy().ValidateRefreshToken(ctx, r, signature), fosite.ErrTokenExpired)
+		})
+	}
+}
+
 func testHelperRotateRefreshToken(x *driver.RegistrySQL) func(t *testing.T) {
 	return func(t *testing.T) {
 		ctx := t.Context()
@@ -62,6 +62,7 @@ func TestManagers(t *testing.T) {
 					t.Run("testHelperRevokeAccessToken", testHelperRevokeAccessToken(store))
 					t.Run("testFositeJWTBearerGrantStorage", testFositeJWTBearerGrantStorage(store))
 					t.Run("testHelperRotateRefreshToken", testHelperRotateRefreshToken(store))
+					t.Run("testHelperJWERefreshTokens", testHelperJWERefreshTokens(store))
 					t.Run("testHelperRefreshTokenExpiryUpdate", testHelperRefreshTokenExpiryUpdate(store))
 					t.Run("testHelperAuthorizeCodeInvalidation", testHelperAuthorizeCodeInvalidation(store))
 				})
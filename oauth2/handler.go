@@ -10,12 +10,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"html/template"
+	"mime"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strings"
 	"time"
 
+	"github.com/dgraph-io/ristretto/v2"
 	jwtV5 "github.com/golang-jwt/jwt/v5"
 	"github.com/pborman/uuid"
 	"github.com/pkg/errors"
@@ -27,12 +29,14 @@ import (
 	"github.com/ory/hydra/v2/flow"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/fosite/handler/openid"
+	"github.com/ory/hydra/v2/fosite/i18n"
 	"github.com/ory/hydra/v2/fosite/token/jwt"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/hydra/v2/x/events"
 	"github.com/ory/x/httprouterx"
 	"github.com/ory/x/josex"
 	"github.com/ory/x/otelx"
+	"github.com/ory/x/stringslice"
 	"github.com/ory/x/urlx"
 )
 
@@ -61,6 +65,14 @@ const (
 
 	DeviceAuthPath         = "/oauth2/device/auth"
 	DeviceVerificationPath = "/oauth2/device/verify"
+
+	// IntrospectionJWTContentType is the media type of signed introspection
+	// responses as defined in RFC 9701.
+	IntrospectionJWTContentType = "application/token-introspection+jwt"
+
+	// registeredScopesCacheTTL is how long the names of the registered scopes
+	// listed in the discovery documents are cached.
+	registeredScopesCacheTTL = time.Minute
 )
 
 // Taken from https://github.com/ory/hydra/v2/fosite/blob/049ed1924cd0b41f12357b0fe617530c264421ac/handler/openid/flow_explicit_auth.go#L29
@@ -76,12 +88,26 @@ var oidcParameters = []string{
 type Handler struct {
 	r InternalRegistry
 	c *config.DefaultProvider
+
+	userinfoHook *hookGuard[UserinfoHookResponse]
+	templates    *x.TemplateLoader
+
+	// registeredScopes caches the names of the registered scopes per network.
+	registeredScopes *ristretto.Cache[string, []string]
 }
 
 func NewHandler(r InternalRegistry) *Handler {
+	registeredScopes, _ := ristretto.NewCache(&ristretto.Config[string, []string]{
+		NumCounters: 10_000,
+		MaxCost:     1_000_000, // scope names
+		BufferItems: 64,
+	})
 	return &Handler{
-		r: r,
-		c: r.Config(),
+		r:                r,
+		c:                r.Config(),
+		userinfoHook:     newHookGuard[UserinfoHookResponse]("userinfo", "userinfo hook"),
+		templates:        x.NewTemplateLoader(),
+		registeredScopes: registeredScopes,
 	}
 }
 
@@ -329,6 +355,11 @@ type oidcConfiguration struct {
 	// values for. Note that for privacy or other reasons, this might not be an exhaustive list.
 	ClaimsSupported []string `json:"claims_supported"`
 
+	// OpenID Connect Supported Authentication Context Class References
+	//
+	// JSON array containing a list of the Authentication Context Class References that this OP supports.
+	ACRValuesSupported []string `json:"acr_values_supported,omitempty"`
+
 	// OAuth 2.0 Supported Grant Types
 	//
 	// JSON array containing a list of the OAuth 2.0 Grant Type values that this OP supports.
@@ -516,6 +547,12 @@ func (h *Handler) discoverOidcConfiguration(w http.ResponseWriter, r *http.Reque
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
+	registeredScopes, err := h.registeredScopeNames(ctx)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	scopesSupported := append(h.c.OIDCDiscoverySupportedScope(ctx), registeredScopes...)
 	h.r.Writer().Write(w, r, &oidcConfiguration{
 		Issuer:                                 h.c.IssuerURL(ctx).String(),
 		AuthURL:                                h.c.OAuth2AuthURL(ctx).String(),
@@ -527,7 +564,8 @@ func (h *Handler) discoverOidcConfiguration(w http.ResponseWriter, r *http.Reque
 		SubjectTypes:                           h.c.SubjectTypesSupported(ctx),
 		ResponseTypes:                          []string{"code", "code id_token", "id_token", "token id_token", "token", "token id_token code"},
 		ClaimsSupported:                        h.c.OIDCDiscoverySupportedClaims(ctx),
-		ScopesSupported:                        h.c.OIDCDiscoverySupportedScope(ctx),
+		ACRValuesSupported:                     h.c.ACRValuesSupported(ctx),
+		ScopesSupported:                        stringslice.Unique(scopesSupported),
 		UserinfoEndpoint:                       h.c.OIDCDiscoveryUserinfoEndpoint(ctx).String(),
 		TokenEndpointAuthMethodsSupported:      []string{"client_secret_post", "client_secret_basic", "private_key_jwt", "none"},
 		IDTokenSigningAlgValuesSupported:       []string{key.Algorithm},
@@ -538,6 +576,7 @@ func (h *Handler) discoverOidcConfiguration(w http.ResponseWriter, r *http.Reque
 		UserinfoSigningAlgValuesSupported:      []string{"none", key.Algorithm},
 		RequestParameterSupported:              true,
 		RequestURIParameterSupported:           true,
+		ClaimsParameterSupported:               true,
 		RequireRequestURIRegistration:          true,
 		BackChannelLogoutSupported:             true,
 		BackChannelLogoutSessionSupported:      true,
@@ -561,6 +600,28 @@ func (h *Handler) discoverOidcConfiguration(w http.ResponseWriter, r *http.Reque
 	})
 }
 
+// registeredScopeNames returns the names of the scopes registered in the scope
+// registry. The discovery documents are requested often and the registry may
+// be large, so the names are cached for a while; changes to the registry show
+// up in the documents once the cached names expire.
+func (h *Handler) registeredScopeNames(ctx context.Context) ([]string, error) {
+	key := h.r.Networker().NetworkID(ctx).String()
+	if names, ok := h.registeredScopes.Get(key); ok {
+		return names, nil
+	}
+
+	registered, err := h.r.ScopeManager().ListScopes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(registered))
+	for k, s := range registered {
+		names[k] = s.Name
+	}
+	h.registeredScopes.SetWithTTL(key, names, int64(len(names))+1, registeredScopesCacheTTL)
+	return names, nil
+}
+
 // OpenID Connect Userinfo
 //
 // swagger:model oidcUserInfo
@@ -630,6 +691,9 @@ type _ struct {
 // This endpoint returns the payload of the ID Token, including `session.id_token` values, of
 // the provided OAuth 2.0 Access Token's consent request.
 //
+// If a userinfo hook is configured, the claims are instead fetched from the hook on every request
+// and filtered by the granted scopes. The hook may also return aggregated and distributed claims.
+//
 // In the case of authentication error, a WWW-Authenticate header might be set in the response
 // with more information about the error. See [the spec](https://datatracker.ietf.org/doc/html/rfc6750#section-3)
 // for more details about header format.
@@ -674,7 +738,15 @@ func (h *Handler) getOidcUserInfo(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	interim := ar.GetSession().(*Session).IDTokenClaims().ToMap()
+	grantedSession := ar.GetSession().(*Session)
+	idTokenClaims := *grantedSession.IDTokenClaims()
+	if grantedSession.ClaimsRequest != nil {
+		// The claims request may ask for different claims in the ID token and
+		// from the userinfo endpoint.
+		idTokenClaims.Extra = grantedSession.UserinfoClaims
+	}
+
+	interim := idTokenClaims.ToMap()
 	delete(interim, "nonce")
 	delete(interim, "at_hash")
 	delete(interim, "c_hash")
@@ -699,6 +771,13 @@ func (h *Handler) getOidcUserInfo(w http.ResponseWriter, r *http.Request) {
 	}
 	interim["aud"] = aud
 
+	if hookClaims, err := h.userinfoFromHook(ctx, ar, grantedSession, interim); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	} else if hookClaims != nil {
+		interim = hookClaims
+	}
+
 	if c.UserinfoSignedResponseAlg == "RS256" {
 		interim["jti"] = uuid.New()
 		interim["iat"] = time.Now().Unix()
@@ -777,6 +856,12 @@ func (h *Handler) performOAuth2DeviceVerificationFlow(w http.ResponseWriter, r *
 		return
 	}
 
+	// The user code may have been rejected by an administrator while the end-user was logging in.
+	if req.GetUserCodeState() == fosite.UserCodeRejected {
+		h.r.Writer().WriteError(w, r, errors.WithStack(fosite.ErrAccessDenied.WithHint("The 'user_code' has been rejected.")))
+		return
+	}
+
 	req.SetUserCodeState(fosite.UserCodeAccepted)
 	session, err := h.updateSessionWithRequest(ctx, f, r, req, req.GetSession().(*Session))
 	if err != nil {
@@ -947,6 +1032,7 @@ func (h *Handler) revokeOAuth2Token(w http.ResponseWriter, r *http.Request) {
 	})
 	if err != nil {
 		x.LogError(r, err, h.r.Logger())
+		observeTokenError(r, metricsEndpointRevocation, err)
 	} else {
 		events.Trace(ctx, events.AccessTokenRevoked)
 	}
@@ -982,6 +1068,10 @@ type _ struct {
 // is neither expired nor revoked. If a token is active, additional information on the token will be included. You can
 // set additional data for a token by setting `session.access_token` during the consent flow.
 //
+// If the request accepts `application/token-introspection+jwt`, the response is a JWT signed with the
+// `hydra.jwt.introspection` key set as defined in RFC 9701. The resource server must authenticate
+// with its OAuth 2.0 Client credentials, and its client ID is used as the audience of the JWT.
+//
 //	Consumes:
 //	- application/x-www-form-urlencoded
 //
@@ -1012,78 +1102,181 @@ func (h *Handler) introspectOAuth2Token(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	// RFC 9701 requires the resource server to authenticate to receive a
+	// signed response, which is addressed to it.
+	var audience string
+	if acceptsIntrospectionJWT(r) {
+		c, err := h.r.OAuth2Provider().AuthenticateClient(ctx, r, r.PostForm)
+		if err != nil {
+			x.LogError(r, err, h.r.Logger())
+			h.r.OAuth2Provider().WriteIntrospectionError(ctx, w, err)
+			return
+		}
+		audience = c.GetID()
+	}
+
 	token := r.PostForm.Get("token")
 	tokenType := r.PostForm.Get("token_type_hint")
-	scope := r.PostForm.Get("scope")
+	scopes := strings.Split(r.PostForm.Get("scope"), " ")
 
-	tt, ar, err := h.r.OAuth2Provider().IntrospectToken(ctx, token, fosite.TokenType(tokenType), session, strings.Split(scope, " ")...)
-	if err != nil {
-		x.LogError(r, err, h.r.Logger())
-		err := errors.WithStack(fosite.ErrInactiveToken.WithHint("An introspection strategy indicated that the token is inactive.").WithDebug(err.Error()))
-		h.r.OAuth2Provider().WriteIntrospectionError(ctx, w, err)
-		return
+	cache := h.r.OAuth2IntrospectionCache()
+	result, ok := cache.get(ctx, token)
+	if ok {
+		strategy := h.c.GetScopeStrategy(ctx)
+		for _, scope := range scopes {
+			if scope != "" && !strategy(result.grantedScopes, scope) {
+				h.writeInactiveIntrospection(w, r, audience, errors.WithStack(fosite.ErrInvalidScope.WithHintf("The request scope '%s' has not been granted or is not allowed to be requested.", scope)))
+				return
+			}
+		}
+	} else {
+		tt, ar, err := h.r.OAuth2Provider().IntrospectToken(ctx, token, fosite.TokenType(tokenType), session, scopes...)
+		if err != nil {
+			h.writeInactiveIntrospection(w, r, audience, err)
+			return
+		}
+
+		resp := &fosite.IntrospectionResponse{
+			Active:          true,
+			AccessRequester: ar,
+			TokenUse:        tt,
+			AccessTokenType: "Bearer",
+		}
+
+		exp := resp.GetAccessRequester().GetSession().GetExpiresAt(tt)
+		if exp.IsZero() {
+			if tt == fosite.RefreshToken {
+				exp = resp.GetAccessRequester().GetRequestedAt().Add(h.c.GetRefreshTokenLifespan(ctx))
+			} else {
+				exp = resp.GetAccessRequester().GetRequestedAt().Add(h.c.GetAccessTokenLifespan(ctx))
+			}
+		}
+
+		session, ok := resp.GetAccessRequester().GetSession().(*Session)
+		if !ok {
+			err := errors.WithStack(fosite.ErrServerError.WithHint("Expected session to be of type *Session, but got another type.").WithDebug(fmt.Sprintf("Got type %s", reflect.TypeOf(resp.GetAccessRequester().GetSession()))))
+			x.LogError(r, err, h.r.Logger())
+			h.r.OAuth2Provider().WriteIntrospectionError(ctx, w, err)
+			return
+		}
+
+		var obfuscated string
+		if len(session.Claims.Subject) > 0 && session.Claims.Subject != session.Subject {
+			obfuscated = session.Claims.Subject
+		}
+
+		var authTime int64
+		if !session.Claims.AuthTime.IsZero() {
+			authTime = session.Claims.AuthTime.Unix()
+		}
+
+		audience := resp.GetAccessRequester().GetGrantedAudience()
+		if audience == nil {
+			// prevent null
+			audience = fosite.Arguments{}
+		}
+
+		result = &cachedIntrospection{
+			requestID:     resp.GetAccessRequester().GetID(),
+			tokenUse:      tt,
+			grantedScopes: resp.GetAccessRequester().GetGrantedScopes(),
+			introspection: Introspection{
+				Active:            resp.IsActive(),
+				ClientID:          resp.GetAccessRequester().GetClient().GetID(),
+				Scope:             strings.Join(resp.GetAccessRequester().GetGrantedScopes(), " "),
+				ExpiresAt:         exp.Unix(),
+				IssuedAt:          resp.GetAccessRequester().GetRequestedAt().Unix(),
+				Subject:           session.GetSubject(),
+				Username:          session.GetUsername(),
+				Extra:             session.Extra,
+				ScopeParameters:   h.scopeParameters(ctx, resp.GetAccessRequester().GetClient(), resp.GetAccessRequester().GetGrantedScopes()),
+				Audience:          audience,
+				Issuer:            h.c.IssuerURL(ctx).String(),
+				ObfuscatedSubject: obfuscated,
+				TokenType:         resp.GetAccessTokenType(),
+				TokenUse:          string(resp.GetTokenUse()),
+				NotBefore:         resp.GetAccessRequester().GetRequestedAt().Unix(),
+				ACR:               session.Claims.AuthenticationContextClassReference,
+				AMR:               session.Claims.AuthenticationMethodsReferences,
+				AuthTime:          authTime,
+			},
+		}
+		cache.set(ctx, token, result)
 	}
 
-	resp := &fosite.IntrospectionResponse{
-		Active:          true,
-		AccessRequester: ar,
-		TokenUse:        tt,
-		AccessTokenType: "Bearer",
+	if acceptsIntrospectionJWT(r) {
+		h.writeIntrospectionJWT(w, r, audience, &result.introspection)
+	} else {
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		if err := json.NewEncoder(w).Encode(&result.introspection); err != nil {
+			x.LogError(r, errors.WithStack(err), h.r.Logger())
+		}
 	}
 
-	exp := resp.GetAccessRequester().GetSession().GetExpiresAt(tt)
-	if exp.IsZero() {
-		if tt == fosite.RefreshToken {
-			exp = resp.GetAccessRequester().GetRequestedAt().Add(h.c.GetRefreshTokenLifespan(ctx))
-		} else {
-			exp = resp.GetAccessRequester().GetRequestedAt().Add(h.c.GetAccessTokenLifespan(ctx))
+	events.Trace(ctx,
+		events.AccessTokenInspected,
+		events.WithSubject(result.introspection.Subject),
+		events.WithClientID(result.introspection.ClientID),
+	)
+}
+
+// writeInactiveIntrospection responds that the token is inactive.
+func (h *Handler) writeInactiveIntrospection(w http.ResponseWriter, r *http.Request, audience string, err error) {
+	x.LogError(r, err, h.r.Logger())
+
+	if acceptsIntrospectionJWT(r) {
+		h.writeIntrospectionJWT(w, r, audience, map[string]bool{"active": false})
+		return
+	}
+
+	err = errors.WithStack(fosite.ErrInactiveToken.WithHint("An introspection strategy indicated that the token is inactive.").WithDebug(err.Error()))
+	h.r.OAuth2Provider().WriteIntrospectionError(r.Context(), w, err)
+}
+
+// acceptsIntrospectionJWT reports whether the resource server asked for a
+// signed introspection response as defined in RFC 9701.
+func acceptsIntrospectionJWT(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaType := range strings.Split(accept, ",") {
+			if mt, _, err := mime.ParseMediaType(mediaType); err == nil && mt == IntrospectionJWTContentType {
+				return true
+			}
 		}
 	}
+	return false
+}
 
-	session, ok := resp.GetAccessRequester().GetSession().(*Session)
-	if !ok {
-		err := errors.WithStack(fosite.ErrServerError.WithHint("Expected session to be of type *Session, but got another type.").WithDebug(fmt.Sprintf("Got type %s", reflect.TypeOf(resp.GetAccessRequester().GetSession()))))
+// writeIntrospectionJWT responds with the introspection response wrapped in
+// a JWT signed with the introspection key set, as defined in RFC 9701. The
+// audience is the client ID of the resource server which introspected the
+// token.
+func (h *Handler) writeIntrospectionJWT(w http.ResponseWriter, r *http.Request, audience string, introspection any) {
+	ctx := r.Context()
+
+	keyID, err := h.r.IntrospectionJWTSigner().GetPublicKeyID(ctx)
+	if err != nil {
 		x.LogError(r, err, h.r.Logger())
-		h.r.OAuth2Provider().WriteIntrospectionError(ctx, w, err)
+		h.r.Writer().WriteError(w, r, err)
 		return
 	}
 
-	var obfuscated string
-	if len(session.Claims.Subject) > 0 && session.Claims.Subject != session.Subject {
-		obfuscated = session.Claims.Subject
-	}
-
-	audience := resp.GetAccessRequester().GetGrantedAudience()
-	if audience == nil {
-		// prevent null
-		audience = fosite.Arguments{}
-	}
-
-	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
-	if err = json.NewEncoder(w).Encode(&Introspection{
-		Active:            resp.IsActive(),
-		ClientID:          resp.GetAccessRequester().GetClient().GetID(),
-		Scope:             strings.Join(resp.GetAccessRequester().GetGrantedScopes(), " "),
-		ExpiresAt:         exp.Unix(),
-		IssuedAt:          resp.GetAccessRequester().GetRequestedAt().Unix(),
-		Subject:           session.GetSubject(),
-		Username:          session.GetUsername(),
-		Extra:             session.Extra,
-		Audience:          audience,
-		Issuer:            h.c.IssuerURL(ctx).String(),
-		ObfuscatedSubject: obfuscated,
-		TokenType:         resp.GetAccessTokenType(),
-		TokenUse:          string(resp.GetTokenUse()),
-		NotBefore:         resp.GetAccessRequester().GetRequestedAt().Unix(),
-	}); err != nil {
-		x.LogError(r, errors.WithStack(err), h.r.Logger())
+	token, _, err := h.r.IntrospectionJWTSigner().Generate(ctx, jwt.MapClaims{
+		"iss":                 h.c.IssuerURL(ctx).String(),
+		"aud":                 audience,
+		"iat":                 time.Now().Unix(),
+		"token_introspection": introspection,
+	}, &jwt.Headers{
+		Extra: map[string]interface{}{"kid": keyID, "typ": "token-introspection+jwt"},
+	})
+	if err != nil {
+		x.LogError(r, err, h.r.Logger())
+		h.r.Writer().WriteError(w, r, err)
+		return
 	}
 
-	events.Trace(ctx,
-		events.AccessTokenInspected,
-		events.WithSubject(session.GetSubject()),
-		events.WithClientID(resp.GetAccessRequester().GetClient().GetID()),
-	)
+	w.Header().Set("Content-Type", IntrospectionJWTContentType)
+	w.Header().Set("Cache-Control", "no-store")
+	_, _ = w.Write([]byte(token))
 }
 
 // OAuth 2.0 Token Exchange Parameters
@@ -1168,6 +1361,7 @@ func (h *Handler) oauth2TokenExchange(w http.ResponseWriter, r *http.Request) {
 	accessRequest, err := h.r.OAuth2Provider().NewAccessRequest(ctx, r, session)
 	if err != nil {
 		x.LogError(r, err, h.r.Logger())
+		observeTokenError(r, metricsEndpointToken, err)
 		h.r.OAuth2Provider().WriteAccessError(ctx, w, accessRequest, err)
 		// NewAccessRequest sometimes returns the accessRequest even if an error occurs
 		// If that is the case, we want to log it to get information about the client
@@ -1245,6 +1439,11 @@ func (h *Handler) oauth2TokenExchange(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// The granted scopes may have been narrowed during the refresh token grant.
+	if session, ok := accessRequest.GetSession().(*Session); ok {
+		session.ScopeParameters = h.scopeParameters(ctx, accessRequest.GetClient(), accessRequest.GetGrantedScopes())
+	}
+
 	var accessResponse fosite.AccessResponder
 	if err := h.r.Transaction(ctx, func(ctx context.Context) (err error) {
 		accessResponse, err = h.r.OAuth2Provider().NewAccessResponse(ctx, accessRequest)
@@ -1256,6 +1455,9 @@ func (h *Handler) oauth2TokenExchange(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if grantTypes := accessRequest.GetGrantTypes(); len(grantTypes) > 0 {
+		observeTokenIssued(grantTypes[0], accessRequest.GetClient())
+	}
 	h.r.OAuth2Provider().WriteAccessResponse(ctx, w, accessRequest, accessResponse)
 }
 
@@ -1316,7 +1518,10 @@ func (h *Handler) oAuth2Authorize(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	h.r.OAuth2Provider().WriteAuthorizeResponse(ctx, w, authorizeRequest, response)
+	if response.GetParameters().Get("access_token") != "" {
+		observeTokenIssued("implicit", authorizeRequest.GetClient())
+	}
+	h.r.OAuth2Provider().WriteAuthorizeResponse(x.WithTemplateRequest(ctx, r), w, authorizeRequest, response)
 }
 
 // Delete OAuth 2.0 Access Token Parameters
@@ -1367,7 +1572,10 @@ func (h *Handler) deleteOAuth2Token(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) handleOptions(http.ResponseWriter, *http.Request) {}
 
 func (h *Handler) forwardError(w http.ResponseWriter, r *http.Request, err error) {
-	rfcErr := fosite.ErrorToRFC6749Error(err).WithExposeDebug(h.c.GetSendDebugMessagesToClients(r.Context()))
+	catalog := h.r.OAuth2ProviderConfig().GetMessageCatalog(r.Context())
+	rfcErr := fosite.ErrorToRFC6749Error(err).
+		WithExposeDebug(h.c.GetSendDebugMessagesToClients(r.Context())).
+		WithLocalizer(catalog, i18n.GetLangFromRequest(catalog, r))
 	query := rfcErr.ToValues()
 	http.Redirect(w, r, urlx.CopyWithQuery(h.c.ErrorURL(r.Context()), query).String(), http.StatusFound)
 }
@@ -1378,7 +1586,13 @@ func (h *Handler) writeAuthorizeError(w http.ResponseWriter, r *http.Request, ar
 		return
 	}
 
-	h.r.OAuth2Provider().WriteAuthorizeError(r.Context(), w, ar, err)
+	h.r.OAuth2Provider().WriteAuthorizeError(x.WithTemplateRequest(r.Context(), r), w, ar, err)
+}
+
+// scopeParameters returns the parameters of the scopes which are instances of a
+// configured scope template or of a scope template allowed to the client.
+func (h *Handler) scopeParameters(ctx context.Context, c fosite.Client, scopes []string) map[string]map[string]string {
+	return fosite.ScopeParameters(append(h.c.GetScopeTemplates(ctx), fosite.ParseScopeTemplates(c.GetScopes())...), scopes)
 }
 
 // updateSessionWithRequest takes a session and a fosite.request as input and returns a new session.
@@ -1437,6 +1651,10 @@ func (h *Handler) updateSessionWithRequest(
 		// This is set by the fosite strategy
 		// ExpiresAt:   time.Now().Add(h.IDTokenLifespan).UTC(),
 	}
+	claimsRequest := flow.ClaimsRequest()
+	if claimsRequest != nil {
+		claims.Extra = claimsRequest.IDTokenClaims(flow.SessionIDToken, request.GetGrantedScopes())
+	}
 	claims.Add("sid", flow.SessionID)
 
 	if session == nil {
@@ -1459,6 +1677,12 @@ func (h *Handler) updateSessionWithRequest(
 	session.ExcludeNotBeforeClaim = h.c.ExcludeNotBeforeClaim(ctx)
 	session.AllowedTopLevelClaims = h.c.AllowedTopLevelClaims(ctx)
 	session.MirrorTopLevelClaims = h.c.MirrorTopLevelClaims(ctx)
+	session.ClaimsRequest = claimsRequest
+	session.ScopeParameters = h.scopeParameters(ctx, request.GetClient(), request.GetGrantedScopes())
+	session.UserinfoClaims = nil
+	if claimsRequest != nil {
+		session.UserinfoClaims = claimsRequest.UserInfoClaims(flow.SessionIDToken, request.GetGrantedScopes())
+	}
 
 	return session, nil
 }
@@ -8,102 +8,136 @@ import (
 	"net/http"
 
 	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/x"
 )
 
-func (h *Handler) fallbackHandler(title, heading string, sc int, configKey string) func(w http.ResponseWriter, r *http.Request) {
-	if title == "" {
-		title = "The request could not be executed because a mandatory configuration key is missing or malformed"
-	}
-
-	if heading == "" {
-		heading = "The request could not be executed because a mandatory configuration key is missing or malformed"
-	}
-
-	return func(w http.ResponseWriter, r *http.Request) {
-		h.r.Logger().Errorf(`A request failed because configuration key "%s" is missing or malformed.`, configKey)
-
-		t, err := template.New(configKey).Parse(`<html>
+// The built-in pages pass all static texts through the translate function, so
+// they can be localized with message bundles keyed by their English text.
+const (
+	defaultFallbackTemplate = `<html lang="{{ .Lang }}">
 <head>
-	<title>{{ .Title }}</title>
+	<title>{{ translate .Title }}</title>
 </head>
 <body>
 <h1>
-	{{ .Heading }}
+	{{ translate .Heading }}
 </h1>
 <p>
-	You are seeing this page because configuration key <code>{{ .Key }}</code> is not set.
+	{{ translate "You are seeing this page because this configuration key is not set:" }} <code>{{ .Key }}</code>
 </p>
 <p>
-	If you are an administrator, please read <a href="https://www.ory.com/docs">the guide</a> to understand what you
-	need to do. If you are a user, please contact the administrator.
+	{{ translate "If you are an administrator, please read the guide to understand what you need to do. If you are a user, please contact the administrator." }}
+	<a href="https://www.ory.com/docs">https://www.ory.com/docs</a>
 </p>
 </body>
-</html>`)
-		if err != nil {
-			h.r.Writer().WriteError(w, r, err)
-			return
-		}
+</html>`
 
-		w.WriteHeader(sc)
-		if err := t.Execute(w, struct {
-			Title   string
-			Heading string
-			Key     string
-		}{Title: title, Heading: heading, Key: configKey}); err != nil {
-			h.r.Writer().WriteError(w, r, err)
-			return
-		}
-	}
-}
-
-func (h *Handler) DefaultErrorHandler(w http.ResponseWriter, r *http.Request) {
-	h.r.Logger().WithRequest(r).Error("A client requested the default error URL, environment variable URLS_ERROR is probably not set.")
-
-	t, err := template.New("consent").Parse(`
-<html>
+	defaultErrorTemplate = `<html lang="{{ .Lang }}">
 <head>
-	<title>An OAuth 2.0 Error Occurred</title>
+	<title>{{ translate "An OAuth 2.0 Error Occurred" }}</title>
 </head>
 <body>
 <h1>
-	The OAuth2 request resulted in an error.
+	{{ translate "The OAuth2 request resulted in an error." }}
 </h1>
 <ul>
-	<li>Error: {{ .Name }}</li>
-	<li>Description: {{ .Description }}</li>
-	<li>Hint: {{ .Hint }}</li>
-	<li>Debug: {{ .Debug }}</li>
+	<li>{{ translate "Error:" }} {{ .Name }}</li>
+	<li>{{ translate "Description:" }} {{ .Description }}</li>
+	<li>{{ translate "Hint:" }} {{ .Hint }}</li>
+	<li>{{ translate "Debug:" }} {{ .Debug }}</li>
 </ul>
 <p>
-	You are seeing this page because configuration key <code>{{ .Key }}</code> is not set.
+	{{ translate "You are seeing this page because this configuration key is not set:" }} <code>{{ .Key }}</code>
 </p>
 <p>
-	If you are an administrator, please read <a href="https://www.ory.com/docs">the guide</a> to understand what you
-	need to do. If you are a user, please contact the administrator.
+	{{ translate "If you are an administrator, please read the guide to understand what you need to do. If you are a user, please contact the administrator." }}
+	<a href="https://www.ory.com/docs">https://www.ory.com/docs</a>
 </p>
 </body>
 </html>
-`)
+`
+)
+
+// fallbackTemplateContext is passed to the fallback page template.
+type fallbackTemplateContext struct {
+	Title   string
+	Heading string
+	Key     string
+	Lang    string
+}
+
+// errorTemplateContext is passed to the error page template.
+type errorTemplateContext struct {
+	Name        string
+	Description string
+	Hint        string
+	Debug       string
+	Key         string
+	Lang        string
+}
+
+// renderTemplate renders the template at the given location, or the built-in
+// one if the location is empty, localized for the request.
+func (h *Handler) renderTemplate(w http.ResponseWriter, r *http.Request, sc int, name, location, builtin string, data func(lang string) any) {
+	ctx := r.Context()
+
+	var (
+		t   *template.Template
+		err error
+	)
+	if location != "" {
+		t, err = h.templates.Load(ctx, h.r.HTTPClient(ctx), name, location)
+	} else {
+		t, err = h.templates.Parse(name, builtin)
+	}
 	if err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
 
-	w.WriteHeader(http.StatusInternalServerError)
-	if err := t.Execute(w, struct {
-		Name        string
-		Description string
-		Hint        string
-		Debug       string
-		Key         string
-	}{
-		Name:        r.URL.Query().Get("error"),
-		Description: r.URL.Query().Get("error_description"),
-		Hint:        r.URL.Query().Get("error_hint"),
-		Debug:       r.URL.Query().Get("error_debug"),
-		Key:         config.KeyErrorURL,
-	}); err != nil {
+	t, lang, err := x.LocalizeTemplate(t, h.r.OAuth2ProviderConfig().GetMessageCatalog(ctx), r)
+	if err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(sc)
+	if err := t.Execute(w, data(lang.String())); err != nil {
+		h.r.Logger().WithRequest(r).WithError(err).Error("Unable to render template.")
+		return
+	}
+}
+
+func (h *Handler) fallbackHandler(title, heading string, sc int, configKey string) func(w http.ResponseWriter, r *http.Request) {
+	if title == "" {
+		title = "The request could not be executed because a mandatory configuration key is missing or malformed"
+	}
+
+	if heading == "" {
+		heading = "The request could not be executed because a mandatory configuration key is missing or malformed"
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.r.Logger().Errorf(`A request failed because configuration key "%s" is missing or malformed.`, configKey)
+
+		h.renderTemplate(w, r, sc, configKey, h.c.FallbackTemplateURL(r.Context()), defaultFallbackTemplate, func(lang string) any {
+			return &fallbackTemplateContext{Title: title, Heading: heading, Key: configKey, Lang: lang}
+		})
+	}
+}
+
+func (h *Handler) DefaultErrorHandler(w http.ResponseWriter, r *http.Request) {
+	h.r.Logger().WithRequest(r).Error("A client requested the default error URL, environment variable URLS_ERROR is probably not set.")
+
+	h.renderTemplate(w, r, http.StatusInternalServerError, "error", h.c.ErrorTemplateURL(r.Context()), defaultErrorTemplate, func(lang string) any {
+		return &errorTemplateContext{
+			Name:        r.URL.Query().Get("error"),
+			Description: r.URL.Query().Get("error_description"),
+			Hint:        r.URL.Query().Get("error_hint"),
+			Debug:       r.URL.Query().Get("error_debug"),
+			Key:         config.KeyErrorURL,
+			Lang:        lang,
+		}
+	})
 }
@@ -4,12 +4,15 @@
 package oauth2_test
 
 import (
+	"encoding/base64"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/ory/hydra/v2/driver"
 	"github.com/ory/hydra/v2/driver/config"
@@ -40,3 +43,68 @@ func TestHandlerConsent(t *testing.T) {
 
 	assert.NotEmpty(t, body)
 }
+
+func TestHandlerLocalizedPages(t *testing.T) {
+	t.Parallel()
+
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyMessageBundles: []map[string]any{{
+			"lang": "de",
+			"messages": []map[string]any{
+				{"id": "An OAuth 2.0 Error Occurred", "msg": "Ein OAuth 2.0 Fehler ist aufgetreten"},
+				{"id": "Hint:", "msg": "Hinweis:"},
+			},
+		}},
+	})))
+
+	h := oauth2.NewHandler(reg)
+	r := httprouterx.NewRouterAdminWithPrefix()
+	h.SetPublicRoutes(r.ToPublic(), func(h http.Handler) http.Handler { return h })
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+
+	get := func(t *testing.T, path, acceptLanguage string) string {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+path, nil)
+		require.NoError(t, err)
+		req.Header.Set("Accept-Language", acceptLanguage)
+
+		res, err := testhelpers.NewTestClient(t).Do(req)
+		require.NoError(t, err)
+		defer func() { _ = res.Body.Close() }()
+		assert.Equal(t, "text/html; charset=utf-8", res.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	errorPath := oauth2.DefaultErrorPath + "?" + url.Values{"error": {"invalid_request"}, "error_hint": {"<script>"}}.Encode()
+
+	t.Run("case=uses the built-in messages", func(t *testing.T) {
+		body := get(t, errorPath, "en")
+		assert.Contains(t, body, `<html lang="en">`)
+		assert.Contains(t, body, "An OAuth 2.0 Error Occurred")
+		assert.Contains(t, body, "&lt;script&gt;")
+	})
+
+	t.Run("case=uses the message bundle", func(t *testing.T) {
+		body := get(t, errorPath, "de-DE, en;q=0.5")
+		assert.Contains(t, body, `<html lang="de">`)
+		assert.Contains(t, body, "Ein OAuth 2.0 Fehler ist aufgetreten")
+		assert.Contains(t, body, "Hinweis: &lt;script&gt;")
+		assert.Contains(t, body, "The OAuth2 request resulted in an error.")
+	})
+
+	t.Run("case=localizes the fallback pages", func(t *testing.T) {
+		body := get(t, oauth2.DefaultLoginPath, "de")
+		assert.Contains(t, body, `<html lang="de">`)
+		assert.Contains(t, body, "<code>"+config.KeyLoginURL+"</code>")
+	})
+
+	t.Run("case=uses the configured template", func(t *testing.T) {
+		reg.Config().MustSet(t.Context(), config.KeyErrorTemplateURL,
+			"base64://"+base64.StdEncoding.EncodeToString([]byte(`<p>{{ translate "Hint:" }} {{ .Hint }} {{ .Name }}</p>`)))
+
+		assert.Equal(t, "<p>Hinweis: &lt;script&gt; invalid_request</p>", get(t, errorPath, "de"))
+	})
+}
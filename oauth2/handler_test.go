@@ -31,6 +31,7 @@ import (
 	"github.com/ory/hydra/v2/internal/testhelpers"
 	"github.com/ory/hydra/v2/jwk"
 	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/oauth2/scope"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/configx"
 	"github.com/ory/x/httprouterx"
@@ -377,6 +378,30 @@ func TestHandlerWellKnown(t *testing.T) {
 	})
 }
 
+func TestHandlerWellKnownRegisteredScopes(t *testing.T) {
+	t.Parallel()
+
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyOIDCDiscoverySupportedScope: []string{"email"},
+	})))
+	testhelpers.MustEnsureRegistryKeys(t, reg, x.OpenIDConnectKeyName)
+	require.NoError(t, reg.ScopeManager().CreateScope(t.Context(), &scope.Scope{Name: "photos.read"}))
+	require.NoError(t, reg.ScopeManager().CreateScope(t.Context(), &scope.Scope{Name: "email"}))
+
+	r := httprouterx.NewRouterAdminWithPrefix()
+	oauth2.NewHandler(reg).SetPublicRoutes(r.ToPublic(), func(h http.Handler) http.Handler { return h })
+	ts := httptest.NewServer(r)
+	t.Cleanup(ts.Close)
+
+	res, err := testhelpers.NewTestClient(t).Get(ts.URL + "/.well-known/openid-configuration")
+	require.NoError(t, err)
+	defer func() { _ = res.Body.Close() }()
+
+	var wellKnownResp hydra.OidcConfiguration
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&wellKnownResp))
+	assert.ElementsMatch(t, []string{"offline_access", "offline", "openid", "email", "photos.read"}, wellKnownResp.ScopesSupported)
+}
+
 func TestHandlerOauthAuthorizationServer(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,255 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"context"
+	"crypto/sha512"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/ristretto/v2"
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/tenant"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/logrusx"
+)
+
+const (
+	// revocationFeedBatchSize is the number of revocation feed entries read
+	// per query.
+	revocationFeedBatchSize = 1000
+	// revocationFeedClockSkew is subtracted from the feed cursor, so that
+	// entries recorded by nodes with a slightly late clock are not missed.
+	// Reading an entry twice is harmless.
+	revocationFeedClockSkew = 5 * time.Second
+)
+
+type (
+	// IntrospectionCache caches the results of token introspection in memory.
+	//
+	// Tokens are revoked by request. The cache does not know which tokens
+	// belong to a request, so instead of evicting entries it remembers when a
+	// request was revoked and ignores entries of that request which were cached
+	// before. Revocations made on any node are learned by polling the
+	// revocation feed of every network, see Watch.
+	//
+	// Entries and revocations are scoped by network, so that a token of one
+	// tenant is never introspected through another tenant.
+	IntrospectionCache struct {
+		d       introspectionCacheDependencies
+		entries *ristretto.Cache[string, *cachedIntrospection]
+
+		// revoked maps network-scoped request IDs to the time they were
+		// revoked. Unlike the entries, it must never drop items, so it is not
+		// a ristretto cache.
+		mu      sync.RWMutex
+		revoked map[string]time.Time
+	}
+	introspectionCacheDependencies interface {
+		config.Provider
+		logrusx.Provider
+		x.NetworkProvider
+		OAuth2Storage() x.FositeStorer
+		TenantResolver() *tenant.Resolver
+	}
+
+	cachedIntrospection struct {
+		requestID     string
+		cachedAt      time.Time
+		tokenUse      fosite.TokenType
+		grantedScopes fosite.Arguments
+		introspection Introspection
+	}
+)
+
+func NewIntrospectionCache(ctx context.Context, d introspectionCacheDependencies) *IntrospectionCache {
+	maxItems := d.Config().IntrospectionCacheMaxItems(ctx)
+	entries, _ := ristretto.NewCache(&ristretto.Config[string, *cachedIntrospection]{
+		NumCounters: maxItems * 10,
+		MaxCost:     maxItems,
+		BufferItems: 64,
+	})
+	return &IntrospectionCache{d: d, entries: entries, revoked: make(map[string]time.Time)}
+}
+
+// get returns the cached introspection result of the token, unless the
+// token's request was revoked since.
+func (c *IntrospectionCache) get(ctx context.Context, token string) (*cachedIntrospection, bool) {
+	if !c.d.Config().IntrospectionCacheEnabled(ctx) {
+		return nil, false
+	}
+
+	key := c.key(ctx, token)
+	e, ok := c.entries.Get(key)
+	if !ok {
+		return nil, false
+	}
+	c.mu.RLock()
+	revokedAt, revoked := c.revoked[c.key(ctx, e.requestID)]
+	c.mu.RUnlock()
+	if revoked && !revokedAt.Before(e.cachedAt) {
+		c.entries.Del(key)
+		return nil, false
+	}
+	return e, true
+}
+
+// set caches the introspection result of an active token until the token
+// expires, but at most for the configured TTL.
+func (c *IntrospectionCache) set(ctx context.Context, token string, e *cachedIntrospection) {
+	if !c.d.Config().IntrospectionCacheEnabled(ctx) {
+		return
+	}
+
+	ttl := c.d.Config().IntrospectionCacheTTL(ctx)
+	if untilExpiry := time.Until(time.Unix(e.introspection.ExpiresAt, 0)); untilExpiry < ttl {
+		ttl = untilExpiry
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	// Revocations are recorded with second precision. Truncating here means
+	// that a revocation within the same second invalidates the entry.
+	e.cachedAt = time.Now().UTC().Truncate(time.Second)
+	c.entries.SetWithTTL(c.key(ctx, token), e, 1, ttl)
+}
+
+// Invalidate ignores all entries of the request in the context's network
+// which were cached at or before revokedAt.
+func (c *IntrospectionCache) Invalidate(ctx context.Context, requestID string, revokedAt time.Time) {
+	key := c.key(ctx, requestID)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if prev, ok := c.revoked[key]; !ok || revokedAt.After(prev) {
+		c.revoked[key] = revokedAt
+	}
+}
+
+// prune forgets revocations which are older than any cached entry.
+func (c *IntrospectionCache) prune(ctx context.Context) {
+	notAfter := time.Now().Add(-c.d.Config().IntrospectionCacheTTL(ctx) - revocationFeedClockSkew)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, revokedAt := range c.revoked {
+		if revokedAt.Before(notAfter) {
+			delete(c.revoked, id)
+		}
+	}
+}
+
+// Wait blocks until all pending writes to the cache are applied.
+func (c *IntrospectionCache) Wait() {
+	c.entries.Wait()
+}
+
+// Watch polls the revocation feed and invalidates the cached results of
+// revoked requests until the context is canceled. It returns immediately if
+// the cache is disabled.
+func (c *IntrospectionCache) Watch(ctx context.Context) {
+	if !c.d.Config().IntrospectionCacheEnabled(ctx) {
+		return
+	}
+
+	cursor := time.Now().UTC()
+	ticker := time.NewTicker(c.d.Config().IntrospectionCacheRevocationPollInterval(ctx))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			next, err := c.poll(ctx, cursor)
+			if err != nil {
+				c.d.Logger().WithError(err).Warn("Unable to read the revocation feed, cached introspection results may be stale.")
+				continue
+			}
+			cursor = next
+			c.prune(ctx)
+		}
+	}
+}
+
+// poll invalidates the requests revoked since the cursor and returns the new
+// cursor. The revocation feed is scoped by network, so in multi-tenant mode the
+// feed of each tenant is read as well.
+func (c *IntrospectionCache) poll(ctx context.Context, cursor time.Time) (time.Time, error) {
+	networks := []context.Context{ctx}
+	if c.d.Config().MultitenancyEnabled(ctx) {
+		tenants, err := c.d.TenantResolver().Contexts(ctx)
+		if err != nil {
+			return cursor, err
+		}
+		networks = append(networks, tenants...)
+	}
+
+	next := cursor
+	for _, ctx := range networks {
+		last, err := c.pollNetwork(ctx, cursor)
+		if err != nil {
+			return cursor, err
+		}
+		if last.After(next) {
+			next = last
+		}
+	}
+	return next, nil
+}
+
+// pollNetwork invalidates the requests of the context's network revoked since
+// the cursor and returns the time of the latest revocation.
+func (c *IntrospectionCache) pollNetwork(ctx context.Context, cursor time.Time) (time.Time, error) {
+	since, limit := cursor.Add(-revocationFeedClockSkew), revocationFeedBatchSize
+	for {
+		revocations, err := c.d.OAuth2Storage().ListTokenRevocations(ctx, since, limit)
+		if err != nil {
+			return cursor, err
+		}
+
+		last := since
+		for _, r := range revocations {
+			c.Invalidate(ctx, r.RequestID, r.RevokedAt)
+			if r.RevokedAt.After(last) {
+				last = r.RevokedAt
+			}
+		}
+		if last.After(cursor) {
+			cursor = last
+		}
+
+		if len(revocations) < limit {
+			return cursor, nil
+		} else if !last.After(since) {
+			// All entries of the batch were recorded within the same second,
+			// so paging by time does not make progress.
+			limit *= 2
+			continue
+		}
+		since = last
+	}
+}
+
+// key returns the cache key of the token or request ID in the context's
+// network.
+func (c *IntrospectionCache) key(ctx context.Context, value string) string {
+	return introspectionCacheKey(c.d.Networker().NetworkID(ctx), value)
+}
+
+// introspectionCacheKey hashes the network and the token, so that cached
+// tokens can not be read from a memory dump.
+func introspectionCacheKey(nid uuid.UUID, token string) string {
+	h := sha512.New512_256()
+	_, _ = h.Write(nid.Bytes())
+	_, _ = h.Write([]byte(token))
+	return string(h.Sum(nil))
+}
@@ -0,0 +1,74 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/tenant"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/logrusx"
+)
+
+type introspectionCacheTestNetwork struct{}
+
+type introspectionCacheTestDeps struct {
+	c *config.DefaultProvider
+	l *logrusx.Logger
+}
+
+func (d *introspectionCacheTestDeps) Config() *config.DefaultProvider { return d.c }
+func (d *introspectionCacheTestDeps) Logger() *logrusx.Logger         { return d.l }
+func (d *introspectionCacheTestDeps) Networker() x.Networker          { return d }
+func (d *introspectionCacheTestDeps) OAuth2Storage() x.FositeStorer   { return nil }
+func (d *introspectionCacheTestDeps) TenantResolver() *tenant.Resolver {
+	return nil
+}
+
+func (d *introspectionCacheTestDeps) NetworkID(ctx context.Context) uuid.UUID {
+	return ctx.Value(introspectionCacheTestNetwork{}).(uuid.UUID)
+}
+
+func TestIntrospectionCacheIsScopedByNetwork(t *testing.T) {
+	l := logrusx.New("", "")
+	l.Logrus().SetOutput(io.Discard)
+	d := &introspectionCacheTestDeps{
+		c: config.MustNew(t, l, configx.WithValues(map[string]any{
+			config.KeyIntrospectionCacheEnabled: true,
+			config.KeyIntrospectionCacheTTL:     "1m",
+		})),
+		l: l,
+	}
+
+	tenant1 := context.WithValue(t.Context(), introspectionCacheTestNetwork{}, uuid.Must(uuid.NewV4()))
+	tenant2 := context.WithValue(t.Context(), introspectionCacheTestNetwork{}, uuid.Must(uuid.NewV4()))
+
+	c := NewIntrospectionCache(t.Context(), d)
+	c.set(tenant1, "token", &cachedIntrospection{
+		requestID:     "request",
+		introspection: Introspection{Active: true, ExpiresAt: time.Now().Add(time.Hour).Unix()},
+	})
+	c.Wait()
+
+	_, ok := c.get(tenant1, "token")
+	assert.True(t, ok)
+	_, ok = c.get(tenant2, "token")
+	assert.False(t, ok, "the token must not be introspected through another network")
+
+	c.Invalidate(tenant2, "request", time.Now().Add(time.Second))
+	_, ok = c.get(tenant1, "token")
+	assert.True(t, ok, "revocations of another network must not invalidate the entry")
+
+	c.Invalidate(tenant1, "request", time.Now().Add(time.Second))
+	_, ok = c.get(tenant1, "token")
+	assert.False(t, ok)
+}
@@ -70,4 +70,17 @@ type Introspection struct {
 
 	// Extra is arbitrary data set by the session.
 	Extra map[string]interface{} `json:"ext,omitempty"`
+
+	// ScopeParameters are the parameters of the granted scopes which are instances of a scope template,
+	// keyed by scope.
+	ScopeParameters map[string]map[string]string `json:"scope_parameters,omitempty"`
+
+	// ACR is the Authentication Context Class Reference of the authentication which led to the token.
+	ACR string `json:"acr,omitempty"`
+
+	// AMR are the Authentication Methods References of the authentication which led to the token.
+	AMR []string `json:"amr,omitempty"`
+
+	// AuthTime is the time when the End-User authentication occurred, as a UNIX timestamp.
+	AuthTime int64 `json:"auth_time,omitempty"`
 }
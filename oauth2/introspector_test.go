@@ -5,8 +5,12 @@ package oauth2_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
@@ -158,3 +162,193 @@ func TestIntrospectorSDK(t *testing.T) {
 		}
 	})
 }
+
+func TestIntrospectionCache(t *testing.T) {
+	t.Parallel()
+
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyIntrospectionCacheEnabled:                true,
+		config.KeyIntrospectionCacheRevocationPollInterval: "100ms",
+	})))
+	internal.AddFositeExamples(t, reg)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	t.Cleanup(cancel)
+	go reg.OAuth2IntrospectionCache().Watch(ctx)
+
+	router := httprouterx.NewRouterAdminWithPrefix()
+	oauth2.NewHandler(reg).SetAdminRoutes(router)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	introspect := func(t require.TestingT, token, scope string) bool {
+		res, err := server.Client().PostForm(server.URL+"/admin"+oauth2.IntrospectPath, url.Values{"token": {token}, "scope": {scope}})
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		var body oauth2.Introspection
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+		return body.Active
+	}
+
+	newToken := func(t *testing.T) (token string, requestID string) {
+		tokens := Tokens(reg.OAuth2ProviderConfig(), 1)
+		ar := fosite.NewAccessRequest(oauth2.NewTestSession(t, "alice"))
+		ar.GrantedScope = fosite.Arguments{"core"}
+		ar.RequestedAt = time.Now().UTC().Round(time.Second)
+		ar.Client = &fosite.DefaultClient{ID: "my-client"}
+		ar.Session.SetExpiresAt(fosite.AccessToken, time.Now().Add(time.Hour))
+		require.NoError(t, reg.OAuth2Storage().CreateAccessTokenSession(t.Context(), tokens[0].sig, ar))
+		return tokens[0].tok, ar.GetID()
+	}
+
+	t.Run("case=serves cached results", func(t *testing.T) {
+		token, _ := newToken(t)
+		require.True(t, introspect(t, token, ""))
+		reg.OAuth2IntrospectionCache().Wait()
+
+		// Removing the token without revoking it is not noticed by the cache.
+		require.NoError(t, reg.OAuth2Storage().DeleteAccessTokenSession(t.Context(), reg.OAuth2HMACStrategy().AccessTokenSignature(t.Context(), token)))
+		assert.True(t, introspect(t, token, ""))
+	})
+
+	t.Run("case=checks the scope of cached results", func(t *testing.T) {
+		token, _ := newToken(t)
+		require.True(t, introspect(t, token, "core"))
+		reg.OAuth2IntrospectionCache().Wait()
+
+		assert.True(t, introspect(t, token, "core"))
+		assert.False(t, introspect(t, token, "photos"))
+	})
+
+	t.Run("case=invalidates cached results on revocation", func(t *testing.T) {
+		token, requestID := newToken(t)
+		other, _ := newToken(t)
+		require.True(t, introspect(t, token, ""))
+		require.True(t, introspect(t, other, ""))
+		reg.OAuth2IntrospectionCache().Wait()
+
+		require.NoError(t, reg.OAuth2Storage().RevokeAccessToken(t.Context(), requestID))
+
+		assert.EventuallyWithT(t, func(t *assert.CollectT) {
+			assert.False(t, introspect(t, token, ""))
+		}, 5*time.Second, 100*time.Millisecond)
+		assert.True(t, introspect(t, other, ""))
+	})
+
+	t.Run("case=does not cache inactive tokens", func(t *testing.T) {
+		assert.False(t, introspect(t, "invalid", ""))
+	})
+}
+
+func TestIntrospectionCacheInvalidatesLocalRevocations(t *testing.T) {
+	t.Parallel()
+
+	// The poll interval is long enough that only the local invalidation can
+	// make the revocation visible during the test.
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyIntrospectionCacheEnabled:                true,
+		config.KeyIntrospectionCacheRevocationPollInterval: "1h",
+	})))
+	internal.AddFositeExamples(t, reg)
+
+	router := httprouterx.NewRouterAdminWithPrefix()
+	oauth2.NewHandler(reg).SetAdminRoutes(router)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	tokens := Tokens(reg.OAuth2ProviderConfig(), 1)
+	ar := fosite.NewAccessRequest(oauth2.NewTestSession(t, "alice"))
+	ar.GrantedScope = fosite.Arguments{"core"}
+	ar.RequestedAt = time.Now().UTC().Round(time.Second)
+	ar.Client = &fosite.DefaultClient{ID: "my-client"}
+	ar.Session.SetExpiresAt(fosite.AccessToken, time.Now().Add(time.Hour))
+	require.NoError(t, reg.OAuth2Storage().CreateAccessTokenSession(t.Context(), tokens[0].sig, ar))
+
+	introspect := func(t *testing.T) bool {
+		res, err := server.Client().PostForm(server.URL+"/admin"+oauth2.IntrospectPath, url.Values{"token": {tokens[0].tok}})
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		var body oauth2.Introspection
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+		return body.Active
+	}
+
+	require.True(t, introspect(t))
+	reg.OAuth2IntrospectionCache().Wait()
+
+	require.NoError(t, reg.OAuth2Storage().RevokeAccessToken(t.Context(), ar.GetID()))
+	assert.False(t, introspect(t))
+}
+
+func TestIntrospectionJWT(t *testing.T) {
+	t.Parallel()
+
+	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+		config.KeyIssuerURL: "https://foobariss",
+	})))
+	internal.AddFositeExamples(t, reg)
+
+	router := httprouterx.NewRouterAdminWithPrefix()
+	oauth2.NewHandler(reg).SetAdminRoutes(router)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	tokens := Tokens(reg.OAuth2ProviderConfig(), 1)
+	createAccessTokenSession(t, "alice", "my-client", tokens[0].sig, time.Now().Add(time.Hour), reg.OAuth2Storage(), fosite.Arguments{"core"})
+
+	newRequest := func(t *testing.T, token string) *http.Request {
+		req, err := http.NewRequest("POST", server.URL+"/admin"+oauth2.IntrospectPath, strings.NewReader(url.Values{"token": {token}}.Encode()))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Accept", "application/token-introspection+jwt, application/json;q=0.5")
+		return req
+	}
+
+	introspect := func(t *testing.T, token string) map[string]any {
+		req := newRequest(t, token)
+		req.SetBasicAuth("my-client", "foobar")
+
+		res, err := server.Client().Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, oauth2.IntrospectionJWTContentType, res.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+
+		decoded, err := reg.IntrospectionJWTSigner().Decode(t.Context(), string(body))
+		require.NoError(t, err)
+		assert.Equal(t, "token-introspection+jwt", decoded.Header["typ"])
+		assert.Equal(t, "https://foobariss", decoded.Claims["iss"])
+		assert.Equal(t, "my-client", decoded.Claims["aud"])
+		assert.NotEmpty(t, decoded.Claims["iat"])
+
+		introspection, ok := decoded.Claims["token_introspection"].(map[string]any)
+		require.True(t, ok, "%+v", decoded.Claims)
+		return introspection
+	}
+
+	t.Run("case=active token", func(t *testing.T) {
+		introspection := introspect(t, tokens[0].tok)
+		assert.Equal(t, true, introspection["active"])
+		assert.Equal(t, "alice", introspection["sub"])
+		assert.Equal(t, "my-client", introspection["client_id"])
+		assert.Equal(t, "core", introspection["scope"])
+	})
+
+	t.Run("case=inactive token", func(t *testing.T) {
+		assert.Equal(t, map[string]any{"active": false}, introspect(t, "invalid"))
+	})
+
+	t.Run("case=unauthenticated resource server", func(t *testing.T) {
+		res, err := server.Client().Do(newRequest(t, tokens[0].tok))
+		require.NoError(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/x"
+)
+
+// maxClientLabels is the number of distinct clients reported by the
+// hydra_oauth2_tokens_issued_total metric. Tokens issued to other clients are
+// reported with client_id "other".
+const maxClientLabels = 250
+
+var (
+	tokensIssued = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "hydra",
+		Subsystem: "oauth2",
+		Name:      "tokens_issued_total",
+		Help:      "Number of successful token responses by grant type and client.",
+	}, []string{"grant_type", "client_id"})
+	refreshTokenRotations = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "hydra",
+		Subsystem: "oauth2",
+		Name:      "refresh_token_rotations_total",
+		Help:      "Number of refresh tokens exchanged for a new token pair.",
+	})
+	refreshTokenReuseDetections = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "hydra",
+		Subsystem: "oauth2",
+		Name:      "refresh_token_reuse_detections_total",
+		Help:      "Number of refresh tokens used again after they were rotated, which revokes the whole token chain.",
+	})
+	clientAuthenticationFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "hydra",
+		Subsystem: "oauth2",
+		Name:      "client_authentication_failures_total",
+		Help:      "Number of failed client authentications by endpoint and authentication method.",
+	}, []string{"endpoint", "method"})
+
+	clientLabels = x.NewBoundedLabel(maxClientLabels)
+)
+
+// Endpoints reported by the hydra_oauth2_client_authentication_failures_total metric.
+const (
+	metricsEndpointToken      = "token"
+	metricsEndpointRevocation = "revocation"
+)
+
+func observeTokenIssued(grantType string, c fosite.Client) {
+	clientID := ""
+	if c != nil {
+		clientID = c.GetID()
+	}
+	tokensIssued.WithLabelValues(grantType, clientLabels.Value(clientID)).Inc()
+	if grantType == string(fosite.GrantTypeRefreshToken) {
+		refreshTokenRotations.Inc()
+	}
+}
+
+// observeTokenError records refresh token reuse and failed client
+// authentication, which are both reported as errors by fosite.
+func observeTokenError(r *http.Request, endpoint string, err error) {
+	if r.PostForm.Get("grant_type") == string(fosite.GrantTypeRefreshToken) && isRefreshTokenReuse(err) {
+		refreshTokenReuseDetections.Inc()
+	}
+	if errors.Is(err, fosite.ErrInvalidClient) {
+		clientAuthenticationFailures.WithLabelValues(endpoint, clientAuthenticationMethod(r)).Inc()
+	}
+}
+
+// isRefreshTokenReuse returns true if the refresh token grant failed because a
+// rotated refresh token was used again. Only then fosite reports an
+// invalid_grant caused by an inactive token; inactive tokens seen while
+// storing the new token pair are reported as invalid_request, and tokens of
+// revoked grants are not found.
+func isRefreshTokenReuse(err error) bool {
+	var rfcErr *fosite.RFC6749Error
+	if !errors.As(err, &rfcErr) {
+		return false
+	}
+	return rfcErr.ErrorField == fosite.ErrInvalidGrant.ErrorField && errors.Is(rfcErr, fosite.ErrInactiveToken)
+}
+
+// clientAuthenticationMethod returns the client authentication method used by
+// the request. client_secret_jwt is not supported, so client assertions are
+// reported as private_key_jwt.
+func clientAuthenticationMethod(r *http.Request) string {
+	if _, _, ok := r.BasicAuth(); ok {
+		return "client_secret_basic"
+	}
+	switch {
+	case r.PostForm.Get("client_assertion_type") != "":
+		return "private_key_jwt"
+	case r.PostForm.Get("client_secret") != "":
+		return "client_secret_post"
+	default:
+		return "none"
+	}
+}
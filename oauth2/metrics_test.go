@@ -0,0 +1,46 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ory/hydra/v2/fosite"
+)
+
+func TestClientAuthenticationMethod(t *testing.T) {
+	for expected, form := range map[string]url.Values{
+		"client_secret_post": {"client_id": {"foo"}, "client_secret": {"bar"}},
+		"private_key_jwt":    {"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"}, "client_assertion": {"ey..."}},
+		"none":               {"client_id": {"foo"}},
+	} {
+		r := httptest.NewRequest("POST", "/oauth2/token", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		_ = r.ParseForm()
+		assert.Equal(t, expected, clientAuthenticationMethod(r))
+	}
+
+	r := httptest.NewRequest("POST", "/oauth2/token", nil)
+	r.SetBasicAuth("foo", "bar")
+	assert.Equal(t, "client_secret_basic", clientAuthenticationMethod(r))
+}
+
+func TestIsRefreshTokenReuse(t *testing.T) {
+	assert.True(t, isRefreshTokenReuse(errors.WithStack(fosite.ErrInvalidGrant.WithWrap(fosite.ErrInactiveToken).WithHint("The refresh token was already used."))))
+
+	for _, err := range []error{
+		fosite.ErrInactiveToken,
+		errors.WithStack(fosite.ErrInvalidRequest.WithWrap(fosite.ErrInactiveToken).WithHint("Failed to refresh token. Please retry the request.")),
+		fosite.ErrInvalidGrant.WithWrap(fosite.ErrNotFound),
+		errors.New("some error"),
+	} {
+		assert.False(t, isRefreshTokenReuse(err), "%+v", err)
+	}
+}
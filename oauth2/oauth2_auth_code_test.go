@@ -1046,6 +1046,93 @@ func TestAuthCodeWithDefaultStrategy(t *testing.T) {
 				}
 			})
 
+			t.Run("case=returns the claims requested with the claims parameter", func(t *testing.T) {
+				c, conf := newOAuth2Client(t, reg, testhelpers.NewCallbackURL(t, "callback", testhelpers.HTTPServerNotImplementedHandler))
+				testhelpers.NewLoginConsentUI(t, reg.Config(),
+					acceptLoginHandler(t, c, adminClient, reg, subject, nil),
+					acceptConsentHandler(t, c, adminClient, reg, subject, func(r *hydra.OAuth2ConsentRequest) *hydra.AcceptOAuth2ConsentRequest {
+						claims := r.OidcContext.GetClaims()
+						assert.True(t, pointerx.Deref(claims.IdToken["email"].Essential))
+						assert.Contains(t, claims.Userinfo, "bar")
+						return nil
+					}),
+				)
+
+				code, _ := getAuthorizeCode(t, conf, nil, oauth2.SetAuthURLParam("claims", `{"id_token":{"email":{"essential":true}},"userinfo":{"bar":null}}`))
+				require.NotEmpty(t, code)
+
+				token, err := conf.Exchange(context.Background(), code)
+				require.NoError(t, err)
+
+				idClaims := gjson.ParseBytes(testhelpers.InsecureDecodeJWT(t, token.Extra("id_token").(string)))
+				assert.NotEmpty(t, idClaims.Get("sid").String(), "%s", idClaims)
+				assert.Equal(t, "foo@bar.com", idClaims.Get("email").String(), "%s", idClaims)
+				assert.False(t, idClaims.Get("bar").Exists(), "%s", idClaims)
+
+				uiClaims := testhelpers.Userinfo(t, token, publicTS)
+				assert.Equal(t, "baz", uiClaims.Get("bar").String(), "%s", uiClaims)
+				assert.False(t, uiClaims.Get("email").Exists(), "%s", uiClaims)
+				assert.Equal(t, idClaims.Get("sub").String(), uiClaims.Get("sub").String())
+			})
+
+			t.Run("case=returns live claims from the userinfo hook if configured", func(t *testing.T) {
+				respond := http.StatusOK
+				hs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					var hookReq hydraoauth2.UserinfoHookRequest
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&hookReq))
+					assert.Equal(t, subject, hookReq.Subject)
+					assert.NotEmpty(t, hookReq.ClientID)
+					assert.ElementsMatch(t, []string{"hydra", "offline", "openid"}, hookReq.GrantedScopes)
+					require.NotNil(t, hookReq.Session)
+
+					if respond == http.StatusNoContent {
+						w.WriteHeader(http.StatusNoContent)
+						return
+					}
+					require.NoError(t, json.NewEncoder(w).Encode(&hydraoauth2.UserinfoHookResponse{
+						// The email scope was not granted and the subject can not be overridden.
+						Claims:     map[string]interface{}{"bar": "updated", "email": "new@bar.com", "sub": "someone-else"},
+						ClaimNames: map[string]string{"groups": "src1", "address": "src2"},
+						ClaimSources: map[string]hydraoauth2.UserinfoClaimSource{
+							"src1": {Endpoint: "https://groups.example.com/claims", AccessToken: "ksj3n283dke"},
+							"src2": {JWT: "eyJhbGciOiJub25lIn0.e30."},
+						},
+					}))
+				}))
+				t.Cleanup(hs.Close)
+
+				reg.Config().MustSet(ctx, config.KeyUserinfoHook, hs.URL)
+				t.Cleanup(func() {
+					reg.Config().Delete(ctx, config.KeyUserinfoHook)
+				})
+
+				c, conf := newOAuth2Client(t, reg, testhelpers.NewCallbackURL(t, "callback", testhelpers.HTTPServerNotImplementedHandler))
+				testhelpers.NewLoginConsentUI(t, reg.Config(),
+					acceptLoginHandler(t, c, adminClient, reg, subject, nil),
+					acceptConsentHandler(t, c, adminClient, reg, subject, nil),
+				)
+
+				code, _ := getAuthorizeCode(t, conf, nil)
+				require.NotEmpty(t, code)
+				token, err := conf.Exchange(context.Background(), code)
+				require.NoError(t, err)
+
+				uiClaims := testhelpers.Userinfo(t, token, publicTS)
+				assert.Equal(t, subject, uiClaims.Get("sub").String(), "%s", uiClaims)
+				assert.Equal(t, "updated", uiClaims.Get("bar").String(), "%s", uiClaims)
+				assert.False(t, uiClaims.Get("email").Exists(), "%s", uiClaims)
+				assert.Equal(t, "src1", uiClaims.Get("_claim_names.groups").String(), "%s", uiClaims)
+				assert.False(t, uiClaims.Get("_claim_names.address").Exists(), "%s", uiClaims)
+				assert.Equal(t, "https://groups.example.com/claims", uiClaims.Get("_claim_sources.src1.endpoint").String(), "%s", uiClaims)
+				assert.False(t, uiClaims.Get("_claim_sources.src2").Exists(), "%s", uiClaims)
+
+				respond = http.StatusNoContent
+				uiClaims = testhelpers.Userinfo(t, token, publicTS)
+				assert.Equal(t, "baz", uiClaims.Get("bar").String(), "%s", uiClaims)
+				assert.Equal(t, "foo@bar.com", uiClaims.Get("email").String(), "%s", uiClaims)
+				assert.False(t, uiClaims.Get("_claim_names").Exists(), "%s", uiClaims)
+			})
+
 			t.Run("case=add ext claims from hook if configured", func(t *testing.T) {
 				run := func(strategy string) func(t *testing.T) {
 					return func(t *testing.T) {
@@ -1628,7 +1715,7 @@ func TestAuthCodeWithDefaultStrategy(t *testing.T) {
 									generations := createTokenGenerations(t, 4)
 
 									// After revoking consent, all generations are invalid.
-									err := reg.ConsentManager().RevokeSubjectConsentSession(context.Background(), subject)
+									err := reg.ConsentManager().RevokeSubjectConsentSession(context.Background(), subject, "")
 									require.NoError(t, err)
 
 									for i, generation := range generations {
@@ -5,11 +5,13 @@ package oauth2_test
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -27,13 +29,23 @@ import (
 	"github.com/ory/hydra/v2/internal/testhelpers"
 	hydraoauth2 "github.com/ory/hydra/v2/oauth2"
 	"github.com/ory/x/configx"
+	"github.com/ory/x/jsonnetsecure"
+	"github.com/ory/x/sqlxx"
 )
 
 func TestClientCredentials(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
-	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValue(config.KeyAccessTokenStrategy, "opaque")))
+	reg := testhelpers.NewRegistryMemory(t,
+		driver.WithConfigOptions(configx.WithValues(map[string]any{
+			config.KeyAccessTokenStrategy:          "opaque",
+			config.KeyTokenClaimsMapperMemoryLimit: 128,
+		})),
+		driver.WithRegistryModifiers(driver.RegistryWithJsonnetVMProvider(func(r *driver.RegistrySQL) jsonnetsecure.VMProvider {
+			return jsonnetsecure.NewTestProvider(t, jsonnetsecure.WithMemoryLimit(r.Config().TokenClaimsMapperMemoryLimit(ctx)))
+		})),
+	)
 	public, admin := testhelpers.NewOAuth2Server(ctx, t, reg)
 
 	var newCustomClient = func(t *testing.T, c *hc.Client) (*hc.Client, clientcredentials.Config) {
@@ -383,4 +395,281 @@ func TestClientCredentials(t *testing.T) {
 		t.Run("strategy=opaque", run("opaque"))
 		t.Run("strategy=jwt", run("jwt"))
 	})
+
+	t.Run("should fail token if hook times out", func(t *testing.T) {
+		hs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(time.Second)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer hs.Close()
+
+		reg.Config().MustSet(ctx, config.KeyTokenHook, map[string]any{"url": hs.URL, "timeout": "50ms"})
+		defer reg.Config().MustSet(ctx, config.KeyTokenHook, nil)
+
+		_, conf := newClient(t)
+
+		_, err := getToken(t, conf)
+		require.Error(t, err)
+	})
+
+	t.Run("should issue token with unmodified session if hook fails open", func(t *testing.T) {
+		hs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(time.Second)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer hs.Close()
+
+		reg.Config().MustSet(ctx, config.KeyTokenHook, map[string]any{"url": hs.URL, "timeout": "50ms", "fail_open": true})
+		defer reg.Config().MustSet(ctx, config.KeyTokenHook, nil)
+
+		cl, conf := newClient(t)
+		getAndInspectToken(t, cl, conf, "opaque", time.Now().Add(reg.Config().GetAccessTokenLifespan(ctx)), false)
+	})
+
+	t.Run("should not fail open if hook denied the request", func(t *testing.T) {
+		hs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer hs.Close()
+
+		reg.Config().MustSet(ctx, config.KeyTokenHook, map[string]any{"url": hs.URL, "fail_open": true})
+		defer reg.Config().MustSet(ctx, config.KeyTokenHook, nil)
+
+		_, conf := newClient(t)
+
+		_, err := getToken(t, conf)
+		require.Error(t, err)
+	})
+
+	t.Run("should open the circuit after repeated hook failures", func(t *testing.T) {
+		var calls, healthy atomic.Int32
+		hs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			if healthy.Load() == 0 {
+				// Malformed response which is not retried.
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			require.NoError(t, json.NewEncoder(w).Encode(&hydraoauth2.TokenHookResponse{
+				Session: flow.AcceptOAuth2ConsentRequestSession{AccessToken: map[string]any{"hooked": true}},
+			}))
+		}))
+		defer hs.Close()
+
+		reg.Config().MustSet(ctx, config.KeyTokenHook, map[string]any{
+			"url": hs.URL,
+			"circuit_breaker": map[string]any{
+				"failure_threshold": 2,
+				"open_duration":     "500ms",
+			},
+		})
+		defer reg.Config().MustSet(ctx, config.KeyTokenHook, nil)
+
+		cl, conf := newClient(t)
+		for range 3 {
+			_, err := getToken(t, conf)
+			require.Error(t, err)
+		}
+		assert.EqualValues(t, 2, calls.Load(), "the third request must not reach the hook")
+
+		healthy.Store(1)
+		time.Sleep(500 * time.Millisecond)
+
+		getAndInspectToken(t, cl, conf, "opaque", time.Now().Add(reg.Config().GetAccessTokenLifespan(ctx)), true)
+		getAndInspectToken(t, cl, conf, "opaque", time.Now().Add(reg.Config().GetAccessTokenLifespan(ctx)), true)
+		assert.EqualValues(t, 4, calls.Load(), "the probe must close the circuit")
+	})
+
+	t.Run("should cache hook responses per grant", func(t *testing.T) {
+		var calls atomic.Int32
+		hs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusOK)
+			require.NoError(t, json.NewEncoder(w).Encode(&hydraoauth2.TokenHookResponse{
+				Session: flow.AcceptOAuth2ConsentRequestSession{AccessToken: map[string]any{"hooked": true}},
+			}))
+		}))
+		defer hs.Close()
+
+		reg.Config().MustSet(ctx, config.KeyTokenHook, map[string]any{"url": hs.URL, "cache_ttl": "1m"})
+		defer reg.Config().MustSet(ctx, config.KeyTokenHook, nil)
+
+		cl, conf := newClient(t)
+		getAndInspectToken(t, cl, conf, "opaque", time.Now().Add(reg.Config().GetAccessTokenLifespan(ctx)), true)
+		hydraoauth2.WaitForHookCaches()
+		getAndInspectToken(t, cl, conf, "opaque", time.Now().Add(reg.Config().GetAccessTokenLifespan(ctx)), true)
+		assert.EqualValues(t, 1, calls.Load())
+
+		cl, conf = newClient(t)
+		getAndInspectToken(t, cl, conf, "opaque", time.Now().Add(reg.Config().GetAccessTokenLifespan(ctx)), true)
+		assert.EqualValues(t, 2, calls.Load(), "a different client must not share the cached response")
+	})
+
+	t.Run("should call matching token hooks in order and merge their claims", func(t *testing.T) {
+		newHook := func(t *testing.T, check func(hookReq *hydraoauth2.TokenHookRequest), claims map[string]any) *httptest.Server {
+			hs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var hookReq hydraoauth2.TokenHookRequest
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&hookReq))
+				check(&hookReq)
+
+				w.WriteHeader(http.StatusOK)
+				require.NoError(t, json.NewEncoder(w).Encode(&hydraoauth2.TokenHookResponse{
+					Session: flow.AcceptOAuth2ConsentRequestSession{AccessToken: claims},
+				}))
+			}))
+			t.Cleanup(hs.Close)
+			return hs
+		}
+
+		cl, conf := newClient(t)
+
+		first := newHook(t, func(hookReq *hydraoauth2.TokenHookRequest) {
+			assert.Empty(t, hookReq.Session.Extra)
+		}, map[string]any{"hooked": true, "source": "first"})
+		second := newHook(t, func(hookReq *hydraoauth2.TokenHookRequest) {
+			assert.Equal(t, map[string]any{"hooked": true, "source": "first"}, hookReq.Session.Extra)
+		}, map[string]any{"source": "second", "team": "x"})
+		skipped := newHook(t, func(*hydraoauth2.TokenHookRequest) {
+			t.Error("hook must not be called for the client_credentials grant")
+		}, nil)
+
+		reg.Config().MustSet(ctx, config.KeyTokenHook, []map[string]any{
+			{"url": first.URL},
+			{"url": skipped.URL, "match": map[string]any{"grant_types": []string{"authorization_code"}}},
+			{"url": second.URL, "match": map[string]any{"client_ids": []string{cl.GetID()}, "scopes": []string{"foobar"}}},
+		})
+		defer reg.Config().MustSet(ctx, config.KeyTokenHook, nil)
+
+		token, err := getToken(t, conf)
+		require.NoError(t, err)
+		inspectToken(t, token, cl, conf, "opaque", time.Now().Add(reg.Config().GetAccessTokenLifespan(ctx)), true)
+
+		introspection := testhelpers.IntrospectToken(t, token.AccessToken, admin)
+		assert.Equal(t, "second", introspection.Get("ext.source").String(), "%s", introspection.Raw)
+		assert.Equal(t, "x", introspection.Get("ext.team").String(), "%s", introspection.Raw)
+	})
+
+	t.Run("should not reuse a cached hook response if an earlier hook changed the claims", func(t *testing.T) {
+		var firstCalls, secondCalls atomic.Int32
+		first := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := firstCalls.Add(1)
+			w.WriteHeader(http.StatusOK)
+			require.NoError(t, json.NewEncoder(w).Encode(&hydraoauth2.TokenHookResponse{
+				Session: flow.AcceptOAuth2ConsentRequestSession{AccessToken: map[string]any{"hooked": true, "n": n}},
+			}))
+		}))
+		defer first.Close()
+		second := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			secondCalls.Add(1)
+			w.WriteHeader(http.StatusOK)
+			require.NoError(t, json.NewEncoder(w).Encode(&hydraoauth2.TokenHookResponse{
+				Session: flow.AcceptOAuth2ConsentRequestSession{AccessToken: map[string]any{"team": "x"}},
+			}))
+		}))
+		defer second.Close()
+
+		reg.Config().MustSet(ctx, config.KeyTokenHook, []map[string]any{
+			{"url": first.URL},
+			{"url": second.URL, "cache_ttl": "1m"},
+		})
+		defer reg.Config().MustSet(ctx, config.KeyTokenHook, nil)
+
+		cl, conf := newClient(t)
+		getAndInspectToken(t, cl, conf, "opaque", time.Now().Add(reg.Config().GetAccessTokenLifespan(ctx)), true)
+		hydraoauth2.WaitForHookCaches()
+		getAndInspectToken(t, cl, conf, "opaque", time.Now().Add(reg.Config().GetAccessTokenLifespan(ctx)), true)
+		assert.EqualValues(t, 2, firstCalls.Load())
+		assert.EqualValues(t, 2, secondCalls.Load())
+	})
+
+	t.Run("should apply token claims mapper if configured", func(t *testing.T) {
+		mapper := "base64://" + base64.StdEncoding.EncodeToString([]byte(`
+local ctx = std.extVar('ctx');
+{
+  access_token: {
+    hooked: std.member(ctx.request.granted_scopes, 'foobar') && ctx.client.client_id == ctx.request.client_id,
+  },
+}`))
+
+		run := func(strategy string) func(t *testing.T) {
+			return func(t *testing.T) {
+				reg.Config().MustSet(ctx, config.KeyAccessTokenStrategy, strategy)
+				reg.Config().MustSet(ctx, config.KeyTokenClaimsMapperURL, mapper)
+
+				defer reg.Config().MustSet(ctx, config.KeyTokenClaimsMapperURL, nil)
+
+				cl, conf := newClient(t)
+				getAndInspectToken(t, cl, conf, strategy, time.Now().Add(reg.Config().GetAccessTokenLifespan(ctx)), true)
+			}
+		}
+
+		t.Run("strategy=opaque", run("opaque"))
+		t.Run("strategy=jwt", run("jwt"))
+	})
+
+	t.Run("should prefer the client's token claims mapper over the global one", func(t *testing.T) {
+		reg.Config().MustSet(ctx, config.KeyTokenClaimsMapperURL, "base64://"+base64.StdEncoding.EncodeToString([]byte(`{access_token: {hooked: false}}`)))
+		defer reg.Config().MustSet(ctx, config.KeyTokenClaimsMapperURL, nil)
+
+		cl, conf := newCustomClient(t, &hc.Client{
+			Secret:               uuid.Must(uuid.NewV4()).String(),
+			RedirectURIs:         []string{public.URL + "/callback"},
+			ResponseTypes:        []string{"token"},
+			GrantTypes:           []string{"client_credentials"},
+			Scope:                "foobar",
+			Audience:             []string{"https://api.ory.sh/"},
+			TokenClaimsMapperURL: sqlxx.NullString("base64://" + base64.StdEncoding.EncodeToString([]byte(`{access_token: {hooked: true}}`))),
+		})
+		getAndInspectToken(t, cl, conf, "opaque", time.Now().Add(reg.Config().GetAccessTokenLifespan(ctx)), true)
+	})
+
+	t.Run("should merge the token claims mapper's claims into the token hook's claims", func(t *testing.T) {
+		hs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			require.NoError(t, json.NewEncoder(w).Encode(&hydraoauth2.TokenHookResponse{
+				Session: flow.AcceptOAuth2ConsentRequestSession{AccessToken: map[string]any{"hooked": true, "source": "hook"}},
+			}))
+		}))
+		defer hs.Close()
+
+		reg.Config().MustSet(ctx, config.KeyTokenHook, hs.URL)
+		defer reg.Config().MustSet(ctx, config.KeyTokenHook, nil)
+		reg.Config().MustSet(ctx, config.KeyTokenClaimsMapperURL, "base64://"+base64.StdEncoding.EncodeToString([]byte(`{access_token: {source: 'mapper'}}`)))
+		defer reg.Config().MustSet(ctx, config.KeyTokenClaimsMapperURL, nil)
+
+		cl, conf := newClient(t)
+		token, err := getToken(t, conf)
+		require.NoError(t, err)
+		inspectToken(t, token, cl, conf, "opaque", time.Now().Add(reg.Config().GetAccessTokenLifespan(ctx)), true)
+
+		introspection := testhelpers.IntrospectToken(t, token.AccessToken, admin)
+		assert.Equal(t, "mapper", introspection.Get("ext.source").String(), "%s", introspection.Raw)
+	})
+
+	t.Run("should fail token if token claims mapper fails", func(t *testing.T) {
+		reg.Config().MustSet(ctx, config.KeyTokenClaimsMapperURL, "base64://"+base64.StdEncoding.EncodeToString([]byte(`error 'nope'`)))
+		defer reg.Config().MustSet(ctx, config.KeyTokenClaimsMapperURL, nil)
+
+		_, conf := newClient(t)
+		_, err := getToken(t, conf)
+		require.Error(t, err)
+	})
+
+	t.Run("should fail token if token claims mapper exceeds the memory limit", func(t *testing.T) {
+		reg.Config().MustSet(ctx, config.KeyTokenClaimsMapperTimeout, "1s")
+		defer reg.Config().MustSet(ctx, config.KeyTokenClaimsMapperTimeout, nil)
+		reg.Config().MustSet(ctx, config.KeyTokenClaimsMapperURL, "base64://"+base64.StdEncoding.EncodeToString([]byte(`
+local double(s, n) = if n == 0 then s else double(s + s, n - 1);
+{access_token: {length: std.length(double('a', 28))}}`)))
+		defer reg.Config().MustSet(ctx, config.KeyTokenClaimsMapperURL, nil)
+
+		_, conf := newClient(t)
+		_, err := getToken(t, conf)
+		require.Error(t, err)
+
+		reg.Config().MustSet(ctx, config.KeyTokenClaimsMapperURL, "base64://"+base64.StdEncoding.EncodeToString([]byte(`{access_token: {hooked: true}}`)))
+		cl, conf := newClient(t)
+		getAndInspectToken(t, cl, conf, "opaque", time.Now().Add(reg.Config().GetAccessTokenLifespan(ctx)), true)
+	})
 }
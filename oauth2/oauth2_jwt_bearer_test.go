@@ -34,6 +34,7 @@ import (
 	hydraoauth2 "github.com/ory/hydra/v2/oauth2"
 	"github.com/ory/hydra/v2/oauth2/trust"
 	"github.com/ory/x/configx"
+	"github.com/ory/x/jsonnetsecure"
 )
 
 func TestJWTBearer(t *testing.T) {
@@ -43,10 +44,15 @@ func TestJWTBearer(t *testing.T) {
 	// This suite asserts the legacy behavior of copying the assertion audience
 	// into the access token, so it pins omit_assertion_audience to false. The
 	// default (omit) is covered by the dedicated sub-test below.
-	reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(
-		configx.WithValue(config.KeyAccessTokenStrategy, "opaque"),
-		configx.WithValue(config.KeyOAuth2GrantJWTOmitAssertionAudience, false),
-	))
+	reg := testhelpers.NewRegistryMemory(t,
+		driver.WithConfigOptions(
+			configx.WithValue(config.KeyAccessTokenStrategy, "opaque"),
+			configx.WithValue(config.KeyOAuth2GrantJWTOmitAssertionAudience, false),
+		),
+		driver.WithRegistryModifiers(driver.RegistryWithJsonnetVMProvider(func(*driver.RegistrySQL) jsonnetsecure.VMProvider {
+			return jsonnetsecure.NewTestProvider(t)
+		})),
+	)
 	_, admin := testhelpers.NewOAuth2Server(ctx, t, reg)
 
 	secret := uuid.Must(uuid.NewV4()).String()
@@ -267,6 +273,57 @@ func TestJWTBearer(t *testing.T) {
 		t.Run("strategy=jwt", run("jwt"))
 	})
 
+	t.Run("case=applies the claim conditions and claims mapper of the trust relationship", func(t *testing.T) {
+		issuer, kid := uuid.Must(uuid.NewV4()).String(), uuid.Must(uuid.NewV4()).String()
+		keys, err := jwk.GenerateJWK(jose.RS256, kid, "sig")
+		require.NoError(t, err)
+		ruleGrant := trust.Grant{
+			ID:              uuid.Must(uuid.NewV4()),
+			Issuer:          issuer,
+			AllowAnySubject: true,
+			Scope:           []string{"offline_access"},
+			ExpiresAt:       time.Now().Add(time.Hour),
+			PublicKey:       trust.PublicKey{Set: issuer, KeyID: kid},
+			ClaimConditions: trust.ClaimConditions{"repository": {"org/app"}, "environment": {"staging", "production"}},
+			ClaimsMapper:    `local claims = std.extVar('ctx').claims; {subject: 'repo:' + claims.repository, ext: {environment: claims.environment}}`,
+		}
+		require.NoError(t, reg.GrantManager().CreateGrant(ctx, ruleGrant, keys.Keys[0].Public()))
+		signer := jwk.NewDefaultJWTSigner(reg, issuer)
+		signer.GetPrivateKey = func(ctx context.Context) (interface{}, error) {
+			return keys.Keys[0], nil
+		}
+
+		exchange := func(t *testing.T, repository string) (*goauth2.Token, error) {
+			token, _, err := signer.Generate(ctx, jwt.MapClaims{
+				"jti":         uuid.Must(uuid.NewV4()).String(),
+				"iss":         issuer,
+				"sub":         "repo:" + repository + ":ref:refs/heads/main",
+				"aud":         reg.Config().OAuth2TokenURL(ctx).String(),
+				"exp":         time.Now().Add(time.Hour).Unix(),
+				"iat":         time.Now().Add(-time.Minute).Unix(),
+				"repository":  repository,
+				"environment": "production",
+			}, &jwt.Headers{Extra: map[string]interface{}{"kid": kid}})
+			require.NoError(t, err)
+
+			conf := newConf(client)
+			conf.EndpointParams = url.Values{"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"}, "assertion": {token}}
+			return getToken(t, conf)
+		}
+
+		_, err = exchange(t, "org/other")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `does not satisfy the condition on claim \"repository\"`)
+
+		result, err := exchange(t, "org/app")
+		require.NoError(t, err)
+
+		introspection := testhelpers.IntrospectToken(t, result.AccessToken, admin)
+		assert.True(t, introspection.Get("active").Bool(), "%s", introspection.Raw)
+		assert.Equal(t, "repo:org/app", introspection.Get("sub").String(), "%s", introspection.Raw)
+		assert.Equal(t, "production", introspection.Get("ext.environment").String(), "%s", introspection.Raw)
+	})
+
 	t.Run("case=omits the assertion audience when omit_assertion_audience is enabled", func(t *testing.T) {
 		reg.Config().MustSet(ctx, config.KeyOAuth2GrantJWTOmitAssertionAudience, true)
 		t.Cleanup(func() {
@@ -9,10 +9,8 @@ import (
 
 	"github.com/pkg/errors"
 
-	"github.com/ory/x/httpx"
 	"github.com/ory/x/otelx"
 
-	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/fosite"
 )
 
@@ -49,12 +47,8 @@ type RefreshTokenHookRequest struct {
 }
 
 // RefreshTokenHook is an AccessRequestHook called for `refresh_token` grant type.
-func RefreshTokenHook(reg interface {
-	config.Provider
-	httpx.ClientProvider
-	otelx.Provider
-},
-) AccessRequestHook {
+func RefreshTokenHook(reg hookRegistry) AccessRequestHook {
+	guard := newHookGuard[TokenHookResponse]("refresh_token", "token hook")
 	return func(ctx context.Context, requester fosite.AccessRequester) (err error) {
 		ctx, span := reg.Tracer(ctx).Tracer().Start(ctx, "oauth2.RefreshTokenHook")
 		defer otelx.End(span, &err)
@@ -99,7 +93,7 @@ func RefreshTokenHook(reg interface {
 			)
 		}
 
-		err = executeHookAndUpdateSession(ctx, reg, hookConfig, reqBodyBytes, session)
+		err = executeHookAndUpdateSession(ctx, reg, guard, hookConfig, hookGrantKey(requester, session), reqBodyBytes, session)
 		if err != nil {
 			return err
 		}
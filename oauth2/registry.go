@@ -11,6 +11,7 @@ import (
 	"github.com/ory/hydra/v2/fosite/handler/openid"
 	"github.com/ory/hydra/v2/fosite/handler/rfc8628"
 	"github.com/ory/hydra/v2/jwk"
+	"github.com/ory/hydra/v2/oauth2/scope"
 	"github.com/ory/hydra/v2/oauth2/trust"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/httpx"
@@ -22,7 +23,9 @@ type InternalRegistry interface {
 	client.Registry
 	jwk.Registry
 	trust.Registry
+	scope.Registry
 	httpx.WriterProvider
+	httpx.ClientProvider
 	logrusx.Provider
 	otelx.Provider
 	x.Transactor
@@ -35,6 +38,8 @@ type Registry interface {
 	OAuth2Storage() x.FositeStorer
 	OAuth2Provider() fosite.OAuth2Provider
 	AccessTokenJWTSigner() jwk.JWTSigner
+	IntrospectionJWTSigner() jwk.JWTSigner
+	OAuth2IntrospectionCache() *IntrospectionCache
 	OpenIDConnectRequestValidator() *openid.OpenIDConnectRequestValidator
 	AccessRequestHooks() []AccessRequestHook
 	OAuth2ProviderConfig() fosite.Configurator
@@ -0,0 +1,273 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package scope
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/httprouterx"
+	"github.com/ory/x/urlx"
+)
+
+const (
+	ScopesHandlerPath = "/scopes"
+)
+
+type Handler struct {
+	r InternalRegistry
+}
+
+func NewHandler(r InternalRegistry) *Handler {
+	return &Handler{r: r}
+}
+
+func (h *Handler) SetRoutes(admin *httprouterx.RouterAdmin) {
+	admin.GET(ScopesHandlerPath, h.listScopes)
+	admin.POST(ScopesHandlerPath, h.createScope)
+	admin.GET(ScopesHandlerPath+"/{name}", h.getScope)
+	admin.PUT(ScopesHandlerPath+"/{name}", h.setScope)
+	admin.DELETE(ScopesHandlerPath+"/{name}", h.deleteScope)
+}
+
+// Create Scope Request
+//
+// swagger:parameters createScope
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type createScope struct {
+	// in: body
+	// required: true
+	Body Scope
+}
+
+// swagger:route POST /admin/scopes scope createScope
+//
+// # Create Scope
+//
+// Registers a scope in the scope registry. Once at least one scope is registered, OAuth 2.0 Clients can only
+// be granted registered scopes and the built-in scopes openid, offline and offline_access.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  201: scope
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) createScope(w http.ResponseWriter, r *http.Request) {
+	var s Scope
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to decode the request body: %s", err)))
+		return
+	}
+
+	if err := s.Validate(); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if err := h.r.ScopeManager().CreateScope(r.Context(), &s); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().WriteCreated(w, r, urlx.MustJoin("/admin", ScopesHandlerPath, url.PathEscape(s.Name)), &s)
+}
+
+// Set Scope Request
+//
+// swagger:parameters setScope
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type setScope struct {
+	// The name of the scope
+	//
+	// in: path
+	// required: true
+	Name string `json:"name"`
+
+	// in: body
+	// required: true
+	Body Scope
+}
+
+// swagger:route PUT /admin/scopes/{name} scope setScope
+//
+// # Set Scope
+//
+// Replaces the description, display names, sensitivity, default audience and consent requirement of a
+// registered scope. The name of a scope can not be changed.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: scope
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) setScope(w http.ResponseWriter, r *http.Request) {
+	var s Scope
+	if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to decode the request body: %s", err)))
+		return
+	}
+
+	s.Name = r.PathValue("name")
+	if err := s.Validate(); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if err := h.r.ScopeManager().UpdateScope(r.Context(), &s); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, &s)
+}
+
+// Get Scope Request
+//
+// swagger:parameters getScope
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type getScope struct {
+	// The name of the scope
+	//
+	// in: path
+	// required: true
+	Name string `json:"name"`
+}
+
+// swagger:route GET /admin/scopes/{name} scope getScope
+//
+// # Get Scope
+//
+// Returns a registered scope.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: scope
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) getScope(w http.ResponseWriter, r *http.Request) {
+	s, err := h.r.ScopeManager().GetScope(r.Context(), r.PathValue("name"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, s)
+}
+
+// Scopes
+//
+// swagger:model scopes
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type scopes []Scope
+
+// swagger:route GET /admin/scopes scope listScopes
+//
+// # List Scopes
+//
+// Lists all registered scopes.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: scopes
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) listScopes(w http.ResponseWriter, r *http.Request) {
+	ss, err := h.r.ScopeManager().ListScopes(r.Context())
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	if ss == nil {
+		ss = []Scope{}
+	}
+
+	h.r.Writer().Write(w, r, ss)
+}
+
+// Delete Scope Request
+//
+// swagger:parameters deleteScope
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type deleteScope struct {
+	// The name of the scope
+	//
+	// in: path
+	// required: true
+	Name string `json:"name"`
+}
+
+// swagger:route DELETE /admin/scopes/{name} scope deleteScope
+//
+// # Delete Scope
+//
+// Removes a scope from the scope registry. OAuth 2.0 Clients which were granted the scope keep it, but
+// can not be updated until the scope is removed from them or registered again.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  204: emptyResponse
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) deleteScope(w http.ResponseWriter, r *http.Request) {
+	if err := h.r.ScopeManager().DeleteScope(r.Context(), r.PathValue("name")); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
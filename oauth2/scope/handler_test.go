@@ -0,0 +1,81 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package scope_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/oauth2/scope"
+	"github.com/ory/x/httprouterx"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	reg := testhelpers.NewRegistryMemory(t)
+
+	router := httprouterx.NewRouterAdminWithPrefix()
+	scope.NewHandler(reg).SetRoutes(router)
+	ts := httptest.NewServer(router)
+	t.Cleanup(ts.Close)
+
+	c := hydra.NewAPIClient(hydra.NewConfiguration())
+	c.GetConfig().Servers = hydra.ServerConfigurations{{URL: ts.URL}}
+
+	created, _, err := c.ScopeAPI.CreateScope(t.Context()).Scope(hydra.Scope{
+		Name:            new("photos.read"),
+		Description:     new("Read access to your photos."),
+		DisplayNames:    map[string]string{"en": "View your photos"},
+		DefaultAudience: []string{"https://photos.example.com"},
+		RequiresConsent: new(true),
+	}).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "low", created.GetSensitivity())
+	assert.NotZero(t, created.GetCreatedAt())
+
+	_, res, err := c.ScopeAPI.CreateScope(t.Context()).Scope(hydra.Scope{
+		Name: new("photos.read"),
+	}).Execute()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusConflict, res.StatusCode)
+
+	_, res, err = c.ScopeAPI.CreateScope(t.Context()).Scope(hydra.Scope{
+		Name:        new("photos.write"),
+		Sensitivity: new("critical"),
+	}).Execute()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	updated, _, err := c.ScopeAPI.SetScope(t.Context(), created.GetName()).Scope(hydra.Scope{
+		Name:        new("ignored"),
+		Sensitivity: new("high"),
+	}).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, created.GetName(), updated.GetName())
+	assert.Equal(t, "high", updated.GetSensitivity())
+	assert.Equal(t, created.GetCreatedAt(), updated.GetCreatedAt())
+
+	got, _, err := c.ScopeAPI.GetScope(t.Context(), created.GetName()).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, "high", got.GetSensitivity())
+	assert.Empty(t, got.GetDescription())
+
+	list, _, err := c.ScopeAPI.ListScopes(t.Context()).Execute()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	_, err = c.ScopeAPI.DeleteScope(t.Context(), created.GetName()).Execute()
+	require.NoError(t, err)
+
+	_, res, err = c.ScopeAPI.GetScope(t.Context(), created.GetName()).Execute()
+	require.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+}
@@ -0,0 +1,18 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package scope
+
+import (
+	"context"
+)
+
+type Manager interface {
+	CreateScope(ctx context.Context, s *Scope) error
+	GetScope(ctx context.Context, name string) (*Scope, error)
+	UpdateScope(ctx context.Context, s *Scope) error
+	DeleteScope(ctx context.Context, name string) error
+	ListScopes(ctx context.Context) ([]Scope, error)
+	// GetScopes returns the registered scopes among the given names.
+	GetScopes(ctx context.Context, names []string) ([]Scope, error)
+}
@@ -0,0 +1,17 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package scope
+
+import (
+	"github.com/ory/x/httpx"
+)
+
+type InternalRegistry interface {
+	httpx.WriterProvider
+	Registry
+}
+
+type Registry interface {
+	ScopeManager() Manager
+}
@@ -0,0 +1,129 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package scope
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/sqlxx"
+)
+
+const (
+	SensitivityLow    = "low"
+	SensitivityMedium = "medium"
+	SensitivityHigh   = "high"
+)
+
+var sensitivities = []string{SensitivityLow, SensitivityMedium, SensitivityHigh}
+
+// Scope
+//
+// A scope registered in the scope registry. Once at least one scope is registered, OAuth 2.0 Clients can
+// only be granted registered scopes, and the registered scopes are advertised by OpenID Connect Discovery.
+//
+// swagger:model scope
+type Scope struct {
+	ID  uuid.UUID `json:"-" db:"id"`
+	NID uuid.UUID `json:"-" db:"nid"`
+
+	// Name is the scope as requested by OAuth 2.0 Clients.
+	//
+	// example: photos.read
+	Name string `json:"name" db:"name"`
+
+	// Description explains what the scope grants access to.
+	//
+	// example: Read access to your photos.
+	Description string `json:"description" db:"description"`
+
+	// DisplayNames are the names shown to end-users on the consent screen, keyed by BCP 47 language tag.
+	//
+	// example: {"en": "View your photos", "de": "Ihre Fotos ansehen"}
+	DisplayNames DisplayNames `json:"display_names" db:"display_names"`
+
+	// Sensitivity is one of `low`, `medium` or `high` and defaults to `low`.
+	//
+	// example: medium
+	Sensitivity string `json:"sensitivity" db:"sensitivity"`
+
+	// DefaultAudience is the access token audience usually granted together with the scope.
+	//
+	// example: ["https://photos.example.com"]
+	DefaultAudience sqlxx.StringSliceJSONFormat `json:"default_audience" db:"default_audience"`
+
+	// RequiresConsent indicates that the end-user must be asked before the scope is granted. Scopes not
+	// requiring consent can be granted by the consent endpoint without asking the end-user.
+	RequiresConsent bool `json:"requires_consent" db:"requires_consent"`
+
+	// CreatedAt is the time the scope was registered.
+	//
+	// read only
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+
+	// UpdatedAt is the time the scope was last updated.
+	//
+	// read only
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (Scope) TableName() string {
+	return "hydra_oauth2_scope"
+}
+
+// Validate normalizes the scope and checks that it can be registered.
+func (s *Scope) Validate() error {
+	if s.Name == "" {
+		return errors.WithStack(herodot.ErrBadRequest().WithReason("Field 'name' is required."))
+	}
+	// See https://datatracker.ietf.org/doc/html/rfc6749#section-3.3
+	if strings.ContainsFunc(s.Name, func(r rune) bool {
+		return r <= ' ' || r == '"' || r == '\\' || r > '~'
+	}) {
+		return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Scope '%s' must not contain whitespace, quotes, backslashes or non-ASCII characters.", s.Name))
+	}
+
+	if s.Sensitivity == "" {
+		s.Sensitivity = SensitivityLow
+	} else if !slices.Contains(sensitivities, s.Sensitivity) {
+		return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Sensitivity '%s' must be one of %s.", s.Sensitivity, strings.Join(sensitivities, ", ")))
+	}
+
+	if s.DisplayNames == nil {
+		s.DisplayNames = DisplayNames{}
+	}
+	for tag := range s.DisplayNames {
+		if _, err := language.Parse(tag); err != nil {
+			return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Display name language '%s' is not a valid BCP 47 language tag.", tag))
+		}
+	}
+
+	if s.DefaultAudience == nil {
+		s.DefaultAudience = sqlxx.StringSliceJSONFormat{}
+	}
+	return nil
+}
+
+// DisplayNames maps BCP 47 language tags to display names.
+type DisplayNames map[string]string
+
+func (n *DisplayNames) Scan(value interface{}) error {
+	return sqlxx.JSONScan(n, value)
+}
+
+func (n DisplayNames) Value() (driver.Value, error) {
+	value, err := json.Marshal(map[string]string(n))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return string(value), nil
+}
@@ -0,0 +1,44 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package scope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopeValidate(t *testing.T) {
+	for k, tc := range []struct {
+		d   string
+		in  Scope
+		err bool
+	}{
+		{d: "name is required", in: Scope{}, err: true},
+		{d: "name without whitespace", in: Scope{Name: "photos read"}, err: true},
+		{d: "name without quotes", in: Scope{Name: `photos"read`}, err: true},
+		{d: "unknown sensitivity", in: Scope{Name: "photos.read", Sensitivity: "critical"}, err: true},
+		{d: "invalid language tag", in: Scope{Name: "photos.read", DisplayNames: DisplayNames{"not a tag": "Photos"}}, err: true},
+		{d: "url scope", in: Scope{Name: "https://photos.example.com/read"}},
+		{d: "valid", in: Scope{Name: "photos.read", Sensitivity: SensitivityHigh, DisplayNames: DisplayNames{"en": "View your photos", "de-CH": "Ihre Fotos ansehen"}}},
+	} {
+		t.Run(tc.d, func(t *testing.T) {
+			err := tc.in.Validate()
+			if tc.err {
+				require.Error(t, err, "%d", k)
+				return
+			}
+			require.NoError(t, err, "%d", k)
+		})
+	}
+
+	t.Run("case=normalizes", func(t *testing.T) {
+		in := Scope{Name: "photos.read"}
+		require.NoError(t, in.Validate())
+		assert.Equal(t, SensitivityLow, in.Sensitivity)
+		assert.NotNil(t, in.DisplayNames)
+		assert.NotNil(t, in.DefaultAudience)
+	})
+}
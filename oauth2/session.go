@@ -17,6 +17,7 @@ import (
 	"github.com/tidwall/sjson"
 
 	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/flow"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/fosite/handler/openid"
 	"github.com/ory/hydra/v2/fosite/token/jwt"
@@ -34,6 +35,17 @@ type Session struct {
 	AllowedTopLevelClaims  []string               `json:"allowed_top_level_claims"`
 	MirrorTopLevelClaims   bool                   `json:"mirror_top_level_claims"`
 	PreserveExtClaims      bool                   `json:"preserve_ext_claims"`
+
+	// ClaimsRequest is the OpenID Connect `claims` request parameter. If set,
+	// the userinfo endpoint returns UserinfoClaims instead of the ID token's
+	// extra claims.
+	ClaimsRequest  *flow.OIDCClaimsRequest `json:"claims_request,omitempty"`
+	UserinfoClaims map[string]interface{}  `json:"userinfo_claims,omitempty"`
+
+	// ScopeParameters are the parameters of the granted scopes which are
+	// instances of a scope template, keyed by scope. They are added to JWT
+	// access tokens as the `scope_parameters` claim.
+	ScopeParameters map[string]map[string]string `json:"scope_parameters,omitempty"`
 }
 
 func NewTestSession(t testing.TB, subject string) *Session {
@@ -62,7 +74,7 @@ func (s *Session) GetJWTClaims() jwt.JWTClaimsContainer {
 	allowedClaimsFromConfigWithoutReserved := slices.DeleteFunc(s.AllowedTopLevelClaims, func(s string) bool {
 		switch s {
 		// these claims are reserved and should not be overridden
-		case "iss", "sub", "aud", "exp", "nbf", "iat", "jti", "client_id", "scp", "ext":
+		case "iss", "sub", "aud", "exp", "nbf", "iat", "jti", "client_id", "scp", "ext", "scope_parameters", "acr", "amr", "auth_time":
 			return true
 		}
 		return false
@@ -71,6 +83,21 @@ func (s *Session) GetJWTClaims() jwt.JWTClaimsContainer {
 	// our new extra map which will be added to the jwt
 	topLevelExtraWithMirrorExt := make(map[string]interface{}, len(allowedClaimsFromConfigWithoutReserved)+2)
 	topLevelExtraWithMirrorExt["client_id"] = s.ClientID
+	if len(s.ScopeParameters) > 0 {
+		topLevelExtraWithMirrorExt["scope_parameters"] = s.ScopeParameters
+	}
+
+	// include the authentication context so that resource servers can enforce
+	// step-up authentication (RFC 9470)
+	if acr := s.DefaultSession.Claims.AuthenticationContextClassReference; acr != "" {
+		topLevelExtraWithMirrorExt["acr"] = acr
+	}
+	if amr := s.DefaultSession.Claims.AuthenticationMethodsReferences; len(amr) > 0 {
+		topLevelExtraWithMirrorExt["amr"] = amr
+	}
+	if authTime := s.DefaultSession.Claims.AuthTime; !authTime.IsZero() {
+		topLevelExtraWithMirrorExt["auth_time"] = authTime.Unix()
+	}
 
 	// setting every allowed claim top level in jwt with respective value
 	for _, allowedClaim := range allowedClaimsFromConfigWithoutReserved {
@@ -5,6 +5,7 @@ package oauth2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -29,6 +30,10 @@ func TestCustomClaimsInSession(t *testing.T) {
 		extra, expectedClaims                                          map[string]any
 		allowedTopLevelClaims, expectNotSet                            []string
 		mirrorTopLevelClaims, excludeNotBeforeClaim, preserveExtClaims bool
+		scopeParameters                                                map[string]map[string]string
+		acr                                                            string
+		amr                                                            []string
+		authTime                                                       time.Time
 	}{{
 		name:  "no custom claims",
 		extra: map[string]any{},
@@ -36,7 +41,7 @@ func TestCustomClaimsInSession(t *testing.T) {
 			"sub": "alice",
 			"iss": "hydra.localhost",
 		},
-		expectNotSet: []string{"ext"},
+		expectNotSet: []string{"ext", "scope_parameters", "acr", "amr", "auth_time"},
 	}, {
 		name:                  "top level mirrored",
 		extra:                 map[string]any{"foo": "bar"},
@@ -212,6 +217,30 @@ func TestCustomClaimsInSession(t *testing.T) {
 			"iss": "hydra.localhost",
 		},
 		expectNotSet: []string{"nbf"},
+	}, {
+		name:                  "scope parameters",
+		extra:                 map[string]any{"scope_parameters": "overridden"},
+		allowedTopLevelClaims: []string{"scope_parameters"},
+		scopeParameters:       map[string]map[string]string{"transfer:max:500": {"amount": "500"}},
+		expectedClaims: map[string]any{
+			"sub":              "alice",
+			"iss":              "hydra.localhost",
+			"scope_parameters": map[string]map[string]string{"transfer:max:500": {"amount": "500"}},
+		},
+	}, {
+		name:                  "authentication context",
+		extra:                 map[string]any{"acr": "overridden"},
+		allowedTopLevelClaims: []string{"acr"},
+		acr:                   "urn:acr:mfa",
+		amr:                   []string{"pwd", "otp"},
+		authTime:              time.Unix(1700000000, 0),
+		expectedClaims: map[string]any{
+			"sub":       "alice",
+			"iss":       "hydra.localhost",
+			"acr":       "urn:acr:mfa",
+			"amr":       []string{"pwd", "otp"},
+			"auth_time": int64(1700000000),
+		},
 	}} {
 		t.Run(tc.name, func(t *testing.T) {
 			sess := session
@@ -220,6 +249,17 @@ func TestCustomClaimsInSession(t *testing.T) {
 			sess.MirrorTopLevelClaims = tc.mirrorTopLevelClaims
 			sess.ExcludeNotBeforeClaim = tc.excludeNotBeforeClaim
 			sess.PreserveExtClaims = tc.preserveExtClaims
+			sess.ScopeParameters = tc.scopeParameters
+
+			idTokenClaims := *session.DefaultSession.Claims
+			idTokenClaims.AuthenticationContextClassReference = tc.acr
+			idTokenClaims.AuthenticationMethodsReferences = tc.amr
+			idTokenClaims.AuthTime = tc.authTime
+			sess.DefaultSession = &openid.DefaultSession{
+				Claims:  &idTokenClaims,
+				Headers: session.DefaultSession.Headers,
+				Subject: session.DefaultSession.Subject,
+			}
 
 			claims := sess.GetJWTClaims().ToMapClaims()
 			assert.Subset(t, claims, tc.expectedClaims)
@@ -0,0 +1,157 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dgraph-io/ristretto/v2"
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/x/fetcher"
+	"github.com/ory/x/httpx"
+	"github.com/ory/x/jsonnetsecure"
+	"github.com/ory/x/otelx"
+)
+
+// TokenClaimsMapperContext is passed to the token claims mapper as the `ctx`
+// external variable.
+//
+// swagger:ignore
+type TokenClaimsMapperContext struct {
+	// Session is the request's session.
+	Session *Session `json:"session"`
+	// Client is the OAuth 2.0 client performing the token request.
+	Client *client.Client `json:"client"`
+	// Request is a token endpoint's request context.
+	Request Request `json:"request"`
+}
+
+// tokenClaimsMapperCacheTTL is how long remote mappers are cached.
+const tokenClaimsMapperCacheTTL = 5 * time.Minute
+
+// TokenClaimsMapperHook is an AccessRequestHook called for all grant types. It
+// evaluates the Jsonnet mapper configured for the client or, if the client has
+// none, the globally configured mapper.
+func TokenClaimsMapperHook(reg interface {
+	config.Provider
+	httpx.ClientProvider
+	otelx.Provider
+	jsonnetsecure.VMProvider
+},
+) AccessRequestHook {
+	cache, _ := ristretto.NewCache(&ristretto.Config[[]byte, []byte]{
+		NumCounters: 10_000,
+		MaxCost:     16 << 20, // 16 MiB
+		BufferItems: 64,
+	})
+
+	return func(ctx context.Context, requester fosite.AccessRequester) (err error) {
+		ctx, span := reg.Tracer(ctx).Tracer().Start(ctx, "oauth2.TokenClaimsMapperHook")
+		defer otelx.End(span, &err)
+
+		session, ok := requester.GetSession().(*Session)
+		if !ok {
+			return nil
+		}
+
+		// File URLs are only allowed when the mapper comes from the configuration.
+		mapperURL, schemes := reg.Config().TokenClaimsMapperURL(ctx), []string{"file", "base64", "http", "https"}
+		cl, _ := requester.GetClient().(*client.Client)
+		if cl != nil && cl.TokenClaimsMapperURL != "" {
+			mapperURL, schemes = string(cl.TokenClaimsMapperURL), []string{"base64", "http", "https"}
+		}
+		if mapperURL == "" {
+			return nil
+		}
+
+		snippet, err := fetcher.NewFetcher(
+			fetcher.WithClient(reg.HTTPClient(ctx)),
+			fetcher.WithCache(cache, tokenClaimsMapperCacheTTL),
+			fetcher.WithAllowedSchemes(schemes...),
+		).FetchBytes(ctx, mapperURL)
+		if err != nil {
+			return errors.WithStack(
+				fosite.ErrServerError.
+					WithWrap(err).
+					WithDescription("An error occurred while loading the token claims mapper.").
+					WithDebugf("Unable to fetch the token claims mapper: %s", err),
+			)
+		}
+
+		var sanitized *client.Client
+		if cl != nil {
+			c := *cl
+			c.Secret = ""
+			c.RotatedSecrets = nil
+			sanitized = &c
+		}
+
+		input, err := json.Marshal(&TokenClaimsMapperContext{
+			Session: session,
+			Client:  sanitized,
+			Request: Request{
+				ClientID:        requester.GetClient().GetID(),
+				RequestedScopes: requester.GetRequestedScopes(),
+				GrantedScopes:   requester.GetGrantedScopes(),
+				GrantedAudience: requester.GetGrantedAudience(),
+				GrantTypes:      requester.GetGrantTypes(),
+				Payload:         requester.Sanitize([]string{"assertion"}).GetRequestForm(),
+			},
+		})
+		if err != nil {
+			return errors.WithStack(
+				fosite.ErrServerError.
+					WithWrap(err).
+					WithDescription("An error occurred while encoding the token claims mapper input.").
+					WithDebugf("Unable to encode the token claims mapper input: %s", err),
+			)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, reg.Config().TokenClaimsMapperTimeout(ctx))
+		defer cancel()
+
+		vm, err := reg.JsonnetVM(ctx)
+		if err != nil {
+			return errors.WithStack(
+				fosite.ErrServerError.
+					WithWrap(err).
+					WithDescription("An error occurred while preparing the token claims mapper.").
+					WithDebugf("Unable to create the Jsonnet VM: %s", err),
+			)
+		}
+		vm.ExtCode("ctx", string(input))
+
+		output, err := vm.EvaluateAnonymousSnippet("token_claims_mapper.jsonnet", string(snippet))
+		if err != nil {
+			return errors.WithStack(
+				fosite.ErrServerError.
+					WithWrap(err).
+					WithDescription("An error occurred while executing the token claims mapper.").
+					WithDebugf("Unable to evaluate the token claims mapper: %s", err),
+			)
+		}
+
+		var claims flow.AcceptOAuth2ConsentRequestSession
+		if err := json.Unmarshal([]byte(output), &claims); err != nil {
+			return errors.WithStack(
+				fosite.ErrServerError.
+					WithWrap(err).
+					WithDescription("The token claims mapper returned an invalid result.").
+					WithDebugf("Result of the token claims mapper could not be decoded: %s", err),
+			)
+		}
+
+		// The mapper runs after the token hooks, so its claims are merged into
+		// the ones set by the consent and the hooks instead of replacing them.
+		mergeSessionFromHook(session, &claims)
+		return nil
+	}
+}
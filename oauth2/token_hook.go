@@ -7,17 +7,23 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/ory/x/httpx"
+	"github.com/ory/x/logrusx"
 	"github.com/ory/x/otelx"
 
 	"github.com/hashicorp/go-retryablehttp"
 
+	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/flow"
 	"github.com/ory/x/reqlog"
 
@@ -89,32 +95,116 @@ func applyAuth(req *retryablehttp.Request, auth *config.Auth) error {
 	return nil
 }
 
-func executeHookAndUpdateSession(ctx context.Context, reg httpx.ClientProvider, hookConfig *config.HookConfig, reqBodyBytes []byte, session *Session) error {
+// hookRegistry is the registry needed to execute token and refresh token hooks.
+type hookRegistry interface {
+	config.Provider
+	httpx.ClientProvider
+	logrusx.Provider
+	otelx.Provider
+}
+
+// executeHookAndUpdateSession calls the hook and replaces the session's claims
+// with the ones it returns.
+func executeHookAndUpdateSession(ctx context.Context, reg hookRegistry, guard *hookGuard[TokenHookResponse], hookConfig *config.HookConfig, grantKey string, reqBodyBytes []byte, session *Session) error {
+	resp, err := executeHook(ctx, reg, guard, hookConfig, grantKey, reqBodyBytes)
+	if err != nil {
+		return err
+	}
+	if resp != nil {
+		updateSessionFromHook(session, &resp.Session)
+	}
+	return nil
+}
+
+// executeHook calls the hook and returns its response, or nil if the hook
+// responded without a body. Responses are cached per grant, calls are bounded
+// by the hook's timeout and guarded by its circuit breaker. If the hook is
+// configured to fail open, any failure other than the hook denying the request
+// is logged and nil is returned.
+func executeHook[T any](ctx context.Context, reg hookRegistry, guard *hookGuard[T], hookConfig *config.HookConfig, grantKey string, reqBodyBytes []byte) (*T, error) {
+	if resp, ok := guard.cached(hookConfig, grantKey); ok {
+		recordHookResult(ctx, guard.name, hookResultCacheHit)
+		return resp, nil
+	}
+
+	breaker := guard.breaker(hookConfig.URL)
+	if !breaker.allow(hookConfig.CircuitBreaker, time.Now()) {
+		recordHookResult(ctx, guard.name, hookResultCircuitOpen)
+		return nil, failHook(ctx, reg, guard.name, guard.description, hookConfig, errors.WithStack(
+			fosite.ErrServerError.
+				WithDescription(fmt.Sprintf("The %s is temporarily unavailable.", guard.description)).
+				WithDebugf("The circuit breaker of the %s is open.", guard.description),
+		))
+	}
+
+	t0 := time.Now()
+	resp, err := callHook[T](ctx, reg, guard.description, hookConfig, reqBodyBytes)
+	hookDuration.WithLabelValues(guard.name).Observe(time.Since(t0).Seconds())
+
+	denied := errors.Is(err, fosite.ErrAccessDenied)
+	breaker.record(hookConfig.CircuitBreaker, err == nil || denied, time.Now())
+
+	switch {
+	case denied:
+		recordHookResult(ctx, guard.name, hookResultDenied)
+		return nil, err
+	case errors.Is(err, context.DeadlineExceeded):
+		recordHookResult(ctx, guard.name, hookResultTimeout)
+		return nil, failHook(ctx, reg, guard.name, guard.description, hookConfig, err)
+	case err != nil:
+		recordHookResult(ctx, guard.name, hookResultError)
+		return nil, failHook(ctx, reg, guard.name, guard.description, hookConfig, err)
+	}
+
+	recordHookResult(ctx, guard.name, hookResultOK)
+	guard.store(hookConfig, grantKey, resp)
+	return resp, nil
+}
+
+// failHook returns err unless the hook is configured to fail open.
+func failHook(ctx context.Context, reg hookRegistry, name, description string, hookConfig *config.HookConfig, err error) error {
+	if !hookConfig.FailOpen {
+		return err
+	}
+
+	reg.Logger().WithContext(ctx).
+		WithError(err).
+		WithField("hook", name).
+		Warnf("The %s failed, continuing without its response because the hook is configured to fail open.", description)
+	return nil
+}
+
+// callHook performs the HTTP request to the hook and decodes its response. It
+// returns nil if the hook permitted the request without a response body.
+func callHook[T any](ctx context.Context, reg hookRegistry, description string, hookConfig *config.HookConfig, reqBodyBytes []byte) (*T, error) {
+	ctx, cancel := context.WithTimeout(ctx, hookConfig.Timeout)
+	defer cancel()
+
 	req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodPost, hookConfig.URL, bytes.NewReader(reqBodyBytes))
 	if err != nil {
-		return errors.WithStack(
+		return nil, errors.WithStack(
 			fosite.ErrServerError.
 				WithWrap(err).
-				WithDescription("An error occurred while preparing the token hook.").
+				WithDescription(fmt.Sprintf("An error occurred while preparing the %s.", description)).
 				WithDebugf("Unable to prepare the HTTP Request: %s", err),
 		)
 	}
 	if err := applyAuth(req, hookConfig.Auth); err != nil {
-		return errors.WithStack(
+		return nil, errors.WithStack(
 			fosite.ErrServerError.
 				WithWrap(err).
-				WithDescription("An error occurred while applying the token hook authentication.").
-				WithDebugf("Unable to apply the token hook authentication: %s", err))
+				WithDescription(fmt.Sprintf("An error occurred while applying the %s authentication.", description)).
+				WithDebugf("Unable to apply the %s authentication: %s", description, err))
 	}
 	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
 
 	t0 := time.Now()
 	resp, err := reg.HTTPClient(ctx).Do(req)
 	if err != nil {
-		return errors.WithStack(
+		return nil, errors.WithStack(
 			fosite.ErrServerError.
 				WithWrap(err).
-				WithDescription("An error occurred while executing the token hook.").
+				WithDescription(fmt.Sprintf("An error occurred while executing the %s.", description)).
 				WithDebugf("Unable to execute HTTP Request: %s", err),
 		)
 	}
@@ -123,56 +213,51 @@ func executeHookAndUpdateSession(ctx context.Context, reg httpx.ClientProvider,
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		// Token permitted with new session data
+		// Request permitted with a response body
 	case http.StatusNoContent:
-		// Token is permitted without overriding session data
-		return nil
+		// Request permitted without a response body
+		return nil, nil
 	case http.StatusForbidden:
-		return errors.WithStack(
+		return nil, errors.WithStack(
 			fosite.ErrAccessDenied.
-				WithDescription("The token hook target responded with an error.").
-				WithDebugf("Token hook responded with HTTP status code: %s", resp.Status),
+				WithDescription(fmt.Sprintf("The %s target responded with an error.", description)).
+				WithDebugf("The %s responded with HTTP status code: %s", description, resp.Status),
 		)
 	default:
-		return errors.WithStack(
+		return nil, errors.WithStack(
 			fosite.ErrServerError.
-				WithDescription("The token hook target responded with an error.").
-				WithDebugf("Token hook responded with HTTP status code: %s", resp.Status),
+				WithDescription(fmt.Sprintf("The %s target responded with an error.", description)).
+				WithDebugf("The %s responded with HTTP status code: %s", description, resp.Status),
 		)
 	}
 
-	var respBody TokenHookResponse
+	var respBody T
 	if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
-		return errors.WithStack(
+		return nil, errors.WithStack(
 			fosite.ErrServerError.
 				WithWrap(err).
-				WithDescription("The token hook target responded with an error.").
-				WithDebugf("Response from token hook could not be decoded: %s", err),
+				WithDescription(fmt.Sprintf("The %s target responded with an error.", description)).
+				WithDebugf("Response from the %s could not be decoded: %s", description, err),
 		)
 	}
 
 	reqlog.AccumulateExternalLatency(ctx, time.Since(t0)) // body read
 
-	// Overwrite existing session data (extra claims).
-	session.Extra = respBody.Session.AccessToken
-	idTokenClaims := session.IDTokenClaims()
-	idTokenClaims.Extra = respBody.Session.IDToken
-	return nil
+	return &respBody, nil
 }
 
-// TokenHook is an AccessRequestHook called for all grant types.
-func TokenHook(reg interface {
-	config.Provider
-	httpx.ClientProvider
-	otelx.Provider
-},
-) AccessRequestHook {
+// TokenHook is an AccessRequestHook called for all grant types. It calls the
+// configured token hooks in order, skipping hooks which do not match the
+// request. The first hook response replaces the session's claims, later
+// responses are merged into them.
+func TokenHook(reg hookRegistry) AccessRequestHook {
+	guard := newHookGuard[TokenHookResponse]("token", "token hook")
 	return func(ctx context.Context, requester fosite.AccessRequester) (err error) {
 		ctx, span := reg.Tracer(ctx).Tracer().Start(ctx, "oauth2.AccessRequestHook")
 		defer otelx.End(span, &err)
 
-		hookConfig := reg.Config().TokenHookConfig(ctx)
-		if hookConfig == nil {
+		hookConfigs := reg.Config().TokenHookConfigs(ctx)
+		if len(hookConfigs) == 0 {
 			return nil
 		}
 
@@ -190,26 +275,81 @@ func TokenHook(reg interface {
 			Payload:         requester.Sanitize([]string{"assertion"}).GetRequestForm(),
 		}
 
-		reqBody := TokenHookRequest{
-			Session: session,
-			Request: request,
-		}
+		replaced := false
+		for i, hookConfig := range hookConfigs {
+			if !hookMatches(hookConfig.Match, requester) {
+				continue
+			}
 
-		reqBodyBytes, err := json.Marshal(&reqBody)
-		if err != nil {
-			return errors.WithStack(
-				fosite.ErrServerError.
-					WithWrap(err).
-					WithDescription("An error occurred while encoding the token hook.").
-					WithDebugf("Unable to encode the token hook body: %s", err),
-			)
-		}
+			hookSession, err := executeTokenHook(ctx, reg, guard, i, hookConfig, hookGrantKey(requester, session), &TokenHookRequest{
+				Session: session,
+				Request: request,
+			})
+			if err != nil {
+				return err
+			}
+			if hookSession == nil {
+				continue
+			}
 
-		err = executeHookAndUpdateSession(ctx, reg, hookConfig, reqBodyBytes, session)
-		if err != nil {
-			return err
+			if replaced {
+				mergeSessionFromHook(session, hookSession)
+			} else {
+				updateSessionFromHook(session, hookSession)
+				replaced = true
+			}
 		}
 
 		return nil
 	}
 }
+
+// executeTokenHook calls the i-th token hook in its own span.
+func executeTokenHook(ctx context.Context, reg hookRegistry, guard *hookGuard[TokenHookResponse], i int, hookConfig *config.HookConfig, grantKey string, reqBody *TokenHookRequest) (_ *flow.AcceptOAuth2ConsentRequestSession, err error) {
+	ctx, span := reg.Tracer(ctx).Tracer().Start(ctx, "oauth2.TokenHook", trace.WithAttributes(attribute.Int("hook.index", i)))
+	defer otelx.End(span, &err)
+
+	reqBodyBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, errors.WithStack(
+			fosite.ErrServerError.
+				WithWrap(err).
+				WithDescription("An error occurred while encoding the token hook.").
+				WithDebugf("Unable to encode the token hook body: %s", err),
+		)
+	}
+
+	resp, err := executeHook(ctx, reg, guard, hookConfig, grantKey, reqBodyBytes)
+	if err != nil || resp == nil {
+		return nil, err
+	}
+	return &resp.Session, nil
+}
+
+// hookMatches reports whether a token hook applies to the request.
+func hookMatches(match *config.HookMatch, requester fosite.AccessRequester) bool {
+	if match == nil {
+		return true
+	}
+
+	if len(match.ClientIDs) > 0 && !slices.Contains(match.ClientIDs, requester.GetClient().GetID()) {
+		return false
+	}
+	if len(match.Owners) > 0 {
+		cl, ok := requester.GetClient().(*client.Client)
+		if !ok || !slices.Contains(match.Owners, cl.Owner) {
+			return false
+		}
+	}
+	if len(match.GrantTypes) > 0 && !slices.ContainsFunc(requester.GetGrantTypes(), func(gt string) bool {
+		return slices.Contains(match.GrantTypes, gt)
+	}) {
+		return false
+	}
+	if len(match.Scopes) > 0 && !slices.ContainsFunc(requester.GetRequestedScopes(), func(scope string) bool {
+		return slices.Contains(match.Scopes, scope)
+	}) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,237 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/ristretto/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/fosite"
+)
+
+var (
+	hookDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "hydra",
+		Subsystem: "token_hook",
+		Name:      "duration_seconds",
+		Help:      "Latency of token, refresh token and userinfo webhook calls.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"hook"})
+	hookRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "hydra",
+		Subsystem: "token_hook",
+		Name:      "requests_total",
+		Help:      "Number of token, refresh token and userinfo webhook executions by result.",
+	}, []string{"hook", "result"})
+)
+
+// Results reported by the hydra_token_hook_requests_total metric.
+const (
+	hookResultOK          = "ok"
+	hookResultCacheHit    = "cache_hit"
+	hookResultDenied      = "denied"
+	hookResultError       = "error"
+	hookResultTimeout     = "timeout"
+	hookResultCircuitOpen = "circuit_open"
+)
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker stops calls to a failing hook. Once the circuit has been
+// open for the configured duration, a single probe call is let through; its
+// outcome decides whether the circuit closes again or stays open.
+type circuitBreaker struct {
+	mu       sync.Mutex
+	state    circuitState
+	failures int
+	openedAt time.Time
+}
+
+// allow reports whether a call may be made.
+func (b *circuitBreaker) allow(c config.HookCircuitBreakerConfig, now time.Time) bool {
+	if c.FailureThreshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		if now.Sub(b.openedAt) < c.OpenDuration {
+			return false
+		}
+		b.state = circuitHalfOpen
+		return true
+	case circuitHalfOpen:
+		// A probe is already in flight.
+		return false
+	default:
+		return true
+	}
+}
+
+// record records the outcome of a call that was allowed.
+func (b *circuitBreaker) record(c config.HookCircuitBreakerConfig, success bool, now time.Time) {
+	if c.FailureThreshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if success {
+		b.state, b.failures = circuitClosed, 0
+		return
+	}
+
+	b.failures++
+	if b.state == circuitHalfOpen || b.failures >= c.FailureThreshold {
+		b.state, b.openedAt = circuitOpen, now
+	}
+}
+
+// hookCaches collects the Wait functions of all hook response caches. Cache
+// writes are buffered, so tests wait for them before expecting a cache hit.
+var hookCaches struct {
+	sync.Mutex
+	wait []func()
+}
+
+// hookGuard holds the state shared by all executions of one hook: a circuit
+// breaker per hook URL and the cache of responses of type T.
+type hookGuard[T any] struct {
+	name string
+	// description names the hook in error and log messages.
+	description string
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+
+	cache *ristretto.Cache[string, *T]
+}
+
+func newHookGuard[T any](name, description string) *hookGuard[T] {
+	cache, _ := ristretto.NewCache(&ristretto.Config[string, *T]{
+		NumCounters: 100_000,
+		MaxCost:     10_000,
+		BufferItems: 64,
+	})
+	hookCaches.Lock()
+	hookCaches.wait = append(hookCaches.wait, cache.Wait)
+	hookCaches.Unlock()
+
+	return &hookGuard[T]{
+		name:        name,
+		description: description,
+		breakers:    make(map[string]*circuitBreaker),
+		cache:       cache,
+	}
+}
+
+func (g *hookGuard[T]) breaker(url string) *circuitBreaker {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	b, ok := g.breakers[url]
+	if !ok {
+		b = new(circuitBreaker)
+		g.breakers[url] = b
+	}
+	return b
+}
+
+// cached returns the cached hook response for the grant. A nil response with
+// ok set means the hook responded without a body.
+func (g *hookGuard[T]) cached(hookConfig *config.HookConfig, grantKey string) (response *T, ok bool) {
+	if hookConfig.CacheTTL <= 0 || grantKey == "" {
+		return nil, false
+	}
+	return g.cache.Get(hookConfig.URL + "|" + grantKey)
+}
+
+func (g *hookGuard[T]) store(hookConfig *config.HookConfig, grantKey string, response *T) {
+	if hookConfig.CacheTTL <= 0 || grantKey == "" {
+		return
+	}
+	g.cache.SetWithTTL(hookConfig.URL+"|"+grantKey, response, 1, hookConfig.CacheTTL)
+}
+
+// hookGrantKey identifies the grant a token request belongs to together with
+// the claims the session currently carries. Hook responses are cached under
+// this key, so it must be computed right before calling each hook of a chain,
+// as earlier hooks change the claims later hooks receive.
+func hookGrantKey(requester fosite.AccessRequester, session *Session) string {
+	extra, _ := json.Marshal(session.Extra)
+	idTokenExtra, _ := json.Marshal(session.IDTokenClaims().Extra)
+
+	h := sha256.New()
+	for _, v := range []string{
+		requester.GetClient().GetID(),
+		session.GetSubject(),
+		session.ConsentChallenge,
+		strings.Join(requester.GetGrantTypes(), " "),
+		strings.Join(requester.GetGrantedScopes(), " "),
+		strings.Join(requester.GetGrantedAudience(), " "),
+		string(extra),
+		string(idTokenExtra),
+	} {
+		_, _ = h.Write([]byte(v))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// recordHookResult counts the result of a hook execution and records it on
+// the current span.
+func recordHookResult(ctx context.Context, name, result string) {
+	hookRequests.WithLabelValues(name, result).Inc()
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("hook.result", result))
+}
+
+// updateSessionFromHook overwrites the session's extra claims with the ones
+// returned by a hook. The maps are copied so that cached responses are never
+// modified.
+func updateSessionFromHook(session *Session, hookSession *flow.AcceptOAuth2ConsentRequestSession) {
+	session.Extra = maps.Clone(hookSession.AccessToken)
+	session.IDTokenClaims().Extra = maps.Clone(hookSession.IDToken)
+}
+
+// mergeSessionFromHook merges the claims returned by a hook into the session's
+// extra claims, overwriting top-level claims of the same name.
+func mergeSessionFromHook(session *Session, hookSession *flow.AcceptOAuth2ConsentRequestSession) {
+	if len(hookSession.AccessToken) > 0 {
+		if session.Extra == nil {
+			session.Extra = make(map[string]interface{}, len(hookSession.AccessToken))
+		}
+		maps.Copy(session.Extra, hookSession.AccessToken)
+	}
+	if len(hookSession.IDToken) > 0 {
+		idTokenClaims := session.IDTokenClaims()
+		if idTokenClaims.Extra == nil {
+			idTokenClaims.Extra = make(map[string]interface{}, len(hookSession.IDToken))
+		}
+		maps.Copy(idTokenClaims.Extra, hookSession.IDToken)
+	}
+}
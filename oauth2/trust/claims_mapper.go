@@ -0,0 +1,91 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package trust
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/handler/rfc7523"
+	"github.com/ory/x/jsonnetsecure"
+)
+
+// ClaimsMapperContext is passed to the claims mapper of a trust relationship
+// as the `ctx` external variable.
+//
+// swagger:ignore
+type ClaimsMapperContext struct {
+	// Claims are the claims of the verified assertion.
+	Claims map[string]any `json:"claims"`
+}
+
+type claimsMapperDependencies interface {
+	config.Provider
+	jsonnetsecure.VMProvider
+}
+
+// ClaimsMapper evaluates the Jsonnet claims mapper of a trust relationship in
+// the sandboxed Jsonnet VM. The mapper returns an object with the optional
+// fields `subject`, `scope` and `ext`.
+type ClaimsMapper struct {
+	r claimsMapperDependencies
+}
+
+var _ rfc7523.AssertionClaimsMapper = (*ClaimsMapper)(nil)
+
+func NewClaimsMapper(r claimsMapperDependencies) *ClaimsMapper {
+	return &ClaimsMapper{r: r}
+}
+
+// MapAssertionClaims implements rfc7523.AssertionClaimsMapper.
+func (m *ClaimsMapper) MapAssertionClaims(ctx context.Context, mapper string, claims map[string]any) (*rfc7523.AssertionClaimsMapping, error) {
+	input, err := json.Marshal(&ClaimsMapperContext{Claims: claims})
+	if err != nil {
+		return nil, errors.WithStack(
+			fosite.ErrServerError.
+				WithWrap(err).
+				WithDescription("An error occurred while encoding the claims mapper input.").
+				WithDebugf("Unable to encode the claims mapper input: %s", err),
+		)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, m.r.Config().GrantTypeJWTBearerClaimsMapperTimeout(ctx))
+	defer cancel()
+
+	vm, err := m.r.JsonnetVM(ctx)
+	if err != nil {
+		return nil, errors.WithStack(
+			fosite.ErrServerError.
+				WithWrap(err).
+				WithDescription("An error occurred while preparing the claims mapper.").
+				WithDebugf("Unable to create the Jsonnet VM: %s", err),
+		)
+	}
+	vm.ExtCode("ctx", string(input))
+
+	output, err := vm.EvaluateAnonymousSnippet("claims_mapper.jsonnet", mapper)
+	if err != nil {
+		return nil, errors.WithStack(
+			fosite.ErrInvalidGrant.
+				WithWrap(err).
+				WithHint("The claims mapper of the trust relationship rejected the assertion.").
+				WithDebugf("Unable to evaluate the claims mapper: %s", err),
+		)
+	}
+
+	var mapping rfc7523.AssertionClaimsMapping
+	if err := json.Unmarshal([]byte(output), &mapping); err != nil {
+		return nil, errors.WithStack(
+			fosite.ErrServerError.
+				WithWrap(err).
+				WithDescription("The claims mapper returned an invalid result.").
+				WithDebugf("Result of the claims mapper could not be decoded: %s", err),
+		)
+	}
+	return &mapping, nil
+}
@@ -0,0 +1,54 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package trust
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+)
+
+// discoverJWKSURI returns the JSON Web Key Set URL published in the OpenID
+// Connect Discovery document of the issuer.
+func discoverJWKSURI(ctx context.Context, r InternalRegistry, issuer string) (string, error) {
+	location := strings.TrimRight(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := retryablehttp.NewRequestWithContext(ctx, "GET", location, nil)
+	if err != nil {
+		return "", errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to discover the JSON Web Key Set of issuer %s: %s", issuer, err))
+	}
+
+	res, err := r.HTTPClient(ctx).Do(req)
+	if err != nil {
+		return "", errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to discover the JSON Web Key Set of issuer %s: %s", issuer, err))
+	}
+	defer res.Body.Close() //nolint:errcheck
+
+	if res.StatusCode != http.StatusOK {
+		return "", errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to discover the JSON Web Key Set of issuer %s: expected status code 200 from %s but got %d.", issuer, location, res.StatusCode))
+	}
+
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.NewDecoder(io.LimitReader(res.Body, 5<<20 /* 5 MiB */)).Decode(&discovery); err != nil {
+		return "", errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to decode the OpenID Connect Discovery document of issuer %s: %s", issuer, err))
+	}
+
+	if discovery.Issuer != issuer {
+		return "", errors.WithStack(herodot.ErrBadRequest().WithReasonf("The OpenID Connect Discovery document of issuer %s belongs to issuer %s.", issuer, discovery.Issuer))
+	}
+	if !isHTTPURL(discovery.JWKSURI) {
+		return "", errors.WithStack(herodot.ErrBadRequest().WithReasonf("The OpenID Connect Discovery document of issuer %s does not contain a valid jwks_uri.", issuer))
+	}
+
+	return discovery.JWKSURI, nil
+}
@@ -45,6 +45,17 @@ type trustedOAuth2JwtGrantIssuer struct {
 	// The "public_key" contains information about public key issued by "issuer", that will be used to check JWT assertion signature.
 	PublicKey trustedOAuth2JwtGrantJsonWebKey `json:"public_key"`
 
+	// The "jwks_uri" is the URL of the JSON Web Key Set published by "issuer", if the trust relationship is not
+	// backed by "public_key".
+	// example: https://jwt-idp.example.com/.well-known/jwks.json
+	JWKSURI string `json:"jwks_uri,omitempty"`
+
+	// The "claim_conditions" map claim paths of the JWT assertion to the values the claim may have.
+	ClaimConditions map[string][]string `json:"claim_conditions,omitempty"`
+
+	// The "claims_mapper" is a Jsonnet snippet deriving the subject, scope and ext claims of the access token.
+	ClaimsMapper string `json:"claims_mapper,omitempty"`
+
 	// The "created_at" indicates, when grant was created.
 	CreatedAt time.Time `json:"created_at"`
 
@@ -4,9 +4,14 @@
 package trust
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/x/sqlxx"
 )
 
 type Grant struct {
@@ -27,6 +32,18 @@ type Grant struct {
 	// PublicKeys contains information about public key issued by Issuer, that will be used to check JWT assertion signature.
 	PublicKey PublicKey `json:"public_key"`
 
+	// JWKSURI is the location of the JSON Web Key Set published by Issuer. If set, the grant is not backed by
+	// PublicKey but by the keys of that set, which are resolved when an assertion is checked.
+	JWKSURI string `json:"jwks_uri,omitempty"`
+
+	// ClaimConditions maps claim paths of the JWT assertion to the values the claim may have. Nested claims are
+	// separated by dots. Assertions not satisfying all conditions are rejected.
+	ClaimConditions ClaimConditions `json:"claim_conditions,omitempty"`
+
+	// ClaimsMapper is a Jsonnet snippet deriving the subject, scope and ext claims of the access token from the
+	// claims of the JWT assertion.
+	ClaimsMapper string `json:"claims_mapper,omitempty"`
+
 	// CreatedAt indicates, when grant was created.
 	CreatedAt time.Time `json:"created_at"`
 
@@ -41,3 +58,21 @@ type PublicKey struct {
 	// KeyID is key unique identifier (same as kid header in jws/jwt).
 	KeyID string `json:"kid"`
 }
+
+// ClaimConditions maps claim paths to the values the claim may have.
+type ClaimConditions map[string][]string
+
+func (c *ClaimConditions) Scan(value interface{}) error {
+	return sqlxx.JSONScan(c, value)
+}
+
+func (c ClaimConditions) Value() (driver.Value, error) {
+	if len(c) == 0 {
+		return nil, nil
+	}
+	value, err := json.Marshal(map[string][]string(c))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return string(value), nil
+}
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/go-jose/go-jose/v3"
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 
@@ -36,6 +37,7 @@ func (h *Handler) SetRoutes(admin *httprouterx.RouterAdmin) {
 	admin.GET(grantJWTBearerPath+"/{id}", h.getTrustedOAuth2JwtGrantIssuer)
 	admin.GET(grantJWTBearerPath, h.adminListTrustedOAuth2JwtGrantIssuers)
 	admin.POST(grantJWTBearerPath, h.trustOAuth2JwtGrantIssuer)
+	admin.PUT(grantJWTBearerPath+"/{id}", h.replaceTrustedOAuth2JwtGrantIssuer)
 	admin.DELETE(grantJWTBearerPath+"/{id}", h.deleteTrustedOAuth2JwtGrantIssuer)
 }
 
@@ -66,9 +68,33 @@ type trustOAuth2JwtGrantIssuerBody struct {
 	Scope []string `json:"scope"`
 
 	// The "jwk" contains public key in JWK format issued by "issuer", that will be used to check JWT assertion signature.
+	// Exactly one of "jwk", "jwks_uri" or "oidc_discovery" must be set.
+	JWK *x.JSONWebKey `json:"jwk,omitempty"`
+
+	// The "jwks_uri" is the URL of the JSON Web Key Set published by "issuer". Its keys are used to check the JWT
+	// assertion signature and are refreshed when the issuer rotates them. Can not be used together with "jwk" or
+	// "oidc_discovery".
 	//
-	// required:true
-	JWK x.JSONWebKey `json:"jwk"`
+	// example: https://jwt-idp.example.com/.well-known/jwks.json
+	JWKSURI string `json:"jwks_uri,omitempty"`
+
+	// The "oidc_discovery" indicates that the JSON Web Key Set URL is discovered from the OpenID Connect Discovery
+	// document of "issuer" when the trust relationship is created. Can not be used together with "jwk" or "jwks_uri".
+	OIDCDiscovery bool `json:"oidc_discovery,omitempty"`
+
+	// The "claim_conditions" map claim paths of the JWT assertion to the values the claim may have. Nested claims
+	// are separated by dots, and a claim containing a list matches if one of its elements is allowed. Assertions
+	// not satisfying all conditions are rejected.
+	//
+	// example: {"repository": ["org/app"], "environment": ["staging", "production"]}
+	ClaimConditions map[string][]string `json:"claim_conditions,omitempty"`
+
+	// The "claims_mapper" is a Jsonnet snippet which receives the claims of the JWT assertion as
+	// `std.extVar('ctx').claims` and returns an object with the optional fields "subject", "scope" and "ext".
+	// The "subject" replaces the subject of the access token, the "scope" restricts the scopes which may be
+	// requested, and "ext" is added to the access token claims. The mapper may reject the assertion using
+	// `error`.
+	ClaimsMapper string `json:"claims_mapper,omitempty"`
 
 	// The "expires_at" indicates, when grant will expire, so we will reject assertion from "issuer" targeting "subject".
 	//
@@ -94,6 +120,10 @@ type trustOAuth2JwtGrantIssuer struct {
 // to perform JSON Web Token (JWT) Profile for OAuth 2.0 Client Authentication
 // and Authorization Grants [RFC7523](https://datatracker.ietf.org/doc/html/rfc7523).
 //
+// The assertions are checked either with a single public key, or with the keys
+// of a JSON Web Key Set published by the issuer, so keys rotated by the issuer
+// are trusted without updating the trust relationship.
+//
 //	Consumes:
 //	- application/json
 //
@@ -109,43 +139,127 @@ type trustOAuth2JwtGrantIssuer struct {
 //	Extensions:
 //	  x-ory-ratelimit-bucket: hydra-admin-low
 func (h *Handler) trustOAuth2JwtGrantIssuer(w http.ResponseWriter, r *http.Request) {
-	var grantRequest createGrantRequest
+	grant, publicKey, err := h.decodeGrant(r, uuid.Must(uuid.NewV4()))
+	if err != nil {
+		h.registry.Writer().WriteError(w, r, err)
+		return
+	}
 
-	if err := json.NewDecoder(r.Body).Decode(&grantRequest); err != nil {
+	if err := h.registry.GrantManager().CreateGrant(r.Context(), grant, publicKey); err != nil {
+		h.registry.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.registry.Writer().WriteCreated(w, r, urlx.MustJoin(grantJWTBearerPath, url.PathEscape(grant.ID.String())), &grant)
+}
+
+// Replace Trusted OAuth2 JWT Bearer Grant Type Issuer Request
+//
+// swagger:parameters replaceTrustedOAuth2JwtGrantIssuer
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type replaceTrustedOAuth2JwtGrantIssuer struct {
+	// The id of the grant to replace
+	//
+	// in: path
+	// required: true
+	ID string `json:"id"`
+
+	// in: body
+	Body trustOAuth2JwtGrantIssuerBody
+}
+
+// swagger:route PUT /admin/trust/grants/jwt-bearer/issuers/{id} oAuth2 replaceTrustedOAuth2JwtGrantIssuer
+//
+// # Replace Trusted OAuth2 JWT Bearer Grant Type Issuer
+//
+// Use this endpoint to replace a trusted JWT Bearer Grant Type Issuer. The ID is the one returned when you
+// created the trust relationship and is kept.
+//
+// The trust relationship and its public key are replaced in a single transaction, so assertions of the
+// issuer are never rejected because neither the previous nor the new trust relationship exists.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: trustedOAuth2JwtGrantIssuer
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) replaceTrustedOAuth2JwtGrantIssuer(w http.ResponseWriter, r *http.Request) {
+	rawID := r.PathValue("id")
+	id, err := uuid.FromString(rawID)
+	if err != nil {
 		h.registry.Writer().WriteError(w, r,
-			errors.WithStack(&fosite.RFC6749Error{
-				ErrorField:       "error",
-				DescriptionField: err.Error(),
-				CodeField:        http.StatusBadRequest,
-			}))
+			errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse parameter id: %v", err)))
 		return
 	}
 
-	if err := validateGrant(grantRequest); err != nil {
+	grant, publicKey, err := h.decodeGrant(r, id)
+	if err != nil {
+		h.registry.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if err := h.registry.GrantManager().ReplaceGrant(r.Context(), grant, publicKey); err != nil {
 		h.registry.Writer().WriteError(w, r, err)
 		return
 	}
 
+	h.registry.Writer().Write(w, r, &grant)
+}
+
+// decodeGrant decodes and validates the trust relationship of the request
+// body, which is given the ID id.
+func (h *Handler) decodeGrant(r *http.Request, id uuid.UUID) (Grant, jose.JSONWebKey, error) {
+	var grantRequest createGrantRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&grantRequest); err != nil {
+		return Grant{}, jose.JSONWebKey{}, errors.WithStack(&fosite.RFC6749Error{
+			ErrorField:       "error",
+			DescriptionField: err.Error(),
+			CodeField:        http.StatusBadRequest,
+		})
+	}
+
+	if err := validateGrant(grantRequest); err != nil {
+		return Grant{}, jose.JSONWebKey{}, err
+	}
+
 	grant := Grant{
-		ID:              uuid.Must(uuid.NewV4()),
+		ID:              id,
 		Issuer:          grantRequest.Issuer,
 		Subject:         grantRequest.Subject,
 		AllowAnySubject: grantRequest.AllowAnySubject,
 		Scope:           grantRequest.Scope,
-		PublicKey: PublicKey{
+		JWKSURI:         grantRequest.JWKSURI,
+		ClaimConditions: grantRequest.ClaimConditions,
+		ClaimsMapper:    grantRequest.ClaimsMapper,
+		CreatedAt:       time.Now().UTC().Round(time.Second),
+		ExpiresAt:       grantRequest.ExpiresAt.UTC().Round(time.Second),
+	}
+	if grantRequest.OIDCDiscovery {
+		jwksURI, err := discoverJWKSURI(r.Context(), h.registry, grantRequest.Issuer)
+		if err != nil {
+			return Grant{}, jose.JSONWebKey{}, err
+		}
+		grant.JWKSURI = jwksURI
+	}
+	if grant.JWKSURI == "" {
+		grant.PublicKey = PublicKey{
 			Set:   grantRequest.Issuer, // group all keys by issuer, so set=issuer
 			KeyID: grantRequest.PublicKeyJWK.KeyID,
-		},
-		CreatedAt: time.Now().UTC().Round(time.Second),
-		ExpiresAt: grantRequest.ExpiresAt.UTC().Round(time.Second),
+		}
 	}
 
-	if err := h.registry.GrantManager().CreateGrant(r.Context(), grant, grantRequest.PublicKeyJWK); err != nil {
-		h.registry.Writer().WriteError(w, r, err)
-		return
-	}
-
-	h.registry.Writer().WriteCreated(w, r, urlx.MustJoin(grantJWTBearerPath, url.PathEscape(grant.ID.String())), &grant)
+	return grant, grantRequest.PublicKeyJWK, nil
 }
 
 // Get Trusted OAuth2 JWT Bearer Grant Type Issuer Request
@@ -148,7 +148,7 @@ func (s *HandlerTestSuite) TestGrantCanNotBeCreatedWithUnknownJWK() {
 		AllowAnySubject: new(true),
 		ExpiresAt:       time.Now().Add(1 * time.Hour),
 		Issuer:          "ory",
-		Jwk: hydra.JsonWebKey{
+		Jwk: &hydra.JsonWebKey{
 			Alg: "unknown",
 		},
 		Scope: []string{"openid", "offline", "profile"},
@@ -283,6 +283,80 @@ func (s *HandlerTestSuite) TestGrantCanBeDeleted() {
 	s.Error(err, "expected error, because grant has been already deleted")
 }
 
+func (s *HandlerTestSuite) TestGrantCanBeReplaced() {
+	createRequestParams := s.newCreateJwtBearerGrantParams(
+		"ory",
+		"hackerman@example.com",
+		false,
+		[]string{"openid", "offline", "profile"},
+		time.Now().Add(time.Hour),
+	)
+
+	createResult, _, err := s.hydraClient.OAuth2API.TrustOAuth2JwtGrantIssuer(context.Background()).TrustOAuth2JwtGrantIssuer(createRequestParams).Execute()
+	s.Require().NoError(err, "no errors expected on grant creation")
+
+	createRequestParams.Scope = []string{"openid"}
+	replaceResult, _, err := s.hydraClient.OAuth2API.ReplaceTrustedOAuth2JwtGrantIssuer(context.Background(), *createResult.Id).TrustOAuth2JwtGrantIssuer(createRequestParams).Execute()
+	s.Require().NoError(err, "no errors expected on grant replacement")
+	s.Equal(*createResult.Id, *replaceResult.Id, "id must be kept")
+
+	getResult, _, err := s.hydraClient.OAuth2API.GetTrustedOAuth2JwtGrantIssuer(context.Background(), *createResult.Id).Execute()
+	s.Require().NoError(err, "no errors expected on grant fetching")
+	s.Equal([]string{"openid"}, getResult.Scope, "scope must be replaced")
+	s.Equal(createRequestParams.Jwk.Kid, getResult.PublicKey.GetKid(), "public key id must match")
+
+	_, _, err = s.hydraClient.OAuth2API.ReplaceTrustedOAuth2JwtGrantIssuer(context.Background(), uuid.Must(uuid.NewV4()).String()).TrustOAuth2JwtGrantIssuer(createRequestParams).Execute()
+	s.Error(err, "expected error, because grant does not exist")
+}
+
+func (s *HandlerTestSuite) TestGrantCanBeCreatedWithJWKSURI() {
+	createRequestParams := s.newCreateJwtBearerGrantParams(
+		"ory",
+		"hackerman@example.com",
+		false,
+		[]string{"openid", "offline", "profile"},
+		time.Now().Add(time.Hour),
+	)
+	createRequestParams.Jwk = nil
+	createRequestParams.JwksUri = new("https://jwt-idp.example.com/.well-known/jwks.json")
+
+	createResult, _, err := s.hydraClient.OAuth2API.TrustOAuth2JwtGrantIssuer(context.Background()).TrustOAuth2JwtGrantIssuer(createRequestParams).Execute()
+	s.Require().NoError(err, "no errors expected on grant creation")
+	s.Equal(*createRequestParams.JwksUri, createResult.GetJwksUri(), "jwks_uri must match")
+	s.Empty(createResult.PublicKey.GetKid(), "no public key expected")
+
+	_, err = s.hydraClient.OAuth2API.DeleteTrustedOAuth2JwtGrantIssuer(context.Background(), *createResult.Id).Execute()
+	s.Require().NoError(err, "no errors expected on grant deletion")
+}
+
+func (s *HandlerTestSuite) TestGrantCanBeCreatedWithOIDCDiscovery() {
+	var issuer string
+	discovery := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": issuer, "jwks_uri": issuer + "/jwks.json"})
+	}))
+	defer discovery.Close()
+	issuer = discovery.URL
+
+	createRequestParams := s.newCreateJwtBearerGrantParams(
+		issuer,
+		"",
+		true,
+		[]string{"openid"},
+		time.Now().Add(time.Hour),
+	)
+	createRequestParams.Jwk = nil
+	createRequestParams.OidcDiscovery = new(true)
+
+	createResult, _, err := s.hydraClient.OAuth2API.TrustOAuth2JwtGrantIssuer(context.Background()).TrustOAuth2JwtGrantIssuer(createRequestParams).Execute()
+	s.Require().NoError(err, "no errors expected on grant creation")
+	s.Equal(issuer+"/jwks.json", createResult.GetJwksUri(), "discovered jwks_uri expected")
+
+	createRequestParams.Issuer = issuer + "/other"
+	_, res, err := s.hydraClient.OAuth2API.TrustOAuth2JwtGrantIssuer(context.Background()).TrustOAuth2JwtGrantIssuer(createRequestParams).Execute()
+	s.Require().Error(err, "expected error, because the discovery document belongs to another issuer")
+	s.Equal(http.StatusBadRequest, res.StatusCode)
+}
+
 func (s *HandlerTestSuite) generateJWK(publicKey *rsa.PublicKey) hydra.JsonWebKey {
 	var b bytes.Buffer
 	s.Require().NoError(json.NewEncoder(&b).Encode(&jose.JSONWebKey{
@@ -303,7 +377,7 @@ func (s *HandlerTestSuite) newCreateJwtBearerGrantParams(
 	return hydra.TrustOAuth2JwtGrantIssuer{
 		ExpiresAt:       expiresAt,
 		Issuer:          issuer,
-		Jwk:             s.generateJWK(s.publicKey),
+		Jwk:             new(s.generateJWK(s.publicKey)),
 		Scope:           scope,
 		Subject:         new(subject),
 		AllowAnySubject: new(allowAnySubject),
@@ -17,6 +17,7 @@ type GrantManager interface {
 	CreateGrant(ctx context.Context, g Grant, publicKey jose.JSONWebKey) error
 	GetConcreteGrant(ctx context.Context, id uuid.UUID) (Grant, error)
 	DeleteGrant(ctx context.Context, id uuid.UUID) error
+	ReplaceGrant(ctx context.Context, g Grant, publicKey jose.JSONWebKey) error
 	GetGrants(ctx context.Context, optionalIssuer string, pageOpts ...keysetpagination.Option) ([]Grant, *keysetpagination.Paginator, error)
 	FlushInactiveGrants(ctx context.Context, notAfter time.Time, limit int, batchSize int) error
 }
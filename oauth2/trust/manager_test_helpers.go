@@ -180,6 +180,27 @@ func TestHelperGrantManagerErrors(m GrantManager, km jwk.Manager) func(t *testin
 		}
 		require.NoError(t, m.CreateGrant(t.Context(), grant2, pubKey2))
 
+		replaced := grant2
+		replaced.PublicKey.KeyID = kid1
+		err = m.ReplaceGrant(t.Context(), replaced, pubKey1)
+		require.ErrorIs(t, err, sqlcon.ErrUniqueViolation(), "error expected, because the replacement conflicts with the first grant")
+
+		stored, err := m.GetConcreteGrant(t.Context(), grant2.ID)
+		require.NoError(t, err, "the replaced grant must be kept if the replacement fails")
+		assert.Equal(t, kid2, stored.PublicKey.KeyID)
+		_, err = km.GetKey(t.Context(), set, kid2)
+		require.NoError(t, err, "the key of the replaced grant must be kept if the replacement fails")
+
+		replaced = grant2
+		replaced.Scope = []string{"openid"}
+		require.NoError(t, m.ReplaceGrant(t.Context(), replaced, pubKey2))
+		stored, err = m.GetConcreteGrant(t.Context(), grant2.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"openid"}, stored.Scope)
+
+		err = m.ReplaceGrant(t.Context(), Grant{ID: uuid.Must(uuid.NewV4())}, pubKey2)
+		require.ErrorIs(t, err, sqlcon.ErrNoRows(), "expect error, when replacing non-existing grant")
+
 		nonExistingGrantID := uuid.Must(uuid.NewV4())
 		err = m.DeleteGrant(t.Context(), nonExistingGrantID)
 		require.Error(t, err, "expect error, when deleting non-existing grant")
@@ -12,6 +12,7 @@ import (
 
 type InternalRegistry interface {
 	httpx.WriterProvider
+	httpx.ClientProvider
 	logrusx.Provider
 	Registry
 	config.Provider
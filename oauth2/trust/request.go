@@ -25,6 +25,18 @@ type createGrantRequest struct {
 	// PublicKeyJWK contains public key in JWK format issued by Issuer, that will be used to check JWT assertion signature.
 	PublicKeyJWK jose.JSONWebKey `json:"jwk"`
 
+	// JWKSURI is the location of the JSON Web Key Set published by Issuer, that will be used to check JWT assertion signature.
+	JWKSURI string `json:"jwks_uri"`
+
+	// OIDCDiscovery indicates that JWKSURI is discovered from the OpenID Connect Discovery document of Issuer.
+	OIDCDiscovery bool `json:"oidc_discovery"`
+
+	// ClaimConditions maps claim paths of the JWT assertion to the values the claim may have.
+	ClaimConditions ClaimConditions `json:"claim_conditions"`
+
+	// ClaimsMapper is a Jsonnet snippet deriving the subject, scope and ext claims of the access token.
+	ClaimsMapper string `json:"claims_mapper"`
+
 	// ExpiresAt indicates, when grant will expire, so we will reject assertion from Issuer targeting Subject.
 	ExpiresAt time.Time `json:"expires_at"`
 }
@@ -3,7 +3,14 @@
 
 package trust
 
-import "github.com/pkg/errors"
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/fosite"
+)
 
 func validateGrant(request createGrantRequest) error {
 	if request.Issuer == "" {
@@ -22,9 +29,36 @@ func validateGrant(request createGrantRequest) error {
 		return errors.WithStack(ErrMissingRequiredParameter.WithHint("Field 'expires_at' is required."))
 	}
 
-	if request.PublicKeyJWK.KeyID == "" {
+	hasJWK := request.PublicKeyJWK.Key != nil || request.PublicKeyJWK.KeyID != ""
+	switch {
+	case request.JWKSURI != "" && (hasJWK || request.OIDCDiscovery),
+		request.OIDCDiscovery && hasJWK:
+		return errors.WithStack(fosite.ErrInvalidRequest.WithHint("Only one of 'jwk', 'jwks_uri' or 'oidc_discovery' fields can be set."))
+	case request.JWKSURI != "":
+		if !isHTTPURL(request.JWKSURI) {
+			return errors.WithStack(fosite.ErrInvalidRequest.WithHint("Field 'jwks_uri' must be an absolute HTTP(S) URL."))
+		}
+	case request.OIDCDiscovery:
+		if !isHTTPURL(request.Issuer) {
+			return errors.WithStack(fosite.ErrInvalidRequest.WithHint("Field 'issuer' must be an absolute HTTP(S) URL when 'oidc_discovery' is set."))
+		}
+	case request.PublicKeyJWK.KeyID == "":
 		return errors.WithStack(ErrMissingRequiredParameter.WithHint("Field 'jwk' must contain JWK with kid header."))
 	}
 
+	for path, values := range request.ClaimConditions {
+		if path == "" || strings.HasPrefix(path, ".") || strings.HasSuffix(path, ".") || strings.Contains(path, "..") {
+			return errors.WithStack(ErrMissingRequiredParameter.WithHintf("Field 'claim_conditions' contains the invalid claim path '%s'.", path))
+		}
+		if len(values) == 0 {
+			return errors.WithStack(ErrMissingRequiredParameter.WithHintf("Field 'claim_conditions' must allow at least one value for claim '%s'.", path))
+		}
+	}
+
 	return nil
 }
+
+func isHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+}
@@ -121,3 +121,101 @@ func TestIsValid(t *testing.T) {
 
 	assert.NoError(t, validateGrant(r))
 }
+
+func TestRemoteKeySetIsValid(t *testing.T) {
+	for _, r := range []createGrantRequest{
+		{
+			Issuer:          "valid-issuer",
+			AllowAnySubject: true,
+			ExpiresAt:       time.Now().Add(time.Hour * 10),
+			JWKSURI:         "https://valid-issuer.example.com/.well-known/jwks.json",
+		},
+		{
+			Issuer:          "https://valid-issuer.example.com",
+			AllowAnySubject: true,
+			ExpiresAt:       time.Now().Add(time.Hour * 10),
+			OIDCDiscovery:   true,
+		},
+	} {
+		assert.NoError(t, validateGrant(r))
+	}
+}
+
+func TestInvalidRemoteKeySetIsInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		r    createGrantRequest
+		hint string
+	}{
+		{
+			r: createGrantRequest{
+				JWKSURI:      "https://valid-issuer.example.com/.well-known/jwks.json",
+				PublicKeyJWK: jose.JSONWebKey{KeyID: "valid-key-id"},
+			},
+			hint: "Only one of 'jwk', 'jwks_uri' or 'oidc_discovery' fields can be set.",
+		},
+		{
+			r: createGrantRequest{
+				JWKSURI:       "https://valid-issuer.example.com/.well-known/jwks.json",
+				OIDCDiscovery: true,
+			},
+			hint: "Only one of 'jwk', 'jwks_uri' or 'oidc_discovery' fields can be set.",
+		},
+		{
+			r:    createGrantRequest{JWKSURI: "/.well-known/jwks.json"},
+			hint: "Field 'jwks_uri' must be an absolute HTTP(S) URL.",
+		},
+		{
+			r:    createGrantRequest{OIDCDiscovery: true},
+			hint: "Field 'issuer' must be an absolute HTTP(S) URL when 'oidc_discovery' is set.",
+		},
+	} {
+		tc.r.Issuer = "valid-issuer"
+		tc.r.Subject = "valid-subject"
+		tc.r.ExpiresAt = time.Now().Add(time.Hour * 10)
+
+		err := &fosite.RFC6749Error{}
+		require.ErrorAs(t, validateGrant(tc.r), &err)
+		assert.ErrorIs(t, err, fosite.ErrInvalidRequest)
+		assert.Equal(t, tc.hint, err.HintField)
+	}
+}
+
+func TestInvalidClaimConditionsAreInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		conditions ClaimConditions
+		hint       string
+	}{
+		{
+			conditions: ClaimConditions{"repository": {}},
+			hint:       "Field 'claim_conditions' must allow at least one value for claim 'repository'.",
+		},
+		{
+			conditions: ClaimConditions{"": {"org/app"}},
+			hint:       "Field 'claim_conditions' contains the invalid claim path ''.",
+		},
+		{
+			conditions: ClaimConditions{"job..ref": {"main"}},
+			hint:       "Field 'claim_conditions' contains the invalid claim path 'job..ref'.",
+		},
+	} {
+		r := createGrantRequest{
+			Issuer:          "valid-issuer",
+			Subject:         "valid-subject",
+			ExpiresAt:       time.Now().Add(time.Hour * 10),
+			PublicKeyJWK:    jose.JSONWebKey{KeyID: "valid-key-id"},
+			ClaimConditions: tc.conditions,
+		}
+
+		err := &fosite.RFC6749Error{}
+		require.ErrorAs(t, validateGrant(r), &err)
+		assert.Equal(t, tc.hint, err.HintField)
+	}
+
+	assert.NoError(t, validateGrant(createGrantRequest{
+		Issuer:          "valid-issuer",
+		AllowAnySubject: true,
+		ExpiresAt:       time.Now().Add(time.Hour * 10),
+		PublicKeyJWK:    jose.JSONWebKey{KeyID: "valid-key-id"},
+		ClaimConditions: ClaimConditions{"repository": {"org/app"}, "job_workflow_ref.environment": {"staging", "production"}},
+	}))
+}
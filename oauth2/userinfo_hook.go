@@ -0,0 +1,166 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package oauth2
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/x/otelx"
+)
+
+// UserinfoHookRequest is the request body sent to the userinfo hook.
+//
+// swagger:ignore
+type UserinfoHookRequest struct {
+	// Subject is the identifier of the authenticated end-user.
+	Subject string `json:"subject"`
+	// ClientID is the identifier of the OAuth 2.0 client.
+	ClientID string `json:"client_id"`
+	// GrantedScopes is the list of scopes granted to the OAuth 2.0 client.
+	GrantedScopes []string `json:"granted_scopes"`
+	// GrantedAudience is the list of audiences granted to the OAuth 2.0 client.
+	GrantedAudience []string `json:"granted_audience"`
+	// Session is the session of the access token.
+	Session *Session `json:"session"`
+}
+
+// UserinfoHookResponse is the response body received from the userinfo hook.
+//
+// swagger:ignore
+type UserinfoHookResponse struct {
+	// Claims are the end-user's current claims.
+	Claims map[string]interface{} `json:"claims"`
+	// ClaimNames maps aggregated and distributed claims to their source in
+	// ClaimSources, see OpenID Connect Core 1.0 Section 5.6.2.
+	ClaimNames map[string]string `json:"claim_names,omitempty"`
+	// ClaimSources are the sources of aggregated and distributed claims.
+	ClaimSources map[string]UserinfoClaimSource `json:"claim_sources,omitempty"`
+}
+
+// UserinfoClaimSource is the source of aggregated or distributed claims.
+//
+// swagger:ignore
+type UserinfoClaimSource struct {
+	// JWT contains the aggregated claims signed by their issuer.
+	JWT string `json:"JWT,omitempty"`
+	// Endpoint is the URL distributed claims can be retrieved from.
+	Endpoint string `json:"endpoint,omitempty"`
+	// AccessToken is the access token for retrieving distributed claims.
+	AccessToken string `json:"access_token,omitempty"`
+}
+
+// userinfoReservedClaims are set by Ory Hydra and can not be overridden by
+// the userinfo hook.
+var userinfoReservedClaims = []string{"sub", "iss", "aud", "auth_time", "acr", "amr", "rat", "iat", "jti", "_claim_names", "_claim_sources"}
+
+// userinfoFromHook calls the userinfo hook and returns the claims to respond
+// with. It returns nil if no hook is configured, if the hook responded without
+// a body, or if it failed and is configured to fail open.
+func (h *Handler) userinfoFromHook(ctx context.Context, requester fosite.Requester, session *Session, interim map[string]interface{}) (_ map[string]interface{}, err error) {
+	hookConfig := h.c.UserinfoHookConfig(ctx)
+	if hookConfig == nil {
+		return nil, nil
+	}
+
+	ctx, span := h.r.Tracer(ctx).Tracer().Start(ctx, "oauth2.UserinfoHook")
+	defer otelx.End(span, &err)
+
+	reqBodyBytes, err := json.Marshal(&UserinfoHookRequest{
+		Subject:         session.GetSubject(),
+		ClientID:        requester.GetClient().GetID(),
+		GrantedScopes:   requester.GetGrantedScopes(),
+		GrantedAudience: requester.GetGrantedAudience(),
+		Session:         session,
+	})
+	if err != nil {
+		return nil, errors.WithStack(
+			fosite.ErrServerError.
+				WithWrap(err).
+				WithDescription("An error occurred while encoding the userinfo hook.").
+				WithDebugf("Unable to encode the userinfo hook body: %s", err),
+		)
+	}
+
+	resp, err := executeHook(ctx, h.r, h.userinfoHook, hookConfig, userinfoGrantKey(requester, session), reqBodyBytes)
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	allowed := func(claim string) bool {
+		return userinfoClaimAllowed(claim, requester.GetGrantedScopes(), session)
+	}
+
+	claims := make(map[string]interface{}, len(resp.Claims)+len(interim))
+	for k, v := range resp.Claims {
+		if !slices.Contains(userinfoReservedClaims, k) && allowed(k) {
+			claims[k] = v
+		}
+	}
+	for _, k := range userinfoReservedClaims {
+		if v, ok := interim[k]; ok {
+			claims[k] = v
+		}
+	}
+
+	names := make(map[string]string, len(resp.ClaimNames))
+	sources := make(map[string]UserinfoClaimSource, len(resp.ClaimSources))
+	for claim, src := range resp.ClaimNames {
+		source, ok := resp.ClaimSources[src]
+		if !ok || slices.Contains(userinfoReservedClaims, claim) || !allowed(claim) {
+			continue
+		}
+		names[claim] = src
+		sources[src] = source
+	}
+	if len(names) > 0 {
+		claims["_claim_names"] = names
+		claims["_claim_sources"] = sources
+	}
+
+	return claims, nil
+}
+
+// userinfoClaimAllowed reports whether a claim may be returned from the
+// userinfo endpoint. Standard claims require their scope to be granted. If the
+// client sent a claims request for the userinfo endpoint, only the requested
+// claims and the claims requested by the granted scopes are returned.
+func userinfoClaimAllowed(claim string, grantedScopes fosite.Arguments, session *Session) bool {
+	for scope, claims := range flow.ScopeClaims {
+		if slices.Contains(claims, claim) && !grantedScopes.Has(scope) {
+			return false
+		}
+	}
+
+	if session.ClaimsRequest != nil && session.ClaimsRequest.UserInfo != nil {
+		_, ok := session.ClaimsRequest.UserInfo[claim]
+		return ok || flow.RequestedByScope(claim, grantedScopes)
+	}
+	return true
+}
+
+// userinfoGrantKey identifies the grant an access token belongs to. Userinfo
+// hook responses are cached under this key.
+func userinfoGrantKey(requester fosite.Requester, session *Session) string {
+	h := sha256.New()
+	for _, v := range []string{
+		requester.GetClient().GetID(),
+		session.GetSubject(),
+		session.ConsentChallenge,
+		strings.Join(requester.GetGrantedScopes(), " "),
+		strings.Join(requester.GetGrantedAudience(), " "),
+	} {
+		_, _ = h.Write([]byte(v))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
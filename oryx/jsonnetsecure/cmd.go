@@ -26,7 +26,10 @@ const (
 )
 
 func NewJsonnetCmd() *cobra.Command {
-	var null bool
+	var (
+		null        bool
+		memoryLimit uint64
+	)
 	cmd := &cobra.Command{
 		Use:    "jsonnet",
 		Short:  "Run Jsonnet as a CLI command",
@@ -44,7 +47,7 @@ func NewJsonnetCmd() *cobra.Command {
 
 			// This could fail because current limits are lower than what we tried to set,
 			// so we still continue in this case.
-			SetVirtualMemoryLimit(virtualMemoryLimitBytes)
+			SetVirtualMemoryLimit(memoryLimit)
 
 			if null {
 				return scan(cmd.OutOrStdout(), cmd.InOrStdin())
@@ -71,6 +74,7 @@ func NewJsonnetCmd() *cobra.Command {
 Output will be in the same order as inputs, separated by null bytes.
 Evaluation errors will also be reported to stdout, separated by null bytes.
 Non-recoverable errors are written to stderr and the program will terminate with a non-zero exit code.`)
+	cmd.Flags().Uint64Var(&memoryLimit, "memory-limit", virtualMemoryLimitBytes, "The virtual memory limit of the process in bytes.")
 
 	return cmd
 }
@@ -22,6 +22,8 @@ import (
 	"io"
 	"math"
 	"os/exec"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -55,6 +57,9 @@ type (
 	pool struct {
 		puddle *puddle.Pool[worker]
 	}
+	poolOptions struct {
+		memoryLimit uint64
+	}
 	worker struct {
 		cmd    *exec.Cmd
 		stdin  chan<- []byte
@@ -74,12 +79,31 @@ var (
 	contextValueArgs contextKeyType = "argv"
 )
 
-func NewProcessPool(size int) Pool {
+// PoolOption configures a process pool.
+type PoolOption func(*poolOptions)
+
+// WithMemoryLimit limits the virtual memory of each worker process to the
+// given number of bytes. Evaluations exceeding the limit fail, and the worker
+// is replaced.
+func WithMemoryLimit(bytes uint64) PoolOption {
+	return func(o *poolOptions) {
+		o.memoryLimit = bytes
+	}
+}
+
+func NewProcessPool(size int, opts ...PoolOption) Pool {
+	var o poolOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	size = max(5, min(size, math.MaxInt32))
 	pud, err := puddle.NewPool(&puddle.Config[worker]{
-		MaxSize:     int32(size), //nolint:gosec // disable G115 // because of the previous min/max, 5 <= size <= math.MaxInt32
-		Constructor: newWorker,
-		Destructor:  worker.destroy,
+		MaxSize: int32(size), //nolint:gosec // disable G115 // because of the previous min/max, 5 <= size <= math.MaxInt32
+		Constructor: func(ctx context.Context) (worker, error) {
+			return newWorker(ctx, o)
+		},
+		Destructor: worker.destroy,
 	})
 	if err != nil {
 		panic(err) // this should never happen, see implementation of puddle.NewPool
@@ -109,7 +133,7 @@ func (p *pool) Close() {
 	p.puddle.Close()
 }
 
-func newWorker(ctx context.Context) (_ worker, err error) {
+func newWorker(ctx context.Context, o poolOptions) (_ worker, err error) {
 	tracer := trace.SpanFromContext(ctx).TracerProvider().Tracer("")
 	ctx, span := tracer.Start(ctx, "jsonnetsecure.newWorker")
 	defer otelx.End(span, &err)
@@ -119,7 +143,11 @@ func newWorker(ctx context.Context) (_ worker, err error) {
 		return worker{}, errors.New("newWorker: missing binary path in context")
 	}
 	args, _ := ctx.Value(contextValueArgs).([]string)
-	cmd := exec.Command(path, append(args, "-0")...)
+	args = append(slices.Clone(args), "-0")
+	if o.memoryLimit > 0 {
+		args = append(args, "--memory-limit", strconv.FormatUint(o.memoryLimit, 10))
+	}
+	cmd := exec.Command(path, args...)
 	cmd.Env = []string{"GOMAXPROCS=1"}
 	cmd.WaitDelay = 100 * time.Millisecond
 
@@ -233,7 +261,11 @@ func (w worker) eval(ctx context.Context, processParams []byte) (output string,
 	select {
 	case <-ctx.Done():
 		return "", ctx.Err()
-	case output := <-w.stdout:
+	case output, ok := <-w.stdout:
+		if !ok {
+			// The process exited, e.g. because it exceeded the memory limit.
+			return "", errors.New("jsonnetsecure: worker process exited")
+		}
 		return output, nil
 	case err := <-w.stderr:
 		return "", errors.New(err)
@@ -32,8 +32,8 @@ type (
 	}
 )
 
-func NewTestProvider(t testing.TB) *TestProvider {
-	pool := NewProcessPool(runtime.GOMAXPROCS(0))
+func NewTestProvider(t testing.TB, opts ...PoolOption) *TestProvider {
+	pool := NewProcessPool(runtime.GOMAXPROCS(0), opts...)
 	t.Cleanup(pool.Close)
 	return &TestProvider{JsonnetTestBinary(t), pool}
 }
@@ -5,10 +5,15 @@ package persistence
 
 import (
 	"context"
+	"time"
 
+	"github.com/ory/hydra/v2/bundle"
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/hydra/v2/janitor"
+	"github.com/ory/hydra/v2/oauth2/scope"
 	"github.com/ory/hydra/v2/oauth2/trust"
+	"github.com/ory/hydra/v2/tenant"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/pop/v6"
 	"github.com/ory/x/networkx"
@@ -19,9 +24,20 @@ type (
 		consent.ObfuscatedSubjectManager
 		consent.LoginManager
 		consent.LogoutManager
+		consent.PairwiseSubjectManager
+		consent.SubjectEraser
 		client.Manager
 		x.FositeStorer
 		trust.GrantManager
+		scope.Manager
+		tenant.Manager
+		janitor.Manager
+		bundle.Manager
+
+		// PartitionTables converts the token and flow tables to the
+		// partitioned storage layout, using partitions covering the given
+		// interval.
+		PartitionTables(ctx context.Context, interval time.Duration) error
 
 		Connection(context.Context) *pop.Connection
 		Transaction(context.Context, func(ctx context.Context, c *pop.Connection) error) error
@@ -0,0 +1 @@
+ALTER TABLE hydra_client DROP COLUMN token_claims_mapper_url;
@@ -0,0 +1 @@
+ALTER TABLE hydra_client ADD COLUMN token_claims_mapper_url TEXT NULL;
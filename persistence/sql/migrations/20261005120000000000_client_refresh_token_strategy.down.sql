@@ -0,0 +1 @@
+ALTER TABLE hydra_client DROP COLUMN refresh_token_strategy;
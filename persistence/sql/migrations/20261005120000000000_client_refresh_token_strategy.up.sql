@@ -0,0 +1 @@
+ALTER TABLE hydra_client ADD COLUMN refresh_token_strategy VARCHAR(10) NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+DROP TABLE hydra_oauth2_refresh_deny_list;
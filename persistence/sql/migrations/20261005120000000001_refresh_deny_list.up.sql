@@ -0,0 +1,11 @@
+CREATE TABLE hydra_oauth2_refresh_deny_list
+(
+  id         VARCHAR(40) NOT NULL,
+  nid        UUID        NOT NULL,
+  expires_at TIMESTAMP   NULL,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE,
+  PRIMARY KEY (id, nid)
+);
+
+CREATE INDEX hydra_oauth2_refresh_deny_list_expires_at_idx ON hydra_oauth2_refresh_deny_list (nid, expires_at);
@@ -0,0 +1,11 @@
+CREATE TABLE hydra_oauth2_revocation_feed
+(
+  id         CHAR(36)    NOT NULL PRIMARY KEY,
+  nid        CHAR(36)    NOT NULL,
+  request_id VARCHAR(40) NOT NULL,
+  revoked_at TIMESTAMP   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+CREATE INDEX hydra_oauth2_revocation_feed_revoked_at_idx ON hydra_oauth2_revocation_feed (nid, revoked_at);
@@ -0,0 +1,14 @@
+CREATE TABLE hydra_tenant
+(
+  id          UUID         NOT NULL PRIMARY KEY,
+  name        VARCHAR(255) NOT NULL,
+  hosts       TEXT         NOT NULL,
+  path_prefix VARCHAR(255) NOT NULL DEFAULT '',
+  config      TEXT         NOT NULL,
+  created_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (id) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX hydra_tenant_name_idx ON hydra_tenant (name);
@@ -0,0 +1 @@
+DROP TABLE hydra_janitor_lease;
@@ -0,0 +1,10 @@
+CREATE TABLE hydra_janitor_lease
+(
+  name        VARCHAR(64)  NOT NULL PRIMARY KEY,
+  holder      VARCHAR(255) NOT NULL DEFAULT '',
+  expires_at  TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  paused      BOOLEAN      NOT NULL DEFAULT FALSE,
+  last_run_at TIMESTAMP    NULL
+);
+
+INSERT INTO hydra_janitor_lease (name) VALUES ('janitor');
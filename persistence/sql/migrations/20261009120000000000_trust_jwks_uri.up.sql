@@ -0,0 +1 @@
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ADD COLUMN jwks_uri VARCHAR(2048) NOT NULL DEFAULT '';
@@ -0,0 +1,4 @@
+-- Trust relationships backed by a remote JSON Web Key Set cannot be kept once a key is required again.
+DELETE FROM hydra_oauth2_trusted_jwt_bearer_issuer WHERE key_set IS NULL OR key_id IS NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ALTER COLUMN key_set SET NOT NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ALTER COLUMN key_id SET NOT NULL;
@@ -0,0 +1,6 @@
+-- Trust relationships backed by a remote JSON Web Key Set cannot be kept once a key is required again.
+DELETE FROM hydra_oauth2_trusted_jwt_bearer_issuer WHERE key_set IS NULL OR key_id IS NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer DROP FOREIGN KEY `hydra_oauth2_trusted_jwt_bearer_issuer_ibfk_1`;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer MODIFY `key_set` varchar(255) NOT NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer MODIFY `key_id` varchar(255) CHARACTER SET `ascii` NOT NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ADD CONSTRAINT `hydra_oauth2_trusted_jwt_bearer_issuer_ibfk_1` FOREIGN KEY (`key_set`, `key_id`, `nid`) REFERENCES `hydra_jwk` (`sid`, `kid`, `nid`) ON DELETE CASCADE;
@@ -0,0 +1,27 @@
+CREATE TABLE hydra_oauth2_trusted_jwt_bearer_issuer_next (
+    id                VARCHAR(36)   PRIMARY KEY,
+    issuer            VARCHAR(255)  NOT NULL,
+    subject           VARCHAR(255)  NOT NULL,
+    scope             TEXT          NOT NULL,
+    key_set           VARCHAR(255)  NOT NULL,
+    key_id            VARCHAR(255)  NOT NULL,
+    created_at        TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at        TIMESTAMP     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    nid               CHAR(36)      NOT NULL,
+    allow_any_subject INTEGER       NOT NULL DEFAULT FALSE,
+    jwks_uri          VARCHAR(2048) NOT NULL DEFAULT '',
+    FOREIGN KEY (key_set, key_id, nid) REFERENCES hydra_jwk (sid, kid, nid) ON DELETE CASCADE
+);
+
+INSERT INTO hydra_oauth2_trusted_jwt_bearer_issuer_next (id, issuer, subject, scope, key_set, key_id, created_at, expires_at, nid, allow_any_subject, jwks_uri)
+SELECT id, issuer, subject, scope, key_set, key_id, created_at, expires_at, nid, allow_any_subject, jwks_uri
+FROM hydra_oauth2_trusted_jwt_bearer_issuer
+WHERE key_set IS NOT NULL AND key_id IS NOT NULL;
+
+DROP TABLE hydra_oauth2_trusted_jwt_bearer_issuer;
+
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer_next
+  RENAME TO hydra_oauth2_trusted_jwt_bearer_issuer;
+
+CREATE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_expires_at_idx ON hydra_oauth2_trusted_jwt_bearer_issuer (expires_at);
+CREATE UNIQUE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_nid_uq_idx ON hydra_oauth2_trusted_jwt_bearer_issuer (nid ASC, key_id ASC, issuer ASC, subject ASC);
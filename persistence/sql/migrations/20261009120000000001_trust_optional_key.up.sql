@@ -0,0 +1,3 @@
+-- Trust relationships backed by a remote JSON Web Key Set do not reference a key in hydra_jwk.
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ALTER COLUMN key_set DROP NOT NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ALTER COLUMN key_id DROP NOT NULL;
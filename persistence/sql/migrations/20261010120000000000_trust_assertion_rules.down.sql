@@ -0,0 +1,2 @@
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer DROP COLUMN claims_mapper;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer DROP COLUMN claim_conditions;
@@ -0,0 +1,2 @@
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ADD COLUMN claim_conditions TEXT NULL;
+ALTER TABLE hydra_oauth2_trusted_jwt_bearer_issuer ADD COLUMN claims_mapper TEXT NULL;
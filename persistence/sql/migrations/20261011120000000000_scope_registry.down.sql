@@ -0,0 +1 @@
+DROP TABLE hydra_oauth2_scope;
@@ -0,0 +1,17 @@
+CREATE TABLE hydra_oauth2_scope
+(
+  id               CHAR(36)     NOT NULL PRIMARY KEY,
+  nid              CHAR(36)     NOT NULL,
+  name             VARCHAR(255) NOT NULL,
+  description      TEXT         NOT NULL,
+  display_names    TEXT         NOT NULL,
+  sensitivity      VARCHAR(10)  NOT NULL DEFAULT 'low',
+  default_audience TEXT         NOT NULL,
+  requires_consent BOOLEAN      NOT NULL DEFAULT FALSE,
+  created_at       TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX hydra_oauth2_scope_name_idx ON hydra_oauth2_scope (nid, name);
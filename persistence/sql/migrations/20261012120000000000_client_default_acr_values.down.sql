@@ -0,0 +1 @@
+ALTER TABLE hydra_client DROP COLUMN default_acr_values;
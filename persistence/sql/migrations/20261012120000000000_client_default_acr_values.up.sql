@@ -0,0 +1 @@
+ALTER TABLE hydra_client ADD COLUMN default_acr_values JSONB NULL;
@@ -0,0 +1 @@
+ALTER TABLE hydra_client ADD COLUMN require_auth_time BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1 @@
+ALTER TABLE hydra_client ADD COLUMN minimum_acr VARCHAR(255) NOT NULL DEFAULT '';
@@ -0,0 +1,21 @@
+CREATE TABLE hydra_oauth2_consent_history
+(
+  id                 CHAR(36)     NOT NULL PRIMARY KEY,
+  nid                CHAR(36)     NOT NULL,
+  event              VARCHAR(10)  NOT NULL,
+  subject            VARCHAR(255) NOT NULL,
+  client_id          VARCHAR(255) NOT NULL,
+  consent_request_id VARCHAR(40)  NOT NULL,
+  granted_scope      TEXT         NOT NULL,
+  granted_audience   TEXT         NOT NULL,
+  remember           BOOLEAN      NOT NULL DEFAULT FALSE,
+  remember_for       INTEGER      NOT NULL DEFAULT 0,
+  consented_at       TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at         TIMESTAMP    NULL,
+  revocation_reason  TEXT         NOT NULL,
+  created_at         TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+CREATE INDEX hydra_oauth2_consent_history_subject_idx ON hydra_oauth2_consent_history (nid, subject, created_at, id);
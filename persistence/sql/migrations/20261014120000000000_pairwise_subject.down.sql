@@ -0,0 +1 @@
+DROP TABLE hydra_oauth2_pairwise_subject;
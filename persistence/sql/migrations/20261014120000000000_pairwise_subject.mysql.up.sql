@@ -0,0 +1,18 @@
+CREATE TABLE hydra_oauth2_pairwise_subject
+(
+  nid                       CHAR(36)     NOT NULL,
+  client_id                 VARCHAR(255) NOT NULL,
+  subject                   VARCHAR(255) NOT NULL,
+  pairwise_subject          VARCHAR(255) NOT NULL,
+  previous_pairwise_subject VARCHAR(255) NOT NULL DEFAULT '',
+  sector_identifier         TEXT         NOT NULL,
+  created_at                TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (nid, client_id, subject),
+  FOREIGN KEY (client_id, nid) REFERENCES hydra_client (id, nid) ON DELETE CASCADE,
+  FOREIGN KEY (nid) REFERENCES networks (id) ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX hydra_oauth2_pairwise_subject_pairwise_idx ON hydra_oauth2_pairwise_subject (nid, client_id, pairwise_subject);
+CREATE INDEX hydra_oauth2_pairwise_subject_previous_idx ON hydra_oauth2_pairwise_subject (nid, client_id, previous_pairwise_subject);
+CREATE INDEX hydra_oauth2_pairwise_subject_subject_idx ON hydra_oauth2_pairwise_subject (nid, subject);
@@ -0,0 +1 @@
+CREATE UNIQUE INDEX IF NOT EXISTS hydra_oauth2_trusted_jwt_bearer_issuer_remote_uq_idx ON hydra_oauth2_trusted_jwt_bearer_issuer (nid, issuer, subject) WHERE key_id IS NULL;
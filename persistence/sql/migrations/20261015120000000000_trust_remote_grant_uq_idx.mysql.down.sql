@@ -0,0 +1 @@
+DROP INDEX hydra_oauth2_trusted_jwt_bearer_issuer_remote_uq_idx ON hydra_oauth2_trusted_jwt_bearer_issuer;
@@ -0,0 +1,3 @@
+-- MySQL does not support partial indexes. The expression is NULL for trust relationships with a key ID, which
+-- are therefore not constrained by this index.
+CREATE UNIQUE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_remote_uq_idx ON hydra_oauth2_trusted_jwt_bearer_issuer (nid, issuer, subject, ((CASE WHEN key_id IS NULL THEN 1 END)));
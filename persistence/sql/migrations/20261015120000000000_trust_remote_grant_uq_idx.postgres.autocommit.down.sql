@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS hydra_oauth2_trusted_jwt_bearer_issuer_remote_uq_idx;
@@ -0,0 +1,3 @@
+-- Trust relationships backed by a remote JSON Web Key Set have no key ID, so they are not covered by
+-- hydra_oauth2_trusted_jwt_bearer_issuer_nid_uq_idx.
+CREATE UNIQUE INDEX hydra_oauth2_trusted_jwt_bearer_issuer_remote_uq_idx ON hydra_oauth2_trusted_jwt_bearer_issuer (nid, issuer, subject) WHERE key_id IS NULL;
@@ -0,0 +1 @@
+ALTER TABLE hydra_oauth2_authentication_session ADD COLUMN acr VARCHAR(255) NOT NULL DEFAULT '';
@@ -6,12 +6,14 @@ package sql
 import (
 	"context"
 	"reflect"
+	"sync"
 
 	"github.com/gofrs/uuid"
 
 	"github.com/ory/hydra/v2/aead"
 	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fositex"
 	"github.com/ory/hydra/v2/internal/kratos"
 	"github.com/ory/hydra/v2/jwk"
 	"github.com/ory/hydra/v2/oauth2"
@@ -42,6 +44,7 @@ type (
 		ClientHasher() fosite.Hasher
 		KeyCipher() *aead.AESGCM
 		FlowCipher() *aead.XChaCha20Poly1305
+		OAuth2JWERefreshTokenStrategy() *fositex.JWERefreshTokenStrategy
 		Kratos() kratos.Client
 		contextx.Provider
 		logrusx.Provider
@@ -50,6 +53,7 @@ type (
 	}
 	BasePersister struct {
 		c           *pop.Connection
+		replicas    *readReplicas
 		fallbackNID uuid.UUID
 		d           baseDependencies
 	}
@@ -59,6 +63,7 @@ type (
 		contextx.Provider
 		config.Provider
 		jwk.ManagerProvider
+		OAuth2IntrospectionCache() *oauth2.IntrospectionCache
 	}
 	BasePersisterProvider interface {
 		BasePersister() *BasePersister
@@ -88,11 +93,13 @@ func (p BasePersister) WithFallbackNetworkID(nid uuid.UUID) *BasePersister {
 
 func (p *BasePersister) CreateWithNetwork(ctx context.Context, v interface{}) error {
 	p.mustSetNetwork(ctx, v)
+	p.PinPrimary(ctx)
 	return p.Connection(ctx).Create(v)
 }
 
 func (p *BasePersister) UpdateWithNetwork(ctx context.Context, v interface{}) (int64, error) {
 	p.mustSetNetwork(ctx, v)
+	p.PinPrimary(ctx)
 
 	m := pop.NewModel(v, ctx)
 	cols := m.Columns()
@@ -131,6 +138,53 @@ func (p *BasePersister) mustSetNetwork(ctx context.Context, v interface{}) {
 	nf.Set(reflect.ValueOf(p.NetworkID(ctx)))
 }
 
+// Transaction runs f in a transaction. Nested calls join the outermost
+// transaction. Functions registered with afterCommit run once the outermost
+// transaction was committed.
 func (p *BasePersister) Transaction(ctx context.Context, f func(ctx context.Context, c *pop.Connection) error) error {
-	return popx.Transaction(ctx, p.c, f)
+	p.PinPrimary(ctx)
+	if popx.InTransaction(ctx) {
+		return popx.Transaction(ctx, p.c, f)
+	}
+
+	hooks := new(afterCommitHooks)
+	if err := popx.Transaction(context.WithValue(ctx, afterCommitHooksKey{}, hooks), p.c, f); err != nil {
+		return err
+	}
+	hooks.run()
+	return nil
+}
+
+type (
+	afterCommitHooksKey struct{}
+	afterCommitHooks    struct {
+		mu  sync.Mutex
+		fns []func()
+	}
+)
+
+// afterCommit runs fn after the transaction of the context was committed, or
+// right away if the context has no transaction. If the transaction is retried,
+// fn may run more than once.
+func afterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(afterCommitHooksKey{}).(*afterCommitHooks)
+	if !ok {
+		fn()
+		return
+	}
+
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+	hooks.fns = append(hooks.fns, fn)
+}
+
+func (h *afterCommitHooks) run() {
+	h.mu.Lock()
+	fns := h.fns
+	h.fns = nil
+	h.mu.Unlock()
+
+	for _, fn := range fns {
+		fn()
+	}
 }
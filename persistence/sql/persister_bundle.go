@@ -0,0 +1,70 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ory/hydra/v2/bundle"
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/hydra/v2/x/events"
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+)
+
+var _ bundle.Manager = (*Persister)(nil)
+
+// ListJsonWebKeySets implements bundle.Manager
+func (p *Persister) ListJsonWebKeySets(ctx context.Context) (_ []string, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ListJsonWebKeySets")
+	defer otelx.End(span, &err)
+
+	var sets []string
+	if err := p.Connection(ctx).RawQuery(
+		"SELECT DISTINCT sid FROM hydra_jwk WHERE nid = ? ORDER BY sid",
+		p.NetworkID(ctx),
+	).All(&sets); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return sets, nil
+}
+
+// ListForcedObfuscatedLoginSessions implements bundle.Manager
+func (p *Persister) ListForcedObfuscatedLoginSessions(ctx context.Context) (_ []consent.ForcedObfuscatedLoginSession, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ListForcedObfuscatedLoginSessions")
+	defer otelx.End(span, &err)
+
+	var sessions []consent.ForcedObfuscatedLoginSession
+	if err := p.QueryWithNetwork(ctx).Order("client_id, subject").All(&sessions); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return sessions, nil
+}
+
+// ImportClient implements bundle.Manager
+func (p *Persister) ImportClient(ctx context.Context, cl *client.Client) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ImportClient",
+		trace.WithAttributes(events.ClientID(cl.ID)),
+	)
+	defer otelx.End(span, &err)
+
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		if _, err := p.GetConcreteClient(ctx, cl.ID); errors.Is(err, sqlcon.ErrNoRows()) {
+			return sqlcon.HandleError(p.CreateWithNetwork(ctx, cl))
+		} else if err != nil {
+			return err
+		}
+
+		if err := cl.BeforeSave(c); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		_, err := p.UpdateWithNetwork(ctx, cl)
+		return sqlcon.HandleError(err)
+	})
+}
@@ -124,6 +124,8 @@ func (p *Persister) DeleteClient(ctx context.Context, id string) (err error) {
 	)
 	defer otelx.End(span, &err)
 
+	p.PinPrimary(ctx)
+
 	c, err := p.GetConcreteClient(ctx, id)
 	if err != nil {
 		return err
@@ -180,7 +182,9 @@ func (p *Persister) GetConcreteClient(ctx context.Context, id string) (c *client
 	defer otelx.End(span, &err)
 
 	var cl client.Client
-	if err := p.QueryWithNetwork(ctx).Where("id = ?", id).First(&cl); err != nil {
+	if err := p.ReadReplicaWithNetwork(ctx, func(q func() *pop.Query) error {
+		return q().Where("id = ?", id).First(&cl)
+	}); err != nil {
 		return nil, sqlcon.HandleError(err)
 	}
 	return &cl, nil
@@ -38,34 +38,35 @@ type ConsentPersister struct {
 	*BasePersister
 }
 
-func (p *ConsentPersister) RevokeSubjectConsentSession(ctx context.Context, user string) (err error) {
+func (p *ConsentPersister) RevokeSubjectConsentSession(ctx context.Context, user, reason string) (err error) {
 	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.RevokeSubjectConsentSession")
 	defer otelx.End(span, &err)
 
-	return p.Transaction(ctx, p.revokeConsentSession("consent_challenge_id IS NOT NULL AND subject = ?", user))
+	return p.Transaction(ctx, p.revokeConsentSession(reason, "consent_challenge_id IS NOT NULL AND subject = ?", user))
 }
 
-func (p *ConsentPersister) RevokeSubjectClientConsentSession(ctx context.Context, user, clientID string) (err error) {
+func (p *ConsentPersister) RevokeSubjectClientConsentSession(ctx context.Context, user, clientID, reason string) (err error) {
 	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.RevokeSubjectClientConsentSession", trace.WithAttributes(attribute.String("client.id", clientID)))
 	defer otelx.End(span, &err)
 
-	return p.Transaction(ctx, p.revokeConsentSession("consent_challenge_id IS NOT NULL AND subject = ? AND client_id = ?", user, clientID))
+	return p.Transaction(ctx, p.revokeConsentSession(reason, "consent_challenge_id IS NOT NULL AND subject = ? AND client_id = ?", user, clientID))
 }
 
-func (p *ConsentPersister) RevokeConsentSessionByID(ctx context.Context, consentRequestID string) (err error) {
+func (p *ConsentPersister) RevokeConsentSessionByID(ctx context.Context, consentRequestID, reason string) (err error) {
 	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.RevokeConsentSessionByID",
 		trace.WithAttributes(attribute.String("consent_challenge_id", consentRequestID)))
 	defer otelx.End(span, &err)
 
-	return p.Transaction(ctx, p.revokeConsentSession("consent_challenge_id = ?", consentRequestID))
+	return p.Transaction(ctx, p.revokeConsentSession(reason, "consent_challenge_id = ?", consentRequestID))
 }
 
-func (p *ConsentPersister) revokeConsentSession(whereStmt string, whereArgs ...interface{}) func(context.Context, *pop.Connection) error {
+func (p *ConsentPersister) revokeConsentSession(reason, whereStmt string, whereArgs ...interface{}) func(context.Context, *pop.Connection) error {
 	return func(ctx context.Context, c *pop.Connection) error {
 		fs := make([]*flow.Flow, 0)
 		if err := p.QueryWithNetwork(ctx).
 			Where(whereStmt, whereArgs...).
-			Select("consent_challenge_id").
+			Select("nid", "consent_challenge_id", "subject", "client_id", "consent_skip", "granted_scope", "granted_at_audience",
+				"consent_remember", "consent_remember_for", "consent_handled_at").
 			All(&fs); errors.Is(err, sql.ErrNoRows) {
 			return errors.WithStack(x.ErrNotFound)
 		} else if err != nil {
@@ -73,15 +74,28 @@ func (p *ConsentPersister) revokeConsentSession(whereStmt string, whereArgs ...i
 		}
 
 		ids := make([]interface{}, 0, len(fs))
+		requestIDs := make([]string, 0, len(fs))
 		nid := p.NetworkID(ctx)
 		for _, f := range fs {
 			ids = append(ids, f.ConsentRequestID.String())
+			requestIDs = append(requestIDs, f.ConsentRequestID.String())
 		}
 
 		if len(ids) == 0 {
 			return nil
 		}
 
+		// JWE refresh tokens are not stored and are revoked through the deny-list.
+		if err := p.denyRefreshTokenRequests(ctx, requestIDs...); err != nil {
+			return err
+		}
+		if err := p.recordRevocations(ctx, requestIDs...); err != nil {
+			return err
+		}
+		if err := p.recordConsentRevocations(ctx, reason, fs); err != nil {
+			return err
+		}
+
 		if err := p.QueryWithNetwork(ctx).
 			Where("nid = ?", nid).
 			Where("request_id IN (?)", ids...).
@@ -100,6 +114,25 @@ func (p *ConsentPersister) revokeConsentSession(whereStmt string, whereArgs ...i
 			return err
 		}
 
+		// The remaining sessions are usually removed by the foreign keys
+		// cascading from the flow table, which are dropped when the flow table
+		// is partitioned.
+		for _, table := range []string{
+			OAuth2RequestSQL{Table: sqlTableCode}.TableName(),
+			OAuth2RequestSQL{Table: sqlTableOpenID}.TableName(),
+			OAuth2RequestSQL{Table: sqlTablePKCE}.TableName(),
+			DeviceRequestSQL{}.TableName(),
+		} {
+			if err := p.QueryWithNetwork(ctx).
+				Where("nid = ?", nid).
+				Where("challenge_id IN (?)", ids...).
+				Delete(table); errors.Is(err, fosite.ErrNotFound) {
+				// do nothing
+			} else if err != nil {
+				return err
+			}
+		}
+
 		if err := p.QueryWithNetwork(ctx).
 			Where("nid = ?", nid).
 			Where("consent_challenge_id IN (?)", ids...).
@@ -223,7 +256,12 @@ func (p *ConsentPersister) CreateConsentSession(ctx context.Context, f *flow.Flo
 	if f.NID != p.NetworkID(ctx) {
 		return errors.WithStack(sqlcon.ErrNoRows())
 	}
-	return sqlcon.HandleError(p.Connection(ctx).Create(f))
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		if err := c.Create(f); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		return p.recordConsentGrant(ctx, f)
+	})
 }
 
 func (p *Persister) GetRememberedLoginSession(ctx context.Context, id string) (_ *flow.LoginSession, err error) {
@@ -231,7 +269,9 @@ func (p *Persister) GetRememberedLoginSession(ctx context.Context, id string) (_
 	defer otelx.End(span, &err)
 
 	var s flow.LoginSession
-	if err := p.QueryWithNetwork(ctx).Where("remember = TRUE").Find(&s, id); errors.Is(err, sql.ErrNoRows) {
+	if err := p.ReadReplicaWithNetwork(ctx, func(q func() *pop.Query) error {
+		return q().Where("remember = TRUE").Find(&s, id)
+	}); errors.Is(err, sql.ErrNoRows) {
 		return nil, errors.WithStack(x.ErrNotFound)
 	} else if err != nil {
 		return nil, sqlcon.HandleError(err)
@@ -245,6 +285,8 @@ func (p *Persister) ConfirmLoginSession(ctx context.Context, loginSession *flow.
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ConfirmLoginSession")
 	defer otelx.End(span, &err)
 
+	p.PinPrimary(ctx)
+
 	loginSession.NID = p.NetworkID(ctx)
 	loginSession.AuthenticatedAt = sqlxx.NullTime(time.Time(loginSession.AuthenticatedAt).Truncate(time.Second))
 	loginSession.ExpiresAt = sqlxx.NullTime(time.Now().Truncate(time.Second).Add(p.r.Config().GetAuthenticationSessionLifespan(ctx)).UTC())
@@ -255,15 +297,16 @@ func (p *Persister) ConfirmLoginSession(ctx context.Context, loginSession *flow.
 	}
 
 	res, err := p.Connection(ctx).Store.NamedExecContext(ctx, `
-INSERT INTO hydra_oauth2_authentication_session (id, nid, authenticated_at, subject, remember, identity_provider_session_id, expires_at)
-VALUES (:id, :nid, :authenticated_at, :subject, :remember, :identity_provider_session_id, :expires_at)
+INSERT INTO hydra_oauth2_authentication_session (id, nid, authenticated_at, subject, remember, identity_provider_session_id, expires_at, acr)
+VALUES (:id, :nid, :authenticated_at, :subject, :remember, :identity_provider_session_id, :expires_at, :acr)
 ON CONFLICT(id) DO
 UPDATE SET
 	authenticated_at = :authenticated_at,
 	subject = :subject,
 	remember = :remember,
 	identity_provider_session_id = :identity_provider_session_id,
-	expires_at = :expires_at
+	expires_at = :expires_at,
+	acr = :acr
 WHERE hydra_oauth2_authentication_session.id = :id AND hydra_oauth2_authentication_session.nid = :nid
 `, loginSession)
 	if err != nil {
@@ -283,6 +326,8 @@ func (p *Persister) DeleteLoginSession(ctx context.Context, id string) (_ *flow.
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteLoginSession")
 	defer otelx.End(span, &err)
 
+	p.PinPrimary(ctx)
+
 	c := p.Connection(ctx)
 	if c.Dialect.Name() == "mysql" {
 		// MySQL does not support RETURNING.
@@ -730,6 +775,7 @@ func (p *Persister) FlushInactiveLoginConsentRequests(ctx context.Context, notAf
 		if err := q.Exec(); err != nil {
 			return sqlcon.HandleError(err)
 		}
+		janitorDeletedRecords.WithLabelValues("hydra_oauth2_flow").Add(float64(j - i))
 	}
 
 	return nil
@@ -748,7 +794,7 @@ func (p *Persister) mySQLConfirmLoginSession(ctx context.Context, session *flow.
 
 		n, err := c.
 			Where("id = ? and nid = ?", session.ID, session.NID).
-			UpdateQuery(session, "authenticated_at", "subject", "identity_provider_session_id", "remember", "expires_at")
+			UpdateQuery(session, "authenticated_at", "subject", "identity_provider_session_id", "remember", "expires_at", "acr")
 		if err != nil {
 			return errors.WithStack(sqlcon.HandleError(err))
 		}
@@ -0,0 +1,101 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/x/otelx"
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
+	"github.com/ory/x/sqlcon"
+)
+
+// recordConsentGrant appends the consent granted in the flow to the consent
+// history. Skipped consent requests reuse an earlier consent and are not
+// recorded.
+func (p *ConsentPersister) recordConsentGrant(ctx context.Context, f *flow.Flow) error {
+	if f.ConsentSkip {
+		return nil
+	}
+
+	// Revoked consents are removed, so the subject modifies a consent if the
+	// client holds another one which has not expired.
+	event := consent.ConsentEventGranted
+	exists, err := p.QueryWithNetwork(ctx).
+		Where("subject = ? AND client_id = ?", f.Subject, f.ClientID).
+		Where("consent_challenge_id != ?", f.ConsentRequestID).
+		Where("(state IN (?, ?) OR state IS NULL)", flow.FlowStateConsentUsed, flow.FlowStateConsentUnused).
+		Where("consent_skip = FALSE").
+		Where("(expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)").
+		Exists(new(flow.Flow))
+	if err != nil {
+		return sqlcon.HandleError(err)
+	} else if exists {
+		event = consent.ConsentEventModified
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	return sqlcon.HandleError(p.CreateWithNetwork(ctx, consent.NewConsentHistoryEntry(f, event, now)))
+}
+
+// recordConsentRevocations appends the revocation of the consents granted in
+// the flows to the consent history.
+func (p *ConsentPersister) recordConsentRevocations(ctx context.Context, reason string, fs []*flow.Flow) error {
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, f := range fs {
+		if f.ConsentSkip {
+			continue
+		}
+
+		e := consent.NewConsentHistoryEntry(f, consent.ConsentEventRevoked, now)
+		e.RevocationReason = reason
+		if err := p.CreateWithNetwork(ctx, e); err != nil {
+			return sqlcon.HandleError(err)
+		}
+	}
+	return nil
+}
+
+// ListSubjectConsentHistory implements consent.Manager
+func (p *ConsentPersister) ListSubjectConsentHistory(ctx context.Context, subject string, pageOpts ...keysetpagination.Option) (_ []consent.ConsentHistoryEntry, _ *keysetpagination.Paginator, err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ListSubjectConsentHistory")
+	defer otelx.End(span, &err)
+
+	paginator, err := keysetpagination.NewPaginator(append(pageOpts,
+		keysetpagination.WithDefaultToken(keysetpagination.NewPageToken(
+			keysetpagination.Column{Name: "created_at", Value: time.Time{}},
+			keysetpagination.Column{Name: "id", Value: uuid.Nil},
+		)),
+	)...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entries []consent.ConsentHistoryEntry
+	if err := p.QueryWithNetwork(ctx).
+		Where("subject = ?", subject).
+		Scope(keysetpagination.Paginate[consent.ConsentHistoryEntry](paginator)).
+		All(&entries); err != nil {
+		return nil, nil, sqlcon.HandleError(err)
+	}
+
+	entries, nextPage := keysetpagination.Result(entries, paginator)
+	return entries, nextPage, nil
+}
+
+// DeleteSubjectConsentHistory implements consent.Manager
+func (p *ConsentPersister) DeleteSubjectConsentHistory(ctx context.Context, subject string) (err error) {
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteSubjectConsentHistory")
+	defer otelx.End(span, &err)
+
+	return sqlcon.HandleError(p.Connection(ctx).RawQuery(
+		"DELETE FROM hydra_oauth2_consent_history WHERE nid = ? AND subject = ?",
+		p.NetworkID(ctx), subject,
+	).Exec())
+}
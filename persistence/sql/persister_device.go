@@ -18,7 +18,9 @@ import (
 
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/x"
 	"github.com/ory/x/otelx"
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
 	"github.com/ory/x/sqlcon"
 	"github.com/ory/x/sqlxx"
 	"github.com/ory/x/stringsx"
@@ -288,3 +290,78 @@ func (p *Persister) UpdateDeviceCodeSessionBySignature(ctx context.Context, sign
 		).Exec(),
 	)
 }
+
+// GetDeviceAuthRequest returns the device authorization request of the user code signature. Implements FositeStorer.
+func (p *Persister) GetDeviceAuthRequest(ctx context.Context, userCodeSignature string, session fosite.Session) (_ fosite.DeviceRequester, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetDeviceAuthRequest")
+	defer otelx.End(span, &err)
+
+	if session == nil {
+		session = oauth2.NewSessionWithCustomClaims(ctx, p.r.Config(), "")
+	}
+
+	r := DeviceRequestSQL{}
+	if err = p.QueryWithNetwork(ctx).Where("user_code_signature = ?", userCodeSignature).First(&r); errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.WithStack(x.ErrNotFound)
+	} else if err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	return r.toRequest(ctx, session, p)
+}
+
+// RejectDeviceAuthRequest marks the unused user code as rejected. Implements FositeStorer.
+func (p *Persister) RejectDeviceAuthRequest(ctx context.Context, userCodeSignature string) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.RejectDeviceAuthRequest")
+	defer otelx.End(span, &err)
+
+	stmt := fmt.Sprintf(
+		"UPDATE %s SET user_code_state=? WHERE user_code_signature=? AND user_code_state=? AND nid = ?",
+		sqlTableDeviceAuthCodes,
+	)
+
+	/* #nosec G201 table is static */
+	n, err := p.Connection(ctx).RawQuery(stmt,
+		fosite.UserCodeRejected, userCodeSignature, fosite.UserCodeUnused, p.NetworkID(ctx),
+	).ExecWithCount()
+	if err != nil {
+		return sqlcon.HandleError(err)
+	} else if n == 0 {
+		return errors.WithStack(x.ErrNotFound)
+	}
+	return nil
+}
+
+// ListPendingDeviceAuthRequests lists the pending device authorization requests of the client. Implements FositeStorer.
+func (p *Persister) ListPendingDeviceAuthRequests(ctx context.Context, clientID string, pageOpts ...keysetpagination.Option) (_ []fosite.DeviceRequester, _ *keysetpagination.Paginator, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ListPendingDeviceAuthRequests")
+	defer otelx.End(span, &err)
+
+	paginator, err := keysetpagination.NewPaginator(append(pageOpts,
+		keysetpagination.WithDefaultToken(keysetpagination.NewPageToken(
+			keysetpagination.Column{Name: "requested_at", Value: time.Time{}},
+			keysetpagination.Column{Name: "device_code_signature", Value: ""},
+		)),
+	)...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rows []DeviceRequestSQL
+	if err := p.QueryWithNetwork(ctx).
+		Where("client_id = ? AND user_code_state = ? AND device_code_active = ? AND expires_at > ?",
+			clientID, fosite.UserCodeUnused, true, time.Now().UTC()).
+		Scope(keysetpagination.Paginate[DeviceRequestSQL](paginator)).
+		All(&rows); err != nil {
+		return nil, nil, sqlcon.HandleError(err)
+	}
+
+	rows, nextPage := keysetpagination.Result(rows, paginator)
+	requests := make([]fosite.DeviceRequester, len(rows))
+	for i := range rows {
+		if requests[i], err = rows[i].toRequest(ctx, oauth2.NewSessionWithCustomClaims(ctx, p.r.Config(), ""), p); err != nil {
+			return nil, nil, err
+		}
+	}
+	return requests, nextPage, nil
+}
@@ -0,0 +1,184 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+)
+
+var _ consent.SubjectEraser = (*Persister)(nil)
+
+// queryBatchSize bounds the number of values bound in a single IN list or
+// multi-row INSERT, so that statements stay well below the placeholder limits
+// of all dialects.
+const queryBatchSize = 500
+
+// ListSubjectLoginSessionIDs implements consent.SubjectEraser
+func (p *Persister) ListSubjectLoginSessionIDs(ctx context.Context, subject string) (_ []string, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ListSubjectLoginSessionIDs")
+	defer otelx.End(span, &err)
+
+	var ids []string
+	if err := p.Connection(ctx).RawQuery(
+		"SELECT id FROM "+flow.LoginSession{}.TableName()+" WHERE nid = ? AND subject = ? ORDER BY id",
+		p.NetworkID(ctx), subject,
+	).All(&ids); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return ids, nil
+}
+
+// EraseSubject implements consent.SubjectEraser
+func (p *Persister) EraseSubject(ctx context.Context, subject string) (_ map[string]int, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.EraseSubject")
+	defer otelx.End(span, &err)
+
+	deleted := make(map[string]int)
+	if err := p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		nid := p.NetworkID(ctx)
+
+		// Tokens may have been issued to a pairwise identifier of the subject.
+		var obfuscated []string
+		if err := c.RawQuery(
+			"SELECT DISTINCT subject_obfuscated FROM "+consent.ForcedObfuscatedLoginSession{}.TableName()+" WHERE nid = ? AND subject = ?",
+			nid, subject,
+		).All(&obfuscated); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		var pairwise []string
+		if err := c.RawQuery(
+			"SELECT pairwise_subject FROM "+consent.PairwiseSubject{}.TableName()+" WHERE nid = ? AND subject = ?"+
+				" UNION SELECT previous_pairwise_subject FROM "+consent.PairwiseSubject{}.TableName()+" WHERE nid = ? AND subject = ? AND previous_pairwise_subject <> ''",
+			nid, subject, nid, subject,
+		).All(&pairwise); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		subjects := uniqueStrings(append(append([]string{subject}, obfuscated...), pairwise...))
+
+		var requestIDs []string
+		if err := c.RawQuery(
+			"SELECT consent_challenge_id FROM "+flow.Flow{}.TableName()+" WHERE nid = ? AND subject = ? AND consent_challenge_id IS NOT NULL",
+			nid, subject,
+		).All(&requestIDs); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		for _, table := range []string{OAuth2RequestSQL{Table: sqlTableAccess}.TableName(), OAuth2RefreshTable{}.TableName()} {
+			for batch := range slices.Chunk(subjects, queryBatchSize) {
+				var ids []string
+				/* #nosec G201 table and placeholders are static */
+				if err := c.RawQuery(
+					fmt.Sprintf("SELECT DISTINCT request_id FROM %s WHERE nid = ? AND subject IN (%s)", table, placeholders(len(batch))),
+					append([]any{nid}, stringsToAny(batch)...)...,
+				).All(&ids); err != nil {
+					return sqlcon.HandleError(err)
+				}
+				requestIDs = append(requestIDs, ids...)
+			}
+		}
+		requestIDs = uniqueStrings(requestIDs)
+
+		// JWE refresh tokens are not stored and are revoked through the deny-list.
+		if err := p.denyRefreshTokenRequests(ctx, requestIDs...); err != nil {
+			return err
+		}
+		if err := p.recordRevocations(ctx, requestIDs...); err != nil {
+			return err
+		}
+
+		tokenTables := []string{
+			OAuth2RequestSQL{Table: sqlTableOpenID}.TableName(),
+			OAuth2RequestSQL{Table: sqlTableAccess}.TableName(),
+			OAuth2RefreshTable{}.TableName(),
+			OAuth2RequestSQL{Table: sqlTableCode}.TableName(),
+			OAuth2RequestSQL{Table: sqlTablePKCE}.TableName(),
+			string(sqlTableDeviceAuthCodes),
+		}
+		for _, table := range tokenTables {
+			for _, by := range []struct {
+				column string
+				values []string
+			}{{"subject", subjects}, {"request_id", requestIDs}} {
+				for batch := range slices.Chunk(by.values, queryBatchSize) {
+					/* #nosec G201 table, column and placeholders are static */
+					n, err := c.RawQuery(
+						fmt.Sprintf("DELETE FROM %s WHERE nid = ? AND %s IN (%s)", table, by.column, placeholders(len(batch))),
+						append([]any{nid}, stringsToAny(batch)...)...,
+					).ExecWithCount()
+					if err != nil {
+						return sqlcon.HandleError(err)
+					}
+					deleted[table] += n
+				}
+			}
+		}
+
+		for _, table := range []string{
+			flow.Flow{}.TableName(),
+			flow.LoginSession{}.TableName(),
+			consent.ForcedObfuscatedLoginSession{}.TableName(),
+			"hydra_oauth2_logout_request",
+			consent.ConsentHistoryEntry{}.TableName(),
+			consent.PairwiseSubject{}.TableName(),
+		} {
+			/* #nosec G201 table is static */
+			n, err := c.RawQuery(fmt.Sprintf("DELETE FROM %s WHERE nid = ? AND subject = ?", table), nid, subject).ExecWithCount()
+			if err != nil {
+				return sqlcon.HandleError(err)
+			}
+			deleted[table] = n
+		}
+
+		// Trust relationships issued for the subject are deleted together with their keys.
+		var grants []SQLGrant
+		if err := p.QueryWithNetwork(ctx).Where("subject = ?", subject).Select("id").All(&grants); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		for _, g := range grants {
+			if err := p.DeleteGrant(ctx, g.ID); err != nil {
+				return err
+			}
+		}
+		deleted[SQLGrant{}.TableName()] = len(grants)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// placeholders returns n comma-separated query placeholders.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func stringsToAny(ss []string) []any {
+	out := make([]any, len(ss))
+	for i, s := range ss {
+		out[i] = s
+	}
+	return out
+}
+
+func uniqueStrings(ss []string) []string {
+	seen := make(map[string]struct{}, len(ss))
+	out := ss[:0]
+	for _, s := range ss {
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		out = append(out, s)
+	}
+	return out
+}
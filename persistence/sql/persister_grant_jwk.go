@@ -12,6 +12,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
 
+	"github.com/ory/hydra/v2/fosite/handler/rfc7523"
 	"github.com/ory/hydra/v2/jwk"
 	"github.com/ory/hydra/v2/oauth2/trust"
 	"github.com/ory/pop/v6"
@@ -30,8 +31,11 @@ type SQLGrant struct {
 	Subject         string                         `db:"subject"`
 	AllowAnySubject bool                           `db:"allow_any_subject"`
 	Scope           sqlxx.StringSlicePipeDelimiter `db:"scope"`
-	KeySet          string                         `db:"key_set"`
-	KeyID           string                         `db:"key_id"`
+	KeySet          sqlxx.NullString               `db:"key_set"`
+	KeyID           sqlxx.NullString               `db:"key_id"`
+	JWKSURI         string                         `db:"jwks_uri"`
+	ClaimConditions trust.ClaimConditions          `db:"claim_conditions"`
+	ClaimsMapper    sqlxx.NullString               `db:"claims_mapper"`
 	CreatedAt       time.Time                      `db:"created_at"`
 	ExpiresAt       time.Time                      `db:"expires_at"`
 }
@@ -47,8 +51,11 @@ func (SQLGrant) fromGrant(g trust.Grant) SQLGrant {
 		Subject:         g.Subject,
 		AllowAnySubject: g.AllowAnySubject,
 		Scope:           g.Scope,
-		KeySet:          g.PublicKey.Set,
-		KeyID:           g.PublicKey.KeyID,
+		KeySet:          sqlxx.NullString(g.PublicKey.Set),
+		KeyID:           sqlxx.NullString(g.PublicKey.KeyID),
+		JWKSURI:         g.JWKSURI,
+		ClaimConditions: g.ClaimConditions,
+		ClaimsMapper:    sqlxx.NullString(g.ClaimsMapper),
 		CreatedAt:       g.CreatedAt,
 		ExpiresAt:       g.ExpiresAt,
 	}
@@ -62,11 +69,21 @@ func (d SQLGrant) toGrant() trust.Grant {
 		AllowAnySubject: d.AllowAnySubject,
 		Scope:           d.Scope,
 		PublicKey: trust.PublicKey{
-			Set:   d.KeySet,
-			KeyID: d.KeyID,
+			Set:   d.KeySet.String(),
+			KeyID: d.KeyID.String(),
 		},
-		CreatedAt: d.CreatedAt,
-		ExpiresAt: d.ExpiresAt,
+		JWKSURI:         d.JWKSURI,
+		ClaimConditions: d.ClaimConditions,
+		ClaimsMapper:    d.ClaimsMapper.String(),
+		CreatedAt:       d.CreatedAt,
+		ExpiresAt:       d.ExpiresAt,
+	}
+}
+
+func (d SQLGrant) toAssertionRule() rfc7523.AssertionRule {
+	return rfc7523.AssertionRule{
+		ClaimConditions: d.ClaimConditions,
+		ClaimsMapper:    d.ClaimsMapper.String(),
 	}
 }
 
@@ -76,14 +93,17 @@ func (p *Persister) CreateGrant(ctx context.Context, g trust.Grant, publicKey jo
 	defer otelx.End(span, &err)
 
 	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
-		// add key, if it doesn't exist
-		if _, err := p.d.KeyManager().GetKey(ctx, g.PublicKey.Set, g.PublicKey.KeyID); err != nil {
-			if !errors.Is(err, sqlcon.ErrNoRows()) {
-				return sqlcon.HandleError(err)
-			}
-
-			if err = p.d.KeyManager().AddKey(ctx, g.PublicKey.Set, &publicKey); err != nil {
-				return sqlcon.HandleError(err)
+		// add key, if it doesn't exist; grants backed by a remote JSON Web Key
+		// Set do not reference a key
+		if g.JWKSURI == "" {
+			if _, err := p.d.KeyManager().GetKey(ctx, g.PublicKey.Set, g.PublicKey.KeyID); err != nil {
+				if !errors.Is(err, sqlcon.ErrNoRows()) {
+					return sqlcon.HandleError(err)
+				}
+
+				if err = p.d.KeyManager().AddKey(ctx, g.PublicKey.Set, &publicKey); err != nil {
+					return sqlcon.HandleError(err)
+				}
 			}
 		}
 
@@ -120,10 +140,28 @@ func (p *Persister) DeleteGrant(ctx context.Context, id uuid.UUID) (err error) {
 			return sqlcon.HandleError(err)
 		}
 
+		if grant.JWKSURI != "" {
+			return nil
+		}
 		return p.d.KeyManager().DeleteKey(ctx, grant.PublicKey.Set, grant.PublicKey.KeyID)
 	})
 }
 
+// ReplaceGrant implements GrantManager
+func (p *Persister) ReplaceGrant(ctx context.Context, g trust.Grant, publicKey jose.JSONWebKey) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ReplaceGrant")
+	defer otelx.End(span, &err)
+
+	// The new grant may reuse the key ID of the replaced one, so the replaced
+	// grant and its key are deleted first, in the same transaction.
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		if err := p.DeleteGrant(ctx, g.ID); err != nil {
+			return err
+		}
+		return p.CreateGrant(ctx, g, publicKey)
+	})
+}
+
 // GetGrants implements GrantManager
 func (p *Persister) GetGrants(ctx context.Context, optionalIssuer string, pageOpts ...keysetpagination.Option) (_ []trust.Grant, _ *keysetpagination.Paginator, err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetGrants")
@@ -164,7 +202,16 @@ func (p *Persister) FlushInactiveGrants(ctx context.Context, notAfter time.Time,
 	if deleteUntil.After(notAfter) {
 		deleteUntil = notAfter.UTC()
 	}
-	return sqlcon.HandleError(p.QueryWithNetwork(ctx).Where("expires_at < ?", deleteUntil).Delete(&SQLGrant{}))
+	/* #nosec G201 table is static */
+	deleted, err := p.Connection(ctx).RawQuery(
+		fmt.Sprintf("DELETE FROM %s WHERE expires_at < ? AND nid = ?", SQLGrant{}.TableName()),
+		deleteUntil, p.NetworkID(ctx),
+	).ExecWithCount()
+	if err != nil {
+		return sqlcon.HandleError(err)
+	}
+	janitorDeletedRecords.WithLabelValues(SQLGrant{}.TableName()).Add(float64(deleted))
+	return nil
 }
 
 // GetPublicKey implements RFC7523KeyStorage
@@ -210,6 +257,7 @@ func (p *Persister) GetPublicKeys(ctx context.Context, issuer string, subject st
 	grantsData := make([]SQLGrant, 0)
 	query := q.
 		Select("key_id").
+		Where("key_id IS NOT NULL").
 		Where(expiresAt).
 		Where("issuer = ?", issuer).
 		Where("(subject = ? OR allow_any_subject IS TRUE)", subject).
@@ -226,7 +274,7 @@ func (p *Persister) GetPublicKeys(ctx context.Context, issuer string, subject st
 
 	keyIDs := make([]interface{}, len(grantsData))
 	for k, d := range grantsData {
-		keyIDs[k] = d.KeyID
+		keyIDs[k] = d.KeyID.String()
 	}
 
 	var js jwk.SQLDataRows
@@ -258,6 +306,36 @@ func (p *Persister) GetPublicKeys(ctx context.Context, issuer string, subject st
 	return js.ToJWK(ctx, p.r.KeyCipher())
 }
 
+// GetRemotePublicKeySets implements rfc7523.RFC7523RemoteKeyStorage
+func (p *Persister) GetRemotePublicKeySets(ctx context.Context, issuer string, subject string) (_ []rfc7523.RemoteJSONWebKeySet, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetRemotePublicKeySets")
+	defer otelx.End(span, &err)
+
+	q := p.QueryWithNetwork(ctx)
+	expiresAt := "expires_at > NOW()"
+	if q.Connection.Dialect.Name() == "sqlite3" {
+		expiresAt = "expires_at > datetime('now')"
+	}
+
+	grantsData := make([]SQLGrant, 0)
+	if err := q.
+		Where("jwks_uri <> ''").
+		Where(expiresAt).
+		Where("issuer = ?", issuer).
+		Where("(subject = ? OR allow_any_subject IS TRUE)", subject).
+		// Grants for the subject are preferred over grants for any subject.
+		Order("allow_any_subject ASC, created_at DESC").
+		All(&grantsData); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	sets := make([]rfc7523.RemoteJSONWebKeySet, len(grantsData))
+	for i, d := range grantsData {
+		sets[i] = rfc7523.RemoteJSONWebKeySet{URI: d.JWKSURI, Scopes: d.Scope, Rule: d.toAssertionRule()}
+	}
+	return sets, nil
+}
+
 // GetPublicKeyScopes implements RFC7523KeyStorage
 func (p *Persister) GetPublicKeyScopes(ctx context.Context, issuer string, subject string, keyId string) (_ []string, err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetPublicKeyScopes")
@@ -281,6 +359,25 @@ func (p *Persister) GetPublicKeyScopes(ctx context.Context, issuer string, subje
 	return scopes, nil
 }
 
+// GetPublicKeyAssertionRule implements rfc7523.RFC7523AssertionRuleStorage
+func (p *Persister) GetPublicKeyAssertionRule(ctx context.Context, issuer string, subject string, keyId string) (_ *rfc7523.AssertionRule, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetPublicKeyAssertionRule")
+	defer otelx.End(span, &err)
+
+	var data SQLGrant
+	if err := p.QueryWithNetwork(ctx).
+		Select("claim_conditions", "claims_mapper").
+		Where("key_id = ?", keyId).
+		Where("issuer = ?", issuer).
+		Where("(subject = ? OR allow_any_subject IS TRUE)", subject).
+		First(&data); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	rule := data.toAssertionRule()
+	return &rule, nil
+}
+
 // IsJWTUsed implements RFC7523KeyStorage
 func (p *Persister) IsJWTUsed(ctx context.Context, jti string) (ok bool, err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.IsJWTUsed")
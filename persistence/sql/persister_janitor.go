@@ -0,0 +1,95 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ory/hydra/v2/janitor"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+)
+
+var _ janitor.Manager = (*Persister)(nil)
+
+var janitorDeletedRecords = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "hydra",
+	Subsystem: "janitor",
+	Name:      "deleted_records_total",
+	Help:      "Number of records deleted by the janitor by table.",
+}, []string{"table"})
+
+// The janitor lease is not scoped by network, because a single janitor cleans
+// up all networks.
+const janitorLeaseName = "janitor"
+
+// AcquireJanitorLease implements janitor.Manager
+func (p *Persister) AcquireJanitorLease(ctx context.Context, holder string, ttl time.Duration) (_ *janitor.Lease, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.AcquireJanitorLease")
+	defer otelx.End(span, &err)
+
+	// The update is a single statement, so only one node acquires an expired
+	// lease even if several nodes try at the same time.
+	now := time.Now().UTC()
+	if err := p.Connection(ctx).RawQuery(
+		"UPDATE hydra_janitor_lease SET holder = ?, expires_at = ? WHERE name = ? AND (holder = ? OR expires_at < ?)",
+		holder, now.Add(ttl), janitorLeaseName, holder, now,
+	).Exec(); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return p.GetJanitorLease(ctx)
+}
+
+// ReleaseJanitorLease implements janitor.Manager
+func (p *Persister) ReleaseJanitorLease(ctx context.Context, holder string) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ReleaseJanitorLease")
+	defer otelx.End(span, &err)
+
+	return sqlcon.HandleError(p.Connection(ctx).RawQuery(
+		"UPDATE hydra_janitor_lease SET holder = '', expires_at = ? WHERE name = ? AND holder = ?",
+		time.Now().UTC(), janitorLeaseName, holder,
+	).Exec())
+}
+
+// GetJanitorLease implements janitor.Manager
+func (p *Persister) GetJanitorLease(ctx context.Context) (_ *janitor.Lease, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetJanitorLease")
+	defer otelx.End(span, &err)
+
+	var l janitor.Lease
+	if err := p.Connection(ctx).RawQuery(
+		"SELECT name, holder, expires_at, paused, last_run_at FROM hydra_janitor_lease WHERE name = ?",
+		janitorLeaseName,
+	).First(&l); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	l.ExpiresAt = l.ExpiresAt.UTC()
+	return &l, nil
+}
+
+// SetJanitorPaused implements janitor.Manager
+func (p *Persister) SetJanitorPaused(ctx context.Context, paused bool) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.SetJanitorPaused")
+	defer otelx.End(span, &err)
+
+	return sqlcon.HandleError(p.Connection(ctx).RawQuery(
+		"UPDATE hydra_janitor_lease SET paused = ? WHERE name = ?",
+		paused, janitorLeaseName,
+	).Exec())
+}
+
+// SetJanitorLastRun implements janitor.Manager
+func (p *Persister) SetJanitorLastRun(ctx context.Context, holder string, at time.Time) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.SetJanitorLastRun")
+	defer otelx.End(span, &err)
+
+	return sqlcon.HandleError(p.Connection(ctx).RawQuery(
+		"UPDATE hydra_janitor_lease SET last_run_at = ? WHERE name = ? AND holder = ?",
+		at.UTC(), janitorLeaseName, holder,
+	).Exec())
+}
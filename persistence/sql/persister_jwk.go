@@ -5,6 +5,7 @@ package sql
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 
 	"github.com/go-jose/go-jose/v3"
@@ -183,10 +184,16 @@ func (p *JWKPersister) GetKeySet(ctx context.Context, set string) (keys *jose.JS
 	defer otelx.End(span, &err)
 
 	var js jwk.SQLDataRows
-	if err := p.D.BasePersister().QueryWithNetwork(ctx).
-		Where("sid = ?", set).
-		Order("created_at DESC").
-		All(&js); err != nil {
+	if err := p.D.BasePersister().ReadReplicaWithNetwork(ctx, func(q func() *pop.Query) error {
+		if err := q().Where("sid = ?", set).Order("created_at DESC").All(&js); err != nil {
+			return err
+		} else if len(js) == 0 {
+			// Lets the key set be looked up on the primary if the replica
+			// lags behind.
+			return errors.WithStack(sql.ErrNoRows)
+		}
+		return nil
+	}); err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, sqlcon.HandleError(err)
 	}
 
@@ -19,12 +19,15 @@ import (
 	"github.com/ory/hydra/v2/client"
 	"github.com/ory/hydra/v2/consent"
 	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
 	"github.com/ory/hydra/v2/flow"
 	"github.com/ory/hydra/v2/fosite"
 	"github.com/ory/hydra/v2/fosite/handler/openid"
+	"github.com/ory/hydra/v2/fosite/handler/rfc7523"
 	"github.com/ory/hydra/v2/internal/testhelpers"
 	"github.com/ory/hydra/v2/jwk"
 	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/hydra/v2/oauth2/scope"
 	"github.com/ory/hydra/v2/oauth2/trust"
 	"github.com/ory/hydra/v2/persistence"
 	persistencesql "github.com/ory/hydra/v2/persistence/sql"
@@ -170,6 +173,7 @@ func (s *PersisterTestSuite) TestConfirmLoginSession() {
 	ls := newLoginSession()
 	ls.AuthenticatedAt = sqlxx.NullTime(time.Now().UTC())
 	ls.Remember = true
+	ls.ACR = "mfa"
 	ls.NID = s.t1NID
 
 	for k, r := range s.registries {
@@ -575,6 +579,66 @@ func (s *PersisterTestSuite) TestDetermineNetwork() {
 	}
 }
 
+func (s *PersisterTestSuite) TestEraseSubject() {
+	for k, r := range s.registries {
+		s.T().Run(k, func(t *testing.T) {
+			subject := uuid.Must(uuid.NewV4()).String()
+			cl := &client.Client{ID: uuid.Must(uuid.NewV4()).String()}
+			require.NoError(t, r.Persister().CreateClient(s.t1, cl))
+
+			ls := flow.LoginSession{
+				ID:       uuid.Must(uuid.NewV4()).String(),
+				NID:      s.t1NID,
+				Subject:  subject,
+				Remember: true,
+			}
+			require.NoError(t, r.Persister().ConfirmLoginSession(s.t1, &ls))
+
+			sig := uuid.Must(uuid.NewV4()).String()
+			fr := fosite.NewRequest()
+			fr.Client = &fosite.DefaultClient{ID: cl.ID}
+			fr.Session = &oauth2.Session{DefaultSession: &openid.DefaultSession{Subject: subject}}
+			require.NoError(t, r.Persister().CreateAccessTokenSession(s.t1, sig, fr))
+
+			setID := uuid.Must(uuid.NewV4()).String()
+			ks := newKeySet(setID, "use")
+			grant := trust.Grant{
+				ID:        uuid.Must(uuid.NewV4()),
+				Issuer:    "https://issuer.example.com",
+				Subject:   subject,
+				ExpiresAt: time.Now().Add(time.Hour),
+				PublicKey: trust.PublicKey{Set: setID, KeyID: ks.Keys[0].KeyID},
+			}
+			require.NoError(t, r.Persister().CreateGrant(s.t1, grant, ks.Keys[0].Public()))
+
+			sids, err := r.Persister().ListSubjectLoginSessionIDs(s.t2, subject)
+			require.NoError(t, err)
+			require.Empty(t, sids)
+			deleted, err := r.Persister().EraseSubject(s.t2, subject)
+			require.NoError(t, err)
+			for table, n := range deleted {
+				assert.Zero(t, n, table)
+			}
+
+			sids, err = r.Persister().ListSubjectLoginSessionIDs(s.t1, subject)
+			require.NoError(t, err)
+			require.Equal(t, []string{ls.ID}, sids)
+			deleted, err = r.Persister().EraseSubject(s.t1, subject)
+			require.NoError(t, err)
+			assert.Equal(t, 1, deleted[persistencesql.OAuth2RequestSQL{Table: "access"}.TableName()])
+			assert.Equal(t, 1, deleted[flow.LoginSession{}.TableName()])
+			assert.Equal(t, 1, deleted[persistencesql.SQLGrant{}.TableName()])
+
+			_, err = r.Persister().GetRememberedLoginSession(s.t1, ls.ID)
+			require.ErrorIs(t, err, x.ErrNotFound)
+			actual := persistencesql.OAuth2RequestSQL{Table: "access"}
+			require.Error(t, r.Persister().Connection(context.Background()).Find(&actual, x.SignatureHash(sig)))
+			_, err = r.Persister().GetConcreteGrant(s.t1, grant.ID)
+			require.Error(t, err)
+		})
+	}
+}
+
 func (s *PersisterTestSuite) TestFindGrantedAndRememberedConsentRequests() {
 	for k, r := range s.registries {
 		s.T().Run(k, func(t *testing.T) {
@@ -963,6 +1027,51 @@ func (s *PersisterTestSuite) TestGetOpenIDConnectSession() {
 	}
 }
 
+func (s *PersisterTestSuite) TestGetPairwiseSubject() {
+	for k, r := range s.registries {
+		s.T().Run(k, func(t *testing.T) {
+			cl := &client.Client{ID: uuid.Must(uuid.NewV4()).String()}
+			require.NoError(t, r.Persister().CreateClient(s.t1, cl))
+
+			ps := &consent.PairwiseSubject{
+				ClientID:         cl.ID,
+				Subject:          uuid.Must(uuid.NewV4()).String(),
+				PairwiseSubject:  uuid.Must(uuid.NewV4()).String(),
+				SectorIdentifier: "example.org",
+				CreatedAt:        time.Now().UTC().Truncate(time.Second),
+			}
+			require.NoError(t, r.Persister().CreatePairwiseSubject(s.t1, ps))
+			require.ErrorIs(t, r.Persister().CreatePairwiseSubject(s.t1, ps), sqlcon.ErrUniqueViolation())
+
+			_, err := r.Persister().GetPairwiseSubject(s.t2, cl.ID, ps.Subject)
+			require.ErrorIs(t, err, x.ErrNotFound)
+			_, err = r.Persister().FindPairwiseSubject(s.t2, cl.ID, ps.PairwiseSubject)
+			require.ErrorIs(t, err, x.ErrNotFound)
+			subjects, err := r.Persister().ListClientSubjects(s.t2, cl.ID)
+			require.NoError(t, err)
+			require.Empty(t, subjects)
+
+			actual, err := r.Persister().GetPairwiseSubject(s.t1, cl.ID, ps.Subject)
+			require.NoError(t, err)
+			assert.Equal(t, ps.PairwiseSubject, actual.PairwiseSubject)
+			subjects, err = r.Persister().ListClientSubjects(s.t1, cl.ID)
+			require.NoError(t, err)
+			require.Equal(t, []string{ps.Subject}, subjects)
+
+			migrated := *ps
+			migrated.PreviousPairwiseSubject, migrated.PairwiseSubject = ps.PairwiseSubject, uuid.Must(uuid.NewV4()).String()
+			require.NoError(t, r.Persister().SetPairwiseSubjects(s.t1, cl.ID, []consent.PairwiseSubject{migrated}))
+
+			for _, id := range []string{ps.PairwiseSubject, migrated.PairwiseSubject} {
+				actual, err = r.Persister().FindPairwiseSubject(s.t1, cl.ID, id)
+				require.NoError(t, err)
+				assert.Equal(t, migrated.PairwiseSubject, actual.PairwiseSubject)
+				assert.Equal(t, ps.Subject, actual.Subject)
+			}
+		})
+	}
+}
+
 func (s *PersisterTestSuite) TestGetPKCERequestSession() {
 	for k, r := range s.registries {
 		s.T().Run(k, func(t *testing.T) {
@@ -1031,6 +1140,36 @@ func (s *PersisterTestSuite) TestGetPublicKeyScopes() {
 	}
 }
 
+func (s *PersisterTestSuite) TestGetPublicKeyAssertionRule() {
+	for k, r := range s.registries {
+		s.T().Run(k, func(t *testing.T) {
+			ks := newKeySet("ks-id", "use")
+			grant := trust.Grant{
+				ID:              uuid.Must(uuid.NewV4()),
+				Scope:           []string{"a"},
+				ExpiresAt:       time.Now().Add(time.Hour),
+				PublicKey:       trust.PublicKey{Set: "ks-id", KeyID: ks.Keys[0].KeyID},
+				ClaimConditions: trust.ClaimConditions{"repository": {"org/app"}},
+				ClaimsMapper:    "{subject: std.extVar('ctx').claims.repository}",
+			}
+			require.NoError(t, r.Persister().CreateGrant(s.t1, grant, ks.Keys[0].Public()))
+
+			actual, err := r.Persister().GetPublicKeyAssertionRule(s.t2, grant.Issuer, grant.Subject, grant.PublicKey.KeyID)
+			require.Error(t, err)
+			require.Nil(t, actual)
+
+			actual, err = r.Persister().GetPublicKeyAssertionRule(s.t1, grant.Issuer, grant.Subject, grant.PublicKey.KeyID)
+			require.NoError(t, err)
+			require.Equal(t, &rfc7523.AssertionRule{ClaimConditions: grant.ClaimConditions, ClaimsMapper: grant.ClaimsMapper}, actual)
+
+			stored, err := r.Persister().GetConcreteGrant(s.t1, grant.ID)
+			require.NoError(t, err)
+			require.Equal(t, grant.ClaimConditions, stored.ClaimConditions)
+			require.Equal(t, grant.ClaimsMapper, stored.ClaimsMapper)
+		})
+	}
+}
+
 func (s *PersisterTestSuite) TestGetPublicKeys() {
 	for k, r := range s.registries {
 		s.T().Run(k, func(t *testing.T) {
@@ -1055,6 +1194,64 @@ func (s *PersisterTestSuite) TestGetPublicKeys() {
 	}
 }
 
+func (s *PersisterTestSuite) TestGetRemotePublicKeySets() {
+	for k, r := range s.registries {
+		s.T().Run(k, func(t *testing.T) {
+			issuer := "https://" + uuid.Must(uuid.NewV4()).String() + ".example.com"
+			grant := trust.Grant{
+				ID:              uuid.Must(uuid.NewV4()),
+				Issuer:          issuer,
+				AllowAnySubject: true,
+				Scope:           []string{"a", "b"},
+				JWKSURI:         issuer + "/.well-known/jwks.json",
+				CreatedAt:       time.Now().UTC().Round(time.Second),
+				ExpiresAt:       time.Now().UTC().Add(time.Hour),
+			}
+			require.NoError(t, r.Persister().CreateGrant(s.t1, grant, jose.JSONWebKey{}))
+
+			actual, err := r.Persister().GetRemotePublicKeySets(s.t2, issuer, "some-subject")
+			require.NoError(t, err)
+			require.Empty(t, actual)
+
+			actual, err = r.Persister().GetRemotePublicKeySets(s.t1, issuer, "some-subject")
+			require.NoError(t, err)
+			require.Equal(t, []rfc7523.RemoteJSONWebKeySet{{URI: grant.JWKSURI, Scopes: grant.Scope}}, actual)
+
+			duplicate := grant
+			duplicate.ID = uuid.Must(uuid.NewV4())
+			duplicate.JWKSURI = issuer + "/other/jwks.json"
+			require.ErrorIs(t, r.Persister().CreateGrant(s.t1, duplicate, jose.JSONWebKey{}), sqlcon.ErrUniqueViolation(),
+				"only one remote grant may exist per issuer and subject")
+
+			forSubject := grant
+			forSubject.ID = uuid.Must(uuid.NewV4())
+			forSubject.AllowAnySubject = false
+			forSubject.Subject = "some-subject"
+			forSubject.Scope = []string{"c"}
+			require.NoError(t, r.Persister().CreateGrant(s.t1, forSubject, jose.JSONWebKey{}))
+
+			actual, err = r.Persister().GetRemotePublicKeySets(s.t1, issuer, "some-subject")
+			require.NoError(t, err)
+			require.Equal(t, []rfc7523.RemoteJSONWebKeySet{
+				{URI: forSubject.JWKSURI, Scopes: forSubject.Scope},
+				{URI: grant.JWKSURI, Scopes: grant.Scope},
+			}, actual, "grants for the subject must be preferred")
+			require.NoError(t, r.Persister().DeleteGrant(s.t1, forSubject.ID))
+
+			keys, err := r.Persister().GetPublicKeys(s.t1, issuer, "some-subject")
+			require.NoError(t, err)
+			require.Empty(t, keys.Keys, "remote grants must not be returned as registered keys")
+
+			stored, err := r.Persister().GetConcreteGrant(s.t1, grant.ID)
+			require.NoError(t, err)
+			require.Equal(t, grant.JWKSURI, stored.JWKSURI)
+			require.Empty(t, stored.PublicKey.KeyID)
+
+			require.NoError(t, r.Persister().DeleteGrant(s.t1, grant.ID))
+		})
+	}
+}
+
 func (s *PersisterTestSuite) TestGetRefreshTokenSession() {
 	for k, r := range s.registries {
 		s.T().Run(k, func(t *testing.T) {
@@ -1293,6 +1490,46 @@ func (s *PersisterTestSuite) TestQueryWithNetwork() {
 	}
 }
 
+func (s *PersisterTestSuite) TestRejectDeviceAuthRequest() {
+	for k, r := range s.registries {
+		s.T().Run(k, func(t *testing.T) {
+			cl := &client.Client{ID: uuid.Must(uuid.NewV4()).String()}
+			require.NoError(t, r.Persister().CreateClient(s.t1, cl))
+
+			request := fosite.NewDeviceRequest()
+			request.ID = uuid.Must(uuid.NewV4()).String()
+			request.Client = cl
+			request.RequestedAt = time.Now().UTC()
+			request.Session = &oauth2.Session{DefaultSession: &openid.DefaultSession{}}
+			request.Session.SetExpiresAt(fosite.DeviceCode, time.Now().Add(time.Hour).UTC())
+			userCodeSignature := uuid.Must(uuid.NewV4()).String()
+			require.NoError(t, r.Persister().CreateDeviceAuthSession(s.t1, uuid.Must(uuid.NewV4()).String(), userCodeSignature, request))
+
+			_, err := r.Persister().GetDeviceAuthRequest(s.t2, userCodeSignature, nil)
+			require.ErrorIs(t, err, x.ErrNotFound)
+			pending, _, err := r.Persister().ListPendingDeviceAuthRequests(s.t2, cl.ID)
+			require.NoError(t, err)
+			require.Empty(t, pending)
+			require.ErrorIs(t, r.Persister().RejectDeviceAuthRequest(s.t2, userCodeSignature), x.ErrNotFound)
+
+			pending, _, err = r.Persister().ListPendingDeviceAuthRequests(s.t1, cl.ID)
+			require.NoError(t, err)
+			require.Len(t, pending, 1)
+			assert.Equal(t, request.ID, pending[0].GetID())
+
+			require.NoError(t, r.Persister().RejectDeviceAuthRequest(s.t1, userCodeSignature))
+			require.ErrorIs(t, r.Persister().RejectDeviceAuthRequest(s.t1, userCodeSignature), x.ErrNotFound)
+
+			actual, err := r.Persister().GetDeviceAuthRequest(s.t1, userCodeSignature, nil)
+			require.NoError(t, err)
+			assert.Equal(t, fosite.UserCodeRejected, actual.GetUserCodeState())
+			pending, _, err = r.Persister().ListPendingDeviceAuthRequests(s.t1, cl.ID)
+			require.NoError(t, err)
+			require.Empty(t, pending)
+		})
+	}
+}
+
 func (s *PersisterTestSuite) TestRejectLogoutRequest() {
 	for k, r := range s.registries {
 		s.T().Run(k, func(t *testing.T) {
@@ -1354,6 +1591,96 @@ func (s *PersisterTestSuite) TestRevokeRefreshToken() {
 	}
 }
 
+func (s *PersisterTestSuite) TestRevokeRefreshTokenDeniesJWEGrants() {
+	for k, r := range s.registries {
+		s.T().Run(k, func(t *testing.T) {
+			grant := func(t *testing.T, strategy string, scope ...string) *fosite.Request {
+				cl := &client.Client{ID: uuid.Must(uuid.NewV4()).String(), RefreshTokenStrategy: strategy}
+				require.NoError(t, r.Persister().CreateClient(s.t1, cl))
+
+				f := newFlow(s.t1NID, cl.ID, "sub", sqlxx.NullString(""))
+				f.ConsentRequestID = sqlxx.NullString(uuid.Must(uuid.NewV4()).String())
+				f.GrantedScope = scope
+				require.NoError(t, r.Persister().Connection(s.t1).Create(f))
+
+				request := fosite.NewRequest()
+				request.ID = f.ConsentRequestID.String()
+				request.Client = cl
+				request.Session = &oauth2.Session{DefaultSession: &openid.DefaultSession{Subject: "sub"}}
+				return request
+			}
+			denied := func(t *testing.T, request *fosite.Request) bool {
+				require.NoError(t, r.Persister().RevokeRefreshToken(s.t1, request.ID))
+				exists, err := r.Persister().Connection(s.t1).
+					Where("id = ?", request.ID).
+					Exists(&persistencesql.OAuth2RefreshDenyListEntry{})
+				require.NoError(t, err)
+				return exists
+			}
+
+			t.Run("case=opaque refresh tokens", func(t *testing.T) {
+				request := grant(t, "opaque", "offline_access")
+				require.NoError(t, r.Persister().CreateRefreshTokenSession(s.t1, uuid.Must(uuid.NewV4()).String(), "", request))
+				assert.False(t, denied(t, request))
+			})
+
+			t.Run("case=JWE refresh tokens", func(t *testing.T) {
+				assert.True(t, denied(t, grant(t, "jwe", "offline_access")))
+			})
+
+			t.Run("case=no refresh token scope", func(t *testing.T) {
+				assert.False(t, denied(t, grant(t, "jwe", "openid")))
+			})
+
+			t.Run("case=strategy changed after JWE refresh tokens were issued", func(t *testing.T) {
+				assert.True(t, denied(t, grant(t, "opaque", "offline")))
+			})
+
+			t.Run("case=no consent", func(t *testing.T) {
+				request := fosite.NewRequest()
+				request.ID = uuid.Must(uuid.NewV4()).String()
+				assert.False(t, denied(t, request))
+			})
+		})
+	}
+}
+
+func (s *PersisterTestSuite) TestRevokeTokensRecordsRevocationOnce() {
+	for k, r := range s.registries {
+		s.T().Run(k, func(t *testing.T) {
+			r.Config().MustSet(s.t1, config.KeyIntrospectionCacheEnabled, true)
+			t.Cleanup(func() { r.Config().MustSet(s.t1, config.KeyIntrospectionCacheEnabled, false) })
+
+			clientID := uuid.Must(uuid.NewV4()).String()
+			require.NoError(t, r.Persister().CreateClient(s.t1, &client.Client{ID: clientID}))
+
+			request := fosite.NewRequest()
+			request.Client = &fosite.DefaultClient{ID: clientID}
+			request.Session = &oauth2.Session{DefaultSession: &openid.DefaultSession{Subject: "sub"}}
+			require.NoError(t, r.Persister().CreateAccessTokenSession(s.t1, uuid.Must(uuid.NewV4()).String(), request))
+			require.NoError(t, r.Persister().CreateRefreshTokenSession(s.t1, uuid.Must(uuid.NewV4()).String(), "", request))
+
+			since := time.Now().Add(-time.Minute)
+			require.NoError(t, r.Transaction(s.t1, func(ctx context.Context) error {
+				if err := r.Persister().RevokeRefreshToken(ctx, request.ID); err != nil {
+					return err
+				}
+				return r.Persister().RevokeAccessToken(ctx, request.ID)
+			}))
+
+			revocations, err := r.Persister().ListTokenRevocations(s.t1, since, 1000)
+			require.NoError(t, err)
+			var found int
+			for _, rev := range revocations {
+				if rev.RequestID == request.ID {
+					found++
+				}
+			}
+			assert.Equal(t, 1, found)
+		})
+	}
+}
+
 func (s *PersisterTestSuite) TestRotateRefreshToken() {
 	for k, r := range s.registries {
 		s.T().Run(k, func(t *testing.T) {
@@ -1465,14 +1792,44 @@ func (s *PersisterTestSuite) TestRevokeSubjectClientConsentSession() {
 
 			actual := flow.Flow{}
 
-			require.NoError(t, r.ConsentManager().RevokeSubjectClientConsentSession(s.t2, "sub", cl.ID), "should not error if nothing was found")
+			require.NoError(t, r.ConsentManager().RevokeSubjectClientConsentSession(s.t2, "sub", cl.ID, ""), "should not error if nothing was found")
 			require.NoError(t, r.Persister().Connection(context.Background()).Find(&actual, f.ID))
-			require.NoError(t, r.ConsentManager().RevokeSubjectClientConsentSession(s.t1, "sub", cl.ID))
+			require.NoError(t, r.ConsentManager().RevokeSubjectClientConsentSession(s.t1, "sub", cl.ID, ""))
 			require.Error(t, r.Persister().Connection(context.Background()).Find(&actual, f.ID))
 		})
 	}
 }
 
+func (s *PersisterTestSuite) TestScopes() {
+	for k, r := range s.registries {
+		s.T().Run(k, func(t *testing.T) {
+			sc := &scope.Scope{Name: "photos.read", Description: "Read access to photos."}
+			require.NoError(t, sc.Validate())
+			require.NoError(t, r.Persister().CreateScope(s.t1, sc))
+
+			_, err := r.Persister().GetScope(s.t2, sc.Name)
+			require.ErrorIs(t, err, sqlcon.ErrNoRows())
+			actual, err := r.Persister().GetScopes(s.t2, []string{sc.Name})
+			require.NoError(t, err)
+			require.Empty(t, actual)
+			require.ErrorIs(t, r.Persister().UpdateScope(s.t2, &scope.Scope{Name: sc.Name}), sqlcon.ErrNoRows())
+			require.ErrorIs(t, r.Persister().DeleteScope(s.t2, sc.Name), sqlcon.ErrNoRows())
+
+			sc.Sensitivity = scope.SensitivityHigh
+			require.NoError(t, r.Persister().UpdateScope(s.t1, sc))
+			actual, err = r.Persister().GetScopes(s.t1, []string{sc.Name, "unknown"})
+			require.NoError(t, err)
+			require.Len(t, actual, 1)
+			require.Equal(t, scope.SensitivityHigh, actual[0].Sensitivity)
+			require.Equal(t, "Read access to photos.", actual[0].Description)
+
+			require.NoError(t, r.Persister().DeleteScope(s.t1, sc.Name))
+			_, err = r.Persister().GetScope(s.t1, sc.Name)
+			require.ErrorIs(t, err, sqlcon.ErrNoRows())
+		})
+	}
+}
+
 func (s *PersisterTestSuite) TestSetClientAssertionJWT() {
 	for k, r := range s.registries {
 		s.T().Run(k, func(t *testing.T) {
@@ -21,9 +21,11 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fositex"
 	"github.com/ory/hydra/v2/oauth2"
 	"github.com/ory/hydra/v2/x"
 	"github.com/ory/hydra/v2/x/events"
+	"github.com/ory/pop/v6"
 	"github.com/ory/x/dbal"
 	"github.com/ory/x/otelx"
 	"github.com/ory/x/sqlcon"
@@ -132,6 +134,10 @@ func (p *Persister) strictRefreshRotation(ctx context.Context, requestID string)
 
 	c := p.Connection(ctx)
 
+	if err := p.recordRevocations(ctx, requestID); err != nil {
+		return err
+	}
+
 	// In strict rotation we only have one token chain for every request. Therefore, we remove all
 	// access tokens associated with the request ID.
 	if err := p.deleteSessionByRequestID(ctx, requestID, sqlTableAccess); errors.Is(err, fosite.ErrNotFound) {
@@ -224,6 +230,10 @@ WHERE signature = ? AND nid = ?`
 		}
 	}
 
+	if err := p.recordRevocations(ctx, requestID); err != nil {
+		return err
+	}
+
 	if !accessTokenSignature.Valid {
 		// If the access token is not found, we fall back to deleting all access tokens associated with the request ID.
 		if err := p.deleteSessionByRequestID(ctx, requestID, sqlTableAccess); errors.Is(err, fosite.ErrNotFound) {
@@ -350,6 +360,8 @@ func (p *Persister) deleteSessionByRequestID(ctx context.Context, id string, tab
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.deleteSessionByRequestID")
 	defer otelx.End(span, &err)
 
+	p.PinPrimary(ctx)
+
 	err = p.QueryWithNetwork(ctx).
 		Where("request_id=?", id).
 		Delete(OAuth2RequestSQL{Table: table}.TableName())
@@ -398,6 +410,7 @@ func (p *Persister) flushInactiveTokens(ctx context.Context, notAfter time.Time,
 		}
 		p.l.Debugf("Flushing tokens...: %d/%d", totalDeletedCount, limit)
 	}
+	janitorDeletedRecords.WithLabelValues(OAuth2RequestSQL{Table: table}.TableName()).Add(float64(totalDeletedCount))
 	p.l.Debugf("Flush Refresh Tokens flushed_records: %d", totalDeletedCount)
 	return sqlcon.HandleError(err)
 }
@@ -560,17 +573,19 @@ func (p *Persister) GetAccessTokenSession(ctx context.Context, signature string,
 	defer otelx.End(span, &err)
 
 	r := OAuth2RequestSQL{Table: sqlTableAccess}
-	err = p.QueryWithNetwork(ctx).Where("signature = ?", x.SignatureHash(signature)).First(&r)
-	if errors.Is(err, sql.ErrNoRows) {
-		// Backwards compatibility: we previously did not always hash the
-		// signature before inserting. In case there are still very old (but
-		// valid) access tokens in the database, this should get them.
-		err = p.QueryWithNetwork(ctx).Where("signature = ?", signature).First(&r)
+	err = p.ReadReplicaWithNetwork(ctx, func(q func() *pop.Query) error {
+		err := q().Where("signature = ?", x.SignatureHash(signature)).First(&r)
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.WithStack(fosite.ErrNotFound)
+			// Backwards compatibility: we previously did not always hash the
+			// signature before inserting. In case there are still very old (but
+			// valid) access tokens in the database, this should get them.
+			return q().Where("signature = ?", signature).First(&r)
 		}
-	}
-	if err != nil {
+		return err
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.WithStack(fosite.ErrNotFound)
+	} else if err != nil {
 		return nil, sqlcon.HandleError(err)
 	}
 	if !r.Active {
@@ -591,6 +606,8 @@ func (p *Persister) DeleteAccessTokenSession(ctx context.Context, signature stri
 	)
 	defer otelx.End(span, &err)
 
+	p.PinPrimary(ctx)
+
 	err = sqlcon.HandleError(
 		p.QueryWithNetwork(ctx).
 			Where("signature = ?", x.SignatureHash(signature)).
@@ -615,6 +632,12 @@ func (p *Persister) DeleteAccessTokenSession(ctx context.Context, signature stri
 
 // CreateRefreshTokenSession implements RefreshTokenStorage
 func (p *Persister) CreateRefreshTokenSession(ctx context.Context, signature string, accessTokenSignature string, requester fosite.Requester) (err error) {
+	if fositex.IsJWERefreshToken(signature) {
+		// JWE refresh tokens are self-contained and not stored.
+		events.Trace(ctx, events.RefreshTokenIssued, toEventOptions(requester)...)
+		return nil
+	}
+
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CreateRefreshTokenSession",
 		trace.WithAttributes(events.RefreshTokenSignature(signature)),
 	)
@@ -648,6 +671,10 @@ func (p *Persister) CreateRefreshTokenSession(ctx context.Context, signature str
 
 // GetRefreshTokenSession implements RefreshTokenStorage
 func (p *Persister) GetRefreshTokenSession(ctx context.Context, signature string, session fosite.Session) (request fosite.Requester, err error) {
+	if fositex.IsJWERefreshToken(signature) {
+		return p.getJWERefreshTokenSession(ctx, signature, session)
+	}
+
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetRefreshTokenSession",
 		trace.WithAttributes(events.RefreshTokenSignature(signature)),
 	)
@@ -684,6 +711,10 @@ func (p *Persister) GetRefreshTokenSession(ctx context.Context, signature string
 
 // DeleteRefreshTokenSession implements RefreshTokenStorage
 func (p *Persister) DeleteRefreshTokenSession(ctx context.Context, signature string) (err error) {
+	if fositex.IsJWERefreshToken(signature) {
+		return p.deleteJWERefreshTokenSession(ctx, signature)
+	}
+
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteRefreshTokenSession",
 		trace.WithAttributes(events.RefreshTokenSignature(signature)),
 	)
@@ -696,6 +727,10 @@ func (p *Persister) RotateRefreshToken(ctx context.Context, requestID, refreshTo
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.RotateRefreshToken")
 	defer otelx.End(span, &err)
 
+	if fositex.IsJWERefreshToken(refreshTokenSignature) {
+		return handleRetryError(p.rotateJWERefreshToken(ctx, requestID, refreshTokenSignature))
+	}
+
 	// If we end up here, we have a valid refresh token and can proceed with the rotation.
 	if p.r.Config().GracefulRefreshTokenRotation(ctx).Period > 0 {
 		return handleRetryError(p.gracefulRefreshRotation(ctx, requestID, refreshTokenSignature))
@@ -761,6 +796,18 @@ func (p *Persister) RevokeRefreshToken(ctx context.Context, id string) (err erro
 		trace.WithAttributes(events.ConsentRequestID(id)),
 	)
 	defer otelx.End(span, &err)
+
+	// JWE refresh tokens are not stored and can only be revoked by denying
+	// the request.
+	if jwe, err := p.mayHaveJWERefreshTokens(ctx, id); err != nil {
+		return err
+	} else if jwe {
+		if err := p.denyRefreshTokenRequests(ctx, id); err != nil {
+			return err
+		}
+	}
+	// The revocation is recorded by RevokeAccessToken, which is always called
+	// together with this method.
 	return p.deleteSessionByRequestID(ctx, id, sqlTableRefresh)
 }
 
@@ -770,6 +817,10 @@ func (p *Persister) RevokeAccessToken(ctx context.Context, id string) (err error
 		trace.WithAttributes(events.ConsentRequestID(id)),
 	)
 	defer otelx.End(span, &err)
+
+	if err := p.recordRevocations(ctx, id); err != nil {
+		return err
+	}
 	return p.deleteSessionByRequestID(ctx, id, sqlTableAccess)
 }
 
@@ -777,14 +828,20 @@ func (p *Persister) RevokeAccessToken(ctx context.Context, id string) (err error
 func (p *Persister) FlushInactiveAccessTokens(ctx context.Context, notAfter time.Time, limit int, batchSize int) (err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.FlushInactiveAccessTokens")
 	defer otelx.End(span, &err)
-	return p.flushInactiveTokens(ctx, notAfter, limit, batchSize, sqlTableAccess, p.r.Config().GetAccessTokenLifespan(ctx))
+	if err := p.flushInactiveTokens(ctx, notAfter, limit, batchSize, sqlTableAccess, p.r.Config().GetAccessTokenLifespan(ctx)); err != nil {
+		return err
+	}
+	return p.flushRevocationFeed(ctx, notAfter, limit, batchSize)
 }
 
 // FlushInactiveRefreshTokens implements FositeStorer
 func (p *Persister) FlushInactiveRefreshTokens(ctx context.Context, notAfter time.Time, limit int, batchSize int) (err error) {
 	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.FlushInactiveRefreshTokens")
 	defer otelx.End(span, &err)
-	return p.flushInactiveTokens(ctx, notAfter, limit, batchSize, sqlTableRefresh, p.r.Config().GetRefreshTokenLifespan(ctx))
+	if err := p.flushInactiveTokens(ctx, notAfter, limit, batchSize, sqlTableRefresh, p.r.Config().GetRefreshTokenLifespan(ctx)); err != nil {
+		return err
+	}
+	return p.flushRefreshTokenDenyList(ctx, limit, batchSize)
 }
 
 // DeleteAccessTokens implements FositeStorer
@@ -0,0 +1,289 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/x/dbal"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
+)
+
+// OAuth2RefreshDenyListEntry denies JWE refresh tokens. The ID is either the
+// ID (jti) of a single rotated token, or the request ID of a revoked grant,
+// which denies all refresh tokens of that grant.
+//
+// JWE refresh tokens are never stored, so this is all that needs to be written
+// when they are issued, rotated, or revoked.
+type OAuth2RefreshDenyListEntry struct {
+	ID  string    `db:"id"`
+	NID uuid.UUID `db:"nid"`
+	// ExpiresAt is when the entry may be removed because all tokens it denies
+	// have expired. Entries without expiry are never removed.
+	ExpiresAt sqlxx.NullTime `db:"expires_at"`
+}
+
+func (OAuth2RefreshDenyListEntry) TableName() string {
+	return "hydra_oauth2_refresh_deny_list"
+}
+
+// jweRefreshTokenSpan starts a span for an operation on a JWE refresh token.
+// Unlike opaque refresh token signatures, the signature of a JWE refresh token
+// is the token itself and must not be recorded.
+func (p *Persister) jweRefreshTokenSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return p.r.Tracer(ctx).Tracer().Start(ctx, name, trace.WithAttributes(attribute.String("refresh_token.strategy", "jwe")))
+}
+
+// getJWERefreshTokenSession restores the request from a JWE refresh token.
+func (p *Persister) getJWERefreshTokenSession(ctx context.Context, token string, session fosite.Session) (_ fosite.Requester, err error) {
+	ctx, span := p.jweRefreshTokenSpan(ctx, "persistence.sql.getJWERefreshTokenSession")
+	defer otelx.End(span, &err)
+
+	claims, err := p.r.OAuth2JWERefreshTokenStrategy().Decode(ctx, token)
+	if err != nil {
+		return nil, errors.WithStack(fosite.ErrNotFound.WithWrap(err))
+	}
+
+	if session != nil {
+		if err := json.Unmarshal(claims.Session, session); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	} else {
+		p.l.Debugf("Got an empty session in getJWERefreshTokenSession")
+	}
+
+	c, err := p.GetClient(ctx, claims.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &fosite.Request{
+		ID:                claims.RequestID,
+		RequestedAt:       claims.RequestedAt,
+		Client:            c,
+		RequestedScope:    claims.RequestedScope,
+		GrantedScope:      claims.GrantedScope,
+		RequestedAudience: claims.RequestedAudience,
+		GrantedAudience:   claims.GrantedAudience,
+		Form:              make(map[string][]string),
+		Session:           session,
+	}
+
+	var denied []OAuth2RefreshDenyListEntry
+	if err := p.QueryWithNetwork(ctx).
+		Where("id IN (?, ?)", claims.ID, claims.RequestID).
+		All(&denied); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	// A denied token ID means that the token was rotated, so it is reused.
+	// Tokens of revoked requests are not found, like opaque refresh tokens
+	// whose request was revoked.
+	for _, e := range denied {
+		if e.ID == claims.ID {
+			return request, errors.WithStack(fosite.ErrInactiveToken)
+		}
+	}
+	if len(denied) > 0 {
+		return nil, errors.WithStack(fosite.ErrNotFound)
+	}
+
+	return request, nil
+}
+
+// rotateJWERefreshToken denies the rotated token and removes the access
+// tokens of the grant. Graceful rotation does not apply to JWE refresh tokens.
+func (p *Persister) rotateJWERefreshToken(ctx context.Context, requestID, token string) (err error) {
+	ctx, span := p.jweRefreshTokenSpan(ctx, "persistence.sql.rotateJWERefreshToken")
+	defer otelx.End(span, &err)
+
+	claims, err := p.r.OAuth2JWERefreshTokenStrategy().Decode(ctx, token)
+	if err != nil {
+		return errors.WithStack(fosite.ErrNotFound.WithWrap(err))
+	}
+
+	// The insert fails if a concurrent request has rotated the token already,
+	// which makes every token usable exactly once.
+	if err := sqlcon.HandleError(p.CreateWithNetwork(ctx, &OAuth2RefreshDenyListEntry{
+		ID:        claims.ID,
+		ExpiresAt: denyListExpiry(claims.Expiry()),
+	})); errors.Is(err, sqlcon.ErrUniqueViolation()) || errors.Is(err, sqlcon.ErrConcurrentUpdate()) {
+		return fosite.ErrSerializationFailure.WithWrap(err)
+	} else if err != nil {
+		return err
+	}
+
+	if err := p.recordRevocations(ctx, requestID); err != nil {
+		return err
+	}
+	if err := p.deleteSessionByRequestID(ctx, requestID, sqlTableAccess); errors.Is(err, fosite.ErrNotFound) {
+		return nil // Tokens may have been pruned earlier, so we do not return an error here.
+	} else if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// deleteJWERefreshTokenSession denies a single JWE refresh token.
+func (p *Persister) deleteJWERefreshTokenSession(ctx context.Context, token string) (err error) {
+	ctx, span := p.jweRefreshTokenSpan(ctx, "persistence.sql.deleteJWERefreshTokenSession")
+	defer otelx.End(span, &err)
+
+	claims, err := p.r.OAuth2JWERefreshTokenStrategy().Decode(ctx, token)
+	if err != nil {
+		return errors.WithStack(fosite.ErrNotFound.WithWrap(err))
+	}
+
+	if err := p.recordRevocations(ctx, claims.RequestID); err != nil {
+		return err
+	}
+	return p.denyRefreshTokens(ctx, denyListExpiry(claims.Expiry()), claims.ID)
+}
+
+// refreshTokenScopes are the scopes for which refresh tokens are issued, see
+// fositex.Config.GetRefreshTokenScopes.
+var refreshTokenScopes = []string{"offline", "offline_access"}
+
+// mayHaveJWERefreshTokens returns whether JWE refresh tokens may have been
+// issued for the request. This is the case if the request was granted a
+// refresh token scope, and its client uses the JWE strategy or no opaque
+// refresh token is stored for it, because JWE refresh tokens stay valid when
+// the strategy changes.
+func (p *Persister) mayHaveJWERefreshTokens(ctx context.Context, requestID string) (bool, error) {
+	// Refresh tokens are only issued for requests with a consent, whose ID is
+	// the request ID.
+	var f flow.Flow
+	if err := p.QueryWithNetwork(ctx).
+		Where("consent_challenge_id = ?", requestID).
+		Select("nid", "login_challenge", "client_id", "granted_scope").
+		First(&f); errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, sqlcon.HandleError(err)
+	}
+	if !slices.ContainsFunc(f.GrantedScope, func(scope string) bool { return slices.Contains(refreshTokenScopes, scope) }) {
+		return false, nil
+	}
+
+	c, err := p.GetConcreteClient(ctx, f.ClientID)
+	if errors.Is(err, sqlcon.ErrNoRows()) {
+		// The tokens of deleted clients can not be used.
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if p.r.Config().RefreshTokenStrategy(ctx, client.RefreshTokenStrategySource(c)) == config.RefreshTokenJWEStrategy {
+		return true, nil
+	}
+
+	stored, err := p.QueryWithNetwork(ctx).
+		Where("request_id = ?", requestID).
+		Exists(OAuth2RequestSQL{Table: sqlTableRefresh}.TableName())
+	if err != nil {
+		return false, sqlcon.HandleError(err)
+	}
+	return !stored, nil
+}
+
+// denyRefreshTokenRequests denies all JWE refresh tokens of the given
+// requests. JWE refresh tokens never outlive the global refresh token
+// lifespan, so the entries can be removed after that.
+func (p *BasePersister) denyRefreshTokenRequests(ctx context.Context, requestIDs ...string) error {
+	var expiresAt time.Time
+	if lifespan := p.d.Config().GetRefreshTokenLifespan(ctx); lifespan > 0 {
+		expiresAt = time.Now().Add(lifespan)
+	}
+	return p.denyRefreshTokens(ctx, denyListExpiry(expiresAt), requestIDs...)
+}
+
+// denyRefreshTokens adds the IDs to the deny-list in batches. IDs which are
+// denied already are skipped, so that this can safely run within a transaction.
+func (p *BasePersister) denyRefreshTokens(ctx context.Context, expiresAt sqlxx.NullTime, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	c := p.Connection(ctx)
+	nid := p.NetworkID(ctx)
+
+	for batch := range slices.Chunk(ids, queryBatchSize) {
+		values := make([]string, 0, len(batch))
+		args := make([]any, 0, len(batch)*3)
+		for _, id := range batch {
+			values = append(values, "(?, ?, ?)")
+			args = append(args, id, nid, expiresAt)
+		}
+
+		query := fmt.Sprintf("INSERT INTO %s (id, nid, expires_at) VALUES %s ON CONFLICT DO NOTHING",
+			OAuth2RefreshDenyListEntry{}.TableName(), strings.Join(values, ", "))
+		if c.Dialect.Name() == dbal.DriverMySQL {
+			// MySQL does not support ON CONFLICT.
+			query = fmt.Sprintf("INSERT IGNORE INTO %s (id, nid, expires_at) VALUES %s",
+				OAuth2RefreshDenyListEntry{}.TableName(), strings.Join(values, ", "))
+		}
+
+		if err := handleRetryError(sqlcon.HandleError(c.RawQuery(query, args...).Exec())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flushRefreshTokenDenyList removes deny-list entries of which all denied
+// tokens have expired.
+func (p *Persister) flushRefreshTokenDenyList(ctx context.Context, limit int, batchSize int) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.flushRefreshTokenDenyList")
+	defer otelx.End(span, &err)
+
+	totalDeletedCount := 0
+	for deletedRecords := batchSize; totalDeletedCount < limit && deletedRecords == batchSize; {
+		d := batchSize
+		if limit-totalDeletedCount < batchSize {
+			d = limit - totalDeletedCount
+		}
+		// The outer SELECT is necessary because our version of MySQL doesn't yet support 'LIMIT & IN/ALL/ANY/SOME subquery
+		/* #nosec G201 table is static */
+		deletedRecords, err = p.Connection(ctx).RawQuery(
+			fmt.Sprintf(`DELETE FROM %[1]s WHERE nid = ? AND id IN (
+				SELECT id FROM (SELECT id FROM %[1]s WHERE nid = ? AND expires_at < ? LIMIT %[2]d) AS s
+			)`, OAuth2RefreshDenyListEntry{}.TableName(), d),
+			p.NetworkID(ctx),
+			p.NetworkID(ctx),
+			time.Now().UTC(),
+		).ExecWithCount()
+		totalDeletedCount += deletedRecords
+
+		if err != nil {
+			break
+		}
+	}
+	janitorDeletedRecords.WithLabelValues(OAuth2RefreshDenyListEntry{}.TableName()).Add(float64(totalDeletedCount))
+	p.l.Debugf("Flush refresh token deny-list flushed_records: %d", totalDeletedCount)
+	return sqlcon.HandleError(err)
+}
+
+func denyListExpiry(t time.Time) sqlxx.NullTime {
+	if t.IsZero() {
+		return sqlxx.NullTime{}
+	}
+	return sqlxx.NullTime(t.UTC().Truncate(time.Second))
+}
@@ -0,0 +1,137 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+)
+
+// revocationFeedRetention is how long revocation feed entries are kept. It
+// matches the maximum introspection cache TTL, after which no cached result
+// can be affected by an entry anymore.
+const revocationFeedRetention = time.Hour
+
+// OAuth2RevocationFeedEntry records that the tokens of a request were revoked
+// or removed, so that all nodes can invalidate their cached introspection
+// results.
+type OAuth2RevocationFeedEntry struct {
+	ID        uuid.UUID `db:"id"`
+	NID       uuid.UUID `db:"nid"`
+	RequestID string    `db:"request_id"`
+	RevokedAt time.Time `db:"revoked_at"`
+}
+
+func (OAuth2RevocationFeedEntry) TableName() string {
+	return "hydra_oauth2_revocation_feed"
+}
+
+// recordRevocations appends the requests to the revocation feed. The feed is
+// only read by the introspection cache, so nothing is written if the cache is
+// disabled. The requests are also invalidated in the local cache once the
+// transaction is committed, instead of waiting for the next poll of the feed.
+func (p *BasePersister) recordRevocations(ctx context.Context, requestIDs ...string) error {
+	if len(requestIDs) == 0 || !p.d.Config().IntrospectionCacheEnabled(ctx) {
+		return nil
+	}
+
+	nid := p.NetworkID(ctx)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	for batch := range slices.Chunk(requestIDs, queryBatchSize) {
+		values := make([]string, 0, len(batch))
+		args := make([]any, 0, len(batch)*4)
+		for _, id := range batch {
+			values = append(values, "(?, ?, ?, ?)")
+			args = append(args, uuid.Must(uuid.NewV4()), nid, id, now)
+		}
+
+		/* #nosec G201 table is static */
+		if err := p.Connection(ctx).RawQuery(
+			fmt.Sprintf("INSERT INTO %s (id, nid, request_id, revoked_at) VALUES %s",
+				OAuth2RevocationFeedEntry{}.TableName(), strings.Join(values, ", ")),
+			args...,
+		).Exec(); err != nil {
+			return sqlcon.HandleError(err)
+		}
+	}
+
+	// Invalidating before the commit would let concurrent introspections cache
+	// the rows which are about to be deleted again.
+	ids := slices.Clone(requestIDs)
+	afterCommit(ctx, func() {
+		cache := p.d.OAuth2IntrospectionCache()
+		for _, id := range ids {
+			cache.Invalidate(ctx, id, now)
+		}
+	})
+	return nil
+}
+
+// ListTokenRevocations implements FositeStorer
+func (p *Persister) ListTokenRevocations(ctx context.Context, since time.Time, limit int) (_ []x.TokenRevocation, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ListTokenRevocations")
+	defer otelx.End(span, &err)
+
+	var entries []OAuth2RevocationFeedEntry
+	if err := p.QueryWithNetwork(ctx).
+		Where("revoked_at >= ?", since.UTC()).
+		Order("revoked_at ASC").
+		Limit(limit).
+		All(&entries); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	revocations := make([]x.TokenRevocation, len(entries))
+	for i, e := range entries {
+		revocations[i] = x.TokenRevocation{RequestID: e.RequestID, RevokedAt: e.RevokedAt.UTC()}
+	}
+	return revocations, nil
+}
+
+// flushRevocationFeed removes revocation feed entries which can no longer
+// affect any cached introspection result.
+func (p *Persister) flushRevocationFeed(ctx context.Context, notAfter time.Time, limit int, batchSize int) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.flushRevocationFeed")
+	defer otelx.End(span, &err)
+
+	if maxAge := time.Now().Add(-revocationFeedRetention); maxAge.Before(notAfter) {
+		notAfter = maxAge
+	}
+
+	totalDeletedCount := 0
+	for deletedRecords := batchSize; totalDeletedCount < limit && deletedRecords == batchSize; {
+		d := batchSize
+		if limit-totalDeletedCount < batchSize {
+			d = limit - totalDeletedCount
+		}
+		// The outer SELECT is necessary because our version of MySQL doesn't yet support 'LIMIT & IN/ALL/ANY/SOME subquery
+		/* #nosec G201 table is static */
+		deletedRecords, err = p.Connection(ctx).RawQuery(
+			fmt.Sprintf(`DELETE FROM %[1]s WHERE nid = ? AND id IN (
+				SELECT id FROM (SELECT id FROM %[1]s WHERE nid = ? AND revoked_at < ? LIMIT %[2]d) AS s
+			)`, OAuth2RevocationFeedEntry{}.TableName(), d),
+			p.NetworkID(ctx),
+			p.NetworkID(ctx),
+			notAfter.UTC(),
+		).ExecWithCount()
+		totalDeletedCount += deletedRecords
+
+		if err != nil {
+			break
+		}
+	}
+	janitorDeletedRecords.WithLabelValues(OAuth2RevocationFeedEntry{}.TableName()).Add(float64(totalDeletedCount))
+	p.l.Debugf("Flush revocation feed flushed_records: %d", totalDeletedCount)
+	return sqlcon.HandleError(err)
+}
@@ -0,0 +1,115 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ory/hydra/v2/consent"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/x"
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+)
+
+var _ consent.PairwiseSubjectManager = (*Persister)(nil)
+
+// GetPairwiseSubject implements consent.PairwiseSubjectManager
+func (p *Persister) GetPairwiseSubject(ctx context.Context, clientID, subject string) (_ *consent.PairwiseSubject, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetPairwiseSubject", trace.WithAttributes(attribute.String("client.id", clientID)))
+	defer otelx.End(span, &err)
+
+	var ps consent.PairwiseSubject
+	if err := p.QueryWithNetwork(ctx).Where("client_id = ? AND subject = ?", clientID, subject).First(&ps); errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.WithStack(x.ErrNotFound)
+	} else if err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return &ps, nil
+}
+
+// FindPairwiseSubject implements consent.PairwiseSubjectManager
+func (p *Persister) FindPairwiseSubject(ctx context.Context, clientID, pairwiseSubject string) (_ *consent.PairwiseSubject, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.FindPairwiseSubject", trace.WithAttributes(attribute.String("client.id", clientID)))
+	defer otelx.End(span, &err)
+
+	var rows []consent.PairwiseSubject
+	if err := p.QueryWithNetwork(ctx).
+		Where("client_id = ? AND (pairwise_subject = ? OR previous_pairwise_subject = ?)", clientID, pairwiseSubject, pairwiseSubject).
+		All(&rows); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	// The current identifier takes precedence over a previous one.
+	var found *consent.PairwiseSubject
+	for i := range rows {
+		if rows[i].PairwiseSubject == pairwiseSubject {
+			return &rows[i], nil
+		} else if found == nil {
+			found = &rows[i]
+		}
+	}
+	if found == nil {
+		return nil, errors.WithStack(x.ErrNotFound)
+	}
+	return found, nil
+}
+
+// CreatePairwiseSubject implements consent.PairwiseSubjectManager
+func (p *Persister) CreatePairwiseSubject(ctx context.Context, ps *consent.PairwiseSubject) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CreatePairwiseSubject", trace.WithAttributes(attribute.String("client.id", ps.ClientID)))
+	defer otelx.End(span, &err)
+
+	return sqlcon.HandleError(p.CreateWithNetwork(ctx, ps))
+}
+
+// SetPairwiseSubjects implements consent.PairwiseSubjectManager
+func (p *Persister) SetPairwiseSubjects(ctx context.Context, clientID string, subjects []consent.PairwiseSubject) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.SetPairwiseSubjects",
+		trace.WithAttributes(attribute.String("client.id", clientID), attribute.Int("subjects", len(subjects))))
+	defer otelx.End(span, &err)
+
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		nid := p.NetworkID(ctx)
+		if err := c.RawQuery(
+			"DELETE FROM "+consent.PairwiseSubject{}.TableName()+" WHERE nid = ? AND client_id = ?",
+			nid, clientID,
+		).Exec(); err != nil {
+			return sqlcon.HandleError(err)
+		}
+
+		for i := range subjects {
+			if subjects[i].ClientID != clientID {
+				return errors.Errorf("pairwise subject belongs to client %s but expected %s", subjects[i].ClientID, clientID)
+			}
+			if err := p.CreateWithNetwork(ctx, &subjects[i]); err != nil {
+				return sqlcon.HandleError(err)
+			}
+		}
+		return nil
+	})
+}
+
+// ListClientSubjects implements consent.PairwiseSubjectManager
+func (p *Persister) ListClientSubjects(ctx context.Context, clientID string) (_ []string, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ListClientSubjects", trace.WithAttributes(attribute.String("client.id", clientID)))
+	defer otelx.End(span, &err)
+
+	var subjects []string
+	if err := p.Connection(ctx).RawQuery(
+		"SELECT subject FROM "+flow.Flow{}.TableName()+" WHERE nid = ? AND client_id = ? AND subject <> ''"+
+			" UNION SELECT subject FROM "+consent.PairwiseSubject{}.TableName()+" WHERE nid = ? AND client_id = ?"+
+			" ORDER BY subject",
+		p.NetworkID(ctx), clientID, p.NetworkID(ctx), clientID,
+	).All(&subjects); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return subjects, nil
+}
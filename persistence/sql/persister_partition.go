@@ -0,0 +1,634 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ory/hydra/v2/tenant"
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/contextx"
+	"github.com/ory/x/dbal"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+)
+
+// The token tables can use a partitioned storage layout, which makes removing
+// expired tokens cheap. On PostgreSQL, the tables are range-partitioned by
+// requested_at, and partitions are dropped as a whole once all of their rows
+// are older than the table's lifespan. On CockroachDB, which can not drop
+// partitions, the tables use row-level TTL instead.
+//
+// The flow table is partitioned as well. A flow is only needed as long as the
+// tokens issued for it, unless its consent is remembered. On PostgreSQL, the
+// table is therefore list-partitioned by consent_remember: remembered consent
+// sessions are kept in a partition of their own until they are revoked, and
+// all other flows are range-partitioned by requested_at like the tokens. These
+// partitions are dropped once they are older than the longest token lifespan.
+// Because the consent challenge can not be referenced across partitions, the
+// token tables do not reference the flow table anymore, and revoking a consent
+// session removes its tokens explicitly. On CockroachDB, the row-level TTL of
+// a flow is derived from the same rules.
+
+var partitionedTokenTables = []tableName{sqlTableAccess, sqlTableRefresh, sqlTableCode, sqlTableOpenID, sqlTablePKCE}
+
+const (
+	flowTable = "hydra_oauth2_flow"
+	// flowExpiringTable is the partition of the flow table containing the
+	// flows whose consent is not remembered. It is range-partitioned itself.
+	flowExpiringTable   = flowTable + "_expiring"
+	flowRememberedTable = flowTable + "_remembered"
+)
+
+const (
+	// partitionPremake is how far ahead of time partitions are created. Rows
+	// for which no partition exists yet are stored in the default partition.
+	partitionPremake = 7 * 24 * time.Hour
+
+	partitionTimeFormat  = "2006010215"
+	partitionBoundFormat = "2006-01-02 15:04:05"
+)
+
+var partitionNamePattern = regexp.MustCompile(`_p(\d{10})_(\d{10})$`)
+
+type tokenPartition struct {
+	name       string
+	start, end time.Time
+}
+
+func partitionName(table string, start, end time.Time) string {
+	return fmt.Sprintf("%s_p%s_%s", table, start.Format(partitionTimeFormat), end.Format(partitionTimeFormat))
+}
+
+// PartitionTables implements persistence.Persister
+func (p *Persister) PartitionTables(ctx context.Context, interval time.Duration) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.PartitionTables",
+		trace.WithAttributes(attribute.String("interval", interval.String())))
+	defer otelx.End(span, &err)
+
+	switch dialect := p.Connection(ctx).Dialect.Name(); dialect {
+	case dbal.DriverPostgreSQL:
+		if interval < time.Hour || interval%time.Hour != 0 {
+			return errors.Errorf("the partition interval must be a multiple of one hour, got %s", interval)
+		}
+		// The token tables are partitioned first, because their foreign keys
+		// referencing the flow table are copied.
+		for _, table := range partitionedTokenTables {
+			if err := p.partitionTable(ctx, OAuth2RequestSQL{Table: table}.TableName(), interval); err != nil {
+				return err
+			}
+		}
+		if err := p.partitionFlowTable(ctx, interval); err != nil {
+			return err
+		}
+	case dbal.DriverCockroachDB:
+		for _, table := range partitionedTokenTables {
+			if err := p.setTokenTableTTL(ctx, table); err != nil {
+				return err
+			}
+		}
+		if err := p.setFlowTableTTL(ctx); err != nil {
+			return err
+		}
+	default:
+		return errors.Errorf("partitioned tables are only supported on PostgreSQL and CockroachDB, not on %s", dialect)
+	}
+	return nil
+}
+
+// FlushExpiredTokenPartitions implements x.FositeStorer
+func (p *Persister) FlushExpiredTokenPartitions(ctx context.Context, notAfter time.Time) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.FlushExpiredTokenPartitions")
+	defer otelx.End(span, &err)
+
+	switch p.Connection(ctx).Dialect.Name() {
+	case dbal.DriverPostgreSQL:
+		for _, table := range partitionedTokenTables {
+			lifespan, err := p.tokenLifespan(ctx, table)
+			if err != nil {
+				return err
+			}
+			if err := p.maintainPartitions(ctx, OAuth2RequestSQL{Table: table}.TableName(), lifespan, notAfter); err != nil {
+				return err
+			}
+		}
+	case dbal.DriverCockroachDB:
+		for _, table := range partitionedTokenTables {
+			if ok, err := p.hasTableTTL(ctx, OAuth2RequestSQL{Table: table}.TableName()); err != nil {
+				return err
+			} else if ok {
+				// Applies changes of the lifespans to the TTL.
+				if err := p.setTokenTableTTL(ctx, table); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// FlushExpiredFlowPartitions implements x.FositeStorer
+func (p *Persister) FlushExpiredFlowPartitions(ctx context.Context, notAfter time.Time) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.FlushExpiredFlowPartitions")
+	defer otelx.End(span, &err)
+
+	switch p.Connection(ctx).Dialect.Name() {
+	case dbal.DriverPostgreSQL:
+		lifespan, err := p.flowLifespan(ctx)
+		if err != nil {
+			return err
+		}
+		return p.maintainPartitions(ctx, flowExpiringTable, lifespan, notAfter)
+	case dbal.DriverCockroachDB:
+		if ok, err := p.hasTableTTL(ctx, flowTable); err != nil || !ok {
+			return err
+		}
+		// Applies changes of the lifespans to the TTL.
+		return p.setFlowTableTTL(ctx)
+	}
+	return nil
+}
+
+// tokenLifespan returns the longest lifespan of the table's rows across all
+// networks. It returns a negative duration if the rows do not expire.
+func (p *Persister) tokenLifespan(ctx context.Context, table tableName) (time.Duration, error) {
+	return p.longestLifespan(ctx, func(ctx context.Context) time.Duration {
+		c := p.r.Config()
+		switch table {
+		case sqlTableAccess:
+			return c.GetAccessTokenLifespan(ctx)
+		case sqlTableRefresh:
+			return c.GetRefreshTokenLifespan(ctx)
+		default:
+			// OpenID Connect and PKCE sessions are used when the authorization or
+			// device code is exchanged.
+			return max(c.GetAuthorizeCodeLifespan(ctx), c.GetDeviceAndUserCodeLifespan(ctx))
+		}
+	})
+}
+
+// flowLifespan returns how long flows whose consent is not remembered are
+// kept, which is the longest lifespan of the tokens issued for them. It
+// returns a negative duration if the flows do not expire.
+func (p *Persister) flowLifespan(ctx context.Context) (time.Duration, error) {
+	return p.longestLifespan(ctx, func(ctx context.Context) time.Duration {
+		c := p.r.Config()
+		lifespans := []time.Duration{
+			c.GetAccessTokenLifespan(ctx),
+			c.GetRefreshTokenLifespan(ctx),
+			c.GetAuthorizeCodeLifespan(ctx),
+			c.GetDeviceAndUserCodeLifespan(ctx),
+		}
+		if slices.ContainsFunc(lifespans, func(l time.Duration) bool { return l < 0 }) {
+			return -1
+		}
+		return slices.Max(lifespans)
+	})
+}
+
+// longestLifespan returns the longest lifespan across all networks. It
+// returns a negative duration if the lifespan of any network is negative.
+func (p *Persister) longestLifespan(ctx context.Context, lifespan func(ctx context.Context) time.Duration) (time.Duration, error) {
+	// Partitions contain the rows of all networks, so the lifespan overrides of
+	// all tenants have to be respected.
+	longest := lifespan(ctx)
+	tenants, err := p.ListTenants(ctx)
+	if err != nil {
+		return 0, err
+	}
+	base := p.r.Config().Source(contextx.RootContext)
+	for i := range tenants {
+		c, err := tenants[i].NewConfig(ctx, base)
+		if err != nil {
+			return 0, err
+		}
+		l := lifespan(tenant.NewContext(ctx, &tenants[i], c))
+		if l < 0 || longest < 0 {
+			return -1, nil
+		}
+		longest = max(longest, l)
+	}
+	return longest, nil
+}
+
+func (p *Persister) isPartitioned(ctx context.Context, table string) (bool, error) {
+	var partitioned bool
+	if err := p.Connection(ctx).RawQuery(
+		`SELECT EXISTS (SELECT 1 FROM pg_partitioned_table WHERE partrelid = to_regclass(?))`, table,
+	).First(&partitioned); err != nil {
+		return false, sqlcon.HandleError(err)
+	}
+	return partitioned, nil
+}
+
+func (p *Persister) partitionTable(ctx context.Context, table string, interval time.Duration) error {
+	if partitioned, err := p.isPartitioned(ctx, table); err != nil {
+		return err
+	} else if partitioned {
+		p.l.Infof("Table %s is already partitioned.", table)
+		return nil
+	}
+
+	// Rows requested until the first partition starts stay in the existing
+	// table, which becomes the legacy partition.
+	start := time.Now().UTC().Truncate(interval).Add(interval)
+	legacy := table + "_legacy"
+
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		var indexes, indexDefs []string
+		if err := c.RawQuery(`SELECT indexname FROM pg_indexes WHERE schemaname = current_schema() AND tablename = ? ORDER BY indexname`, table).All(&indexes); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		if err := c.RawQuery(`SELECT indexdef FROM pg_indexes WHERE schemaname = current_schema() AND tablename = ? ORDER BY indexname`, table).All(&indexDefs); err != nil {
+			return sqlcon.HandleError(err)
+		}
+
+		var foreignKeys, foreignKeyDefs []string
+		if err := c.RawQuery(`SELECT conname FROM pg_constraint WHERE conrelid = to_regclass(?) AND contype = 'f' ORDER BY conname`, table).All(&foreignKeys); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		if err := c.RawQuery(`SELECT pg_get_constraintdef(oid) FROM pg_constraint WHERE conrelid = to_regclass(?) AND contype = 'f' ORDER BY conname`, table).All(&foreignKeyDefs); err != nil {
+			return sqlcon.HandleError(err)
+		}
+
+		statements := []string{fmt.Sprintf("ALTER TABLE %s RENAME TO %s", table, legacy)}
+		for _, index := range indexes {
+			statements = append(statements, fmt.Sprintf("ALTER INDEX %s RENAME TO %s_legacy", index, index))
+		}
+		statements = append(statements,
+			fmt.Sprintf("CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING GENERATED) PARTITION BY RANGE (requested_at)", table, legacy),
+			fmt.Sprintf("ALTER TABLE %s ATTACH PARTITION %s FOR VALUES FROM (MINVALUE) TO ('%s')", table, legacy, start.Format(partitionBoundFormat)),
+			// The partition key must be part of the primary key.
+			fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (signature, requested_at)", table),
+		)
+		indexStatements, err := partitionedIndexes(table, indexes, indexDefs, "requested_at")
+		if err != nil {
+			return err
+		}
+		statements = append(statements, indexStatements...)
+		for i, fk := range foreignKeys {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s %s", table, fk, foreignKeyDefs[i]))
+		}
+		statements = append(statements, fmt.Sprintf("CREATE TABLE %s_default PARTITION OF %s DEFAULT", table, table))
+
+		for _, s := range statements {
+			if err := c.RawQuery(s).Exec(); err != nil {
+				return sqlcon.HandleError(err)
+			}
+		}
+
+		for end := start.Add(interval); start.Before(time.Now().Add(partitionPremake)); start, end = end, end.Add(interval) {
+			if err := p.createPartition(ctx, table, start, end); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (p *Persister) partitionFlowTable(ctx context.Context, interval time.Duration) error {
+	if partitioned, err := p.isPartitioned(ctx, flowTable); err != nil {
+		return err
+	} else if partitioned {
+		p.l.Infof("Table %s is already partitioned.", flowTable)
+		return nil
+	}
+
+	// Flows requested until the first partition starts stay in the existing
+	// table, which becomes the legacy partition of the expiring flows.
+	start := time.Now().UTC().Truncate(interval).Add(interval)
+	legacy := flowExpiringTable + "_legacy"
+
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		var indexes, indexDefs []string
+		if err := c.RawQuery(`SELECT indexname FROM pg_indexes WHERE schemaname = current_schema() AND tablename = ? ORDER BY indexname`, flowTable).All(&indexes); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		if err := c.RawQuery(`SELECT indexdef FROM pg_indexes WHERE schemaname = current_schema() AND tablename = ? ORDER BY indexname`, flowTable).All(&indexDefs); err != nil {
+			return sqlcon.HandleError(err)
+		}
+
+		var foreignKeys, foreignKeyDefs []string
+		if err := c.RawQuery(`SELECT conname FROM pg_constraint WHERE conrelid = to_regclass(?) AND contype = 'f' ORDER BY conname`, flowTable).All(&foreignKeys); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		if err := c.RawQuery(`SELECT pg_get_constraintdef(oid) FROM pg_constraint WHERE conrelid = to_regclass(?) AND contype = 'f' ORDER BY conname`, flowTable).All(&foreignKeyDefs); err != nil {
+			return sqlcon.HandleError(err)
+		}
+
+		// The token tables reference the consent challenge, which can not be
+		// unique across partitions.
+		var references []string
+		if err := c.RawQuery(
+			`SELECT format('ALTER TABLE %s DROP CONSTRAINT %I', conrelid::regclass, conname) FROM pg_constraint WHERE confrelid = to_regclass(?) AND contype = 'f' AND conparentid = 0 ORDER BY conname`,
+			flowTable,
+		).All(&references); err != nil {
+			return sqlcon.HandleError(err)
+		}
+
+		columns, err := p.insertableColumns(ctx, flowTable)
+		if err != nil {
+			return err
+		}
+
+		statements := append(references, fmt.Sprintf("ALTER TABLE %s RENAME TO %s", flowTable, legacy))
+		for _, index := range indexes {
+			statements = append(statements, fmt.Sprintf("ALTER INDEX %s RENAME TO %s_legacy", index, index))
+		}
+		statements = append(statements,
+			// Flows without consent_remember were never remembered. The
+			// partition key must not be NULL to be part of the primary key.
+			fmt.Sprintf("UPDATE %s SET consent_remember = FALSE WHERE consent_remember IS NULL", legacy),
+			fmt.Sprintf("ALTER TABLE %s ALTER COLUMN consent_remember SET NOT NULL", legacy),
+			fmt.Sprintf("CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING GENERATED) PARTITION BY LIST (consent_remember)", flowTable, legacy),
+			fmt.Sprintf("CREATE TABLE %s PARTITION OF %s FOR VALUES IN (TRUE)", flowRememberedTable, flowTable),
+			fmt.Sprintf("CREATE TABLE %s PARTITION OF %s FOR VALUES IN (FALSE) PARTITION BY RANGE (requested_at)", flowExpiringTable, flowTable),
+			// Remembered consent sessions are moved to their partition, the
+			// other flows stay in the legacy partition.
+			fmt.Sprintf("INSERT INTO %s (%[2]s) SELECT %[2]s FROM %s WHERE consent_remember", flowRememberedTable, columns, legacy),
+			fmt.Sprintf("DELETE FROM %s WHERE consent_remember", legacy),
+			fmt.Sprintf("ALTER TABLE %s ATTACH PARTITION %s FOR VALUES FROM (MINVALUE) TO ('%s')", flowExpiringTable, legacy, start.Format(partitionBoundFormat)),
+			// The partition keys must be part of the primary key.
+			fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (login_challenge, consent_remember, requested_at)", flowTable),
+		)
+		indexStatements, err := partitionedIndexes(flowTable, indexes, indexDefs, "consent_remember", "requested_at")
+		if err != nil {
+			return err
+		}
+		statements = append(statements, indexStatements...)
+		for i, fk := range foreignKeys {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s %s", flowTable, fk, foreignKeyDefs[i]))
+		}
+		statements = append(statements, fmt.Sprintf("CREATE TABLE %s_default PARTITION OF %s DEFAULT", flowExpiringTable, flowExpiringTable))
+
+		for _, s := range statements {
+			if err := c.RawQuery(s).Exec(); err != nil {
+				return sqlcon.HandleError(err)
+			}
+		}
+
+		for end := start.Add(interval); start.Before(time.Now().Add(partitionPremake)); start, end = end, end.Add(interval) {
+			if err := p.createPartition(ctx, flowExpiringTable, start, end); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+var uniqueIndexPattern = regexp.MustCompile(`^CREATE UNIQUE INDEX (\S+) ON (\S+) USING (\w+) \(([^(),]+)\)$`)
+
+// partitionedIndexes returns the statements recreating the indexes of a table
+// on its partitioned replacement. PostgreSQL only enforces unique indexes
+// which contain the partition keys, so the partition keys are appended to
+// unique indexes, and a trigger rejects rows whose value already exists in
+// another partition. The primary key is not recreated.
+func partitionedIndexes(table string, indexes, indexDefs []string, partitionKeys ...string) ([]string, error) {
+	statements := []string{uniqueGuardFunction}
+	for i, def := range indexDefs {
+		if !strings.HasPrefix(def, "CREATE UNIQUE INDEX") {
+			statements = append(statements, def)
+			continue
+		}
+
+		m := uniqueIndexPattern.FindStringSubmatch(def)
+		if m == nil {
+			return nil, errors.Errorf("unable to partition table %s because the uniqueness of index %s can not be enforced across partitions", table, indexes[i])
+		}
+		if indexes[i] != table+"_pkey" {
+			statements = append(statements, fmt.Sprintf("CREATE UNIQUE INDEX %s ON %s USING %s (%s, %s)", m[1], m[2], m[3], m[4], strings.Join(partitionKeys, ", ")))
+		}
+		statements = append(statements, fmt.Sprintf(
+			"CREATE TRIGGER %[1]s_%[2]s_unique BEFORE INSERT ON %[1]s FOR EACH ROW EXECUTE FUNCTION hydra_partition_unique_guard('%[1]s', '%[2]s')",
+			table, m[4]))
+	}
+	return statements, nil
+}
+
+// uniqueGuardFunction is the trigger function rejecting rows whose value of a
+// column already exists in the table. Concurrent inserts of the same value are
+// serialized by an advisory lock, so that the lookup sees rows committed in
+// the meantime. The table and column are passed as trigger arguments, because
+// the trigger of a partition only knows the partition's name.
+const uniqueGuardFunction = `CREATE OR REPLACE FUNCTION hydra_partition_unique_guard() RETURNS trigger LANGUAGE plpgsql AS $$
+DECLARE
+	value text;
+	taken boolean;
+BEGIN
+	EXECUTE format('SELECT ($1).%I::text', TG_ARGV[1]) INTO value USING NEW;
+	IF value IS NULL THEN
+		RETURN NEW;
+	END IF;
+	PERFORM pg_advisory_xact_lock(hashtext(TG_ARGV[0] || '.' || TG_ARGV[1]), hashtext(value));
+	EXECUTE format('SELECT EXISTS (SELECT 1 FROM %I WHERE %I = $1)', TG_ARGV[0], TG_ARGV[1]) INTO taken USING value;
+	IF taken THEN
+		RAISE unique_violation USING MESSAGE = format('duplicate key value violates unique constraint on %s (%s)', TG_ARGV[0], TG_ARGV[1]);
+	END IF;
+	RETURN NEW;
+END
+$$`
+
+// insertableColumns returns the comma-separated columns of the table which
+// are not generated.
+func (p *Persister) insertableColumns(ctx context.Context, table string) (string, error) {
+	var columns []string
+	if err := p.Connection(ctx).RawQuery(
+		`SELECT column_name FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = ? AND is_generated = 'NEVER' ORDER BY ordinal_position`, table,
+	).All(&columns); err != nil {
+		return "", sqlcon.HandleError(err)
+	}
+	return strings.Join(columns, ", "), nil
+}
+
+func (p *Persister) listPartitions(ctx context.Context, table string) (legacy bool, partitions []tokenPartition, err error) {
+	var names []string
+	if err := p.Connection(ctx).RawQuery(
+		`SELECT c.relname FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid WHERE i.inhparent = to_regclass(?)`, table,
+	).All(&names); err != nil {
+		return false, nil, sqlcon.HandleError(err)
+	}
+
+	for _, name := range names {
+		if name == table+"_legacy" {
+			legacy = true
+			continue
+		}
+		m := partitionNamePattern.FindStringSubmatch(name)
+		if m == nil {
+			continue
+		}
+		start, err := time.Parse(partitionTimeFormat, m[1])
+		if err != nil {
+			return false, nil, errors.WithStack(err)
+		}
+		end, err := time.Parse(partitionTimeFormat, m[2])
+		if err != nil {
+			return false, nil, errors.WithStack(err)
+		}
+		partitions = append(partitions, tokenPartition{name: name, start: start, end: end})
+	}
+
+	slices.SortFunc(partitions, func(a, b tokenPartition) int { return a.start.Compare(b.start) })
+	return legacy, partitions, nil
+}
+
+// maintainPartitions creates the upcoming partitions of the table and drops
+// those partitions whose rows are all older than the lifespan.
+func (p *Persister) maintainPartitions(ctx context.Context, name string, lifespan time.Duration, notAfter time.Time) error {
+	if partitioned, err := p.isPartitioned(ctx, name); err != nil || !partitioned {
+		return err
+	}
+
+	legacy, partitions, err := p.listPartitions(ctx, name)
+	if err != nil {
+		return err
+	} else if len(partitions) == 0 {
+		return errors.Errorf("table %s is partitioned, but has no partitions created by Ory Hydra", name)
+	}
+
+	last := partitions[len(partitions)-1]
+	interval := last.end.Sub(last.start)
+	for start, end := last.end, last.end.Add(interval); start.Before(time.Now().Add(partitionPremake)); start, end = end, end.Add(interval) {
+		if err := p.Transaction(ctx, func(ctx context.Context, _ *pop.Connection) error {
+			return p.createPartition(ctx, name, start, end)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if lifespan < 0 {
+		// The rows never expire.
+		return nil
+	}
+
+	// The same rows are considered expired as by the batched deletion, see
+	// flushInactiveTokens.
+	cutoff := time.Now().Add(-lifespan)
+	if notAfter.Before(cutoff) {
+		cutoff = notAfter
+	}
+	cutoff = cutoff.UTC()
+
+	var drop []string
+	if legacy && !partitions[0].start.After(cutoff) {
+		drop = append(drop, name+"_legacy")
+	}
+	for _, partition := range partitions {
+		if !partition.end.After(cutoff) {
+			drop = append(drop, partition.name)
+		}
+	}
+
+	for _, partition := range drop {
+		if err := p.Connection(ctx).RawQuery(fmt.Sprintf("DROP TABLE %s", partition)).Exec(); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		p.l.Infof("Dropped expired partition %s.", partition)
+	}
+	return nil
+}
+
+// createPartition creates the partition of the table from start to end. Rows
+// in this range which were stored in the default partition are moved to it.
+// It must be called in a transaction.
+func (p *Persister) createPartition(ctx context.Context, table string, start, end time.Time) error {
+	c := p.Connection(ctx)
+	name := partitionName(table, start, end)
+	def := table + "_default"
+	from, to := start.Format(partitionBoundFormat), end.Format(partitionBoundFormat)
+
+	columns, err := p.insertableColumns(ctx, table)
+	if err != nil {
+		return err
+	}
+
+	var misplaced bool
+	if err := c.RawQuery(
+		fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE requested_at >= ? AND requested_at < ?)", def), start, end,
+	).First(&misplaced); err != nil {
+		return sqlcon.HandleError(err)
+	}
+
+	statements := []string{fmt.Sprintf("CREATE TABLE %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')", name, table, from, to)}
+	if misplaced {
+		// A partition can not be created while the default partition contains
+		// rows belonging to it.
+		statements = []string{
+			fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", table, def),
+			statements[0],
+			fmt.Sprintf("INSERT INTO %s (%[2]s) SELECT %[2]s FROM %s WHERE requested_at >= '%s' AND requested_at < '%s'", table, columns, def, from, to),
+			fmt.Sprintf("DELETE FROM %s WHERE requested_at >= '%s' AND requested_at < '%s'", def, from, to),
+			fmt.Sprintf("ALTER TABLE %s ATTACH PARTITION %s DEFAULT", table, def),
+		}
+	}
+
+	for _, s := range statements {
+		if err := c.RawQuery(s).Exec(); err != nil {
+			return sqlcon.HandleError(err)
+		}
+	}
+	return nil
+}
+
+func (p *Persister) hasTableTTL(ctx context.Context, table string) (bool, error) {
+	var create string
+	if err := p.Connection(ctx).RawQuery(
+		fmt.Sprintf("SELECT create_statement FROM [SHOW CREATE TABLE %s]", table),
+	).First(&create); err != nil {
+		return false, sqlcon.HandleError(err)
+	}
+	return strings.Contains(create, "ttl_expiration_expression"), nil
+}
+
+// setTokenTableTTL makes CockroachDB remove the rows of the table once they
+// are older than the table's lifespan.
+func (p *Persister) setTokenTableTTL(ctx context.Context, table tableName) error {
+	lifespan, err := p.tokenLifespan(ctx, table)
+	if err != nil {
+		return err
+	}
+	return p.setTableTTL(ctx, OAuth2RequestSQL{Table: table}.TableName(), lifespan,
+		fmt.Sprintf(`(requested_at AT TIME ZONE 'UTC') + INTERVAL '%d seconds'`, int64(lifespan.Seconds())))
+}
+
+// setFlowTableTTL makes CockroachDB remove flows once their tokens expired,
+// unless the consent is remembered. Remembered consent sessions are removed
+// once the consent and the tokens expired, or never if the consent is
+// remembered forever.
+func (p *Persister) setFlowTableTTL(ctx context.Context) error {
+	lifespan, err := p.flowLifespan(ctx)
+	if err != nil {
+		return err
+	}
+	return p.setTableTTL(ctx, flowTable, lifespan, fmt.Sprintf(
+		`CASE WHEN consent_remember AND COALESCE(consent_remember_for, 0) <= 0 THEN NULL ELSE (requested_at AT TIME ZONE 'UTC') + GREATEST(CASE WHEN consent_remember THEN consent_remember_for ELSE 0 END, %d) * INTERVAL '1 second' END`,
+		int64(lifespan.Seconds())))
+}
+
+// setTableTTL makes CockroachDB remove the rows of the table once the
+// expiration expression has passed, or resets the TTL if the lifespan is
+// negative.
+func (p *Persister) setTableTTL(ctx context.Context, name string, lifespan time.Duration, expiration string) error {
+	if lifespan < 0 {
+		// The rows never expire.
+		if ok, err := p.hasTableTTL(ctx, name); err != nil || !ok {
+			return err
+		}
+		return sqlcon.HandleError(p.Connection(ctx).RawQuery(fmt.Sprintf(`ALTER TABLE %s RESET (ttl)`, name)).Exec())
+	}
+
+	return sqlcon.HandleError(p.Connection(ctx).RawQuery(fmt.Sprintf(
+		`ALTER TABLE %s SET (ttl_expiration_expression = $$(%s)$$, ttl_job_cron = '@hourly')`,
+		name, expiration,
+	)).Exec())
+}
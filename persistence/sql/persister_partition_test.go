@@ -0,0 +1,154 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/flow"
+	"github.com/ory/hydra/v2/fosite"
+	"github.com/ory/hydra/v2/fosite/handler/openid"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/oauth2"
+	"github.com/ory/x/sqlxx"
+)
+
+func TestPartitionTables(t *testing.T) {
+	t.Parallel()
+
+	t.Run("database=sqlite", func(t *testing.T) {
+		t.Parallel()
+
+		reg := testhelpers.NewRegistryMemory(t)
+		require.Error(t, reg.Persister().PartitionTables(t.Context(), 24*time.Hour))
+		require.NoError(t, reg.Persister().FlushExpiredTokenPartitions(t.Context(), time.Now()))
+		require.NoError(t, reg.Persister().FlushExpiredFlowPartitions(t.Context(), time.Now()))
+	})
+
+	t.Run("database=postgres", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("requires a PostgreSQL database")
+		}
+		t.Parallel()
+
+		ctx := t.Context()
+		reg := testhelpers.NewRegistrySQLFromURL(t, testhelpers.ConnectToPG(t), true, true)
+		p := reg.Persister()
+
+		cl := &client.Client{ID: uuid.Must(uuid.NewV4()).String()}
+		require.NoError(t, p.CreateClient(ctx, cl))
+
+		newRequest := func() *fosite.Request {
+			r := fosite.NewRequest()
+			r.ID = uuid.Must(uuid.NewV4()).String()
+			r.Client = cl
+			r.RequestedAt = time.Now().UTC().Round(time.Second)
+			r.Session = &oauth2.Session{DefaultSession: &openid.DefaultSession{Subject: "sub"}}
+			return r
+		}
+
+		before := uuid.Must(uuid.NewV4()).String()
+		require.NoError(t, p.CreateAccessTokenSession(ctx, before, newRequest()))
+
+		newConsentFlow := func(remember bool) *flow.Flow {
+			f := newFlow(p.NetworkID(ctx), cl.ID, "sub", sqlxx.NullString(""))
+			f.ConsentRequestID = sqlxx.NullString(uuid.Must(uuid.NewV4()).String())
+			f.State = flow.FlowStateConsentUsed
+			f.ConsentRemember = remember
+			require.NoError(t, p.Connection(ctx).Create(f))
+			return f
+		}
+		remembered, expiring := newConsentFlow(true), newConsentFlow(false)
+
+		require.Error(t, p.PartitionTables(ctx, 90*time.Minute), "the interval must be a multiple of one hour")
+		require.NoError(t, p.PartitionTables(ctx, 24*time.Hour))
+		require.NoError(t, p.PartitionTables(ctx, 24*time.Hour), "partitioning is idempotent")
+
+		var partitions []string
+		require.NoError(t, p.Connection(ctx).RawQuery(
+			`SELECT c.relname FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid WHERE i.inhparent = to_regclass('hydra_oauth2_access')`,
+		).All(&partitions))
+		assert.Contains(t, partitions, "hydra_oauth2_access_legacy")
+		assert.Contains(t, partitions, "hydra_oauth2_access_default")
+		assert.GreaterOrEqual(t, len(partitions), 2+7)
+
+		_, err := p.GetAccessTokenSession(ctx, before, &oauth2.Session{})
+		require.NoError(t, err, "existing rows are kept")
+
+		partitions = nil
+		require.NoError(t, p.Connection(ctx).RawQuery(
+			`SELECT c.relname FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid WHERE i.inhparent = to_regclass('hydra_oauth2_flow')`,
+		).All(&partitions))
+		assert.ElementsMatch(t, []string{"hydra_oauth2_flow_remembered", "hydra_oauth2_flow_expiring"}, partitions)
+
+		for _, f := range []*flow.Flow{remembered, expiring} {
+			actual := flow.Flow{}
+			require.NoError(t, p.Connection(ctx).Where("login_challenge = ?", f.ID).First(&actual), "existing flows are kept")
+			assert.Equal(t, f.ConsentRemember, actual.ConsentRemember)
+		}
+		var rememberedCount int
+		require.NoError(t, p.Connection(ctx).RawQuery(`SELECT COUNT(*) FROM hydra_oauth2_flow_remembered`).First(&rememberedCount))
+		assert.Equal(t, 1, rememberedCount, "remembered consent sessions are moved to their partition")
+
+		after := uuid.Must(uuid.NewV4()).String()
+		future := newRequest()
+		future.RequestedAt = time.Now().UTC().Add(48 * time.Hour).Round(time.Second)
+		require.NoError(t, p.CreateAccessTokenSession(ctx, after, future))
+		_, err = p.GetAccessTokenSession(ctx, after, &oauth2.Session{})
+		require.NoError(t, err)
+
+		duplicate := newRequest()
+		duplicate.RequestedAt = future.RequestedAt.Add(-24 * time.Hour)
+		require.Error(t, p.CreateAccessTokenSession(ctx, after, duplicate), "signatures are unique across partitions")
+		f := newConsentFlow(false)
+		f.ID = uuid.Must(uuid.NewV4()).String()
+		f.RequestedAt = f.RequestedAt.Add(48 * time.Hour)
+		require.Error(t, p.Connection(ctx).Create(f), "consent challenges are unique across partitions")
+
+		t.Run("case=revoking a consent session removes its tokens", func(t *testing.T) {
+			f := newConsentFlow(true)
+			session := func() *oauth2.Session {
+				return &oauth2.Session{DefaultSession: &openid.DefaultSession{Subject: "sub"}, ConsentChallenge: f.ConsentRequestID.String()}
+			}
+			r := newRequest()
+			r.ID = f.ConsentRequestID.String()
+			r.Session = session()
+			dr := fosite.NewDeviceRequest()
+			dr.ID, dr.Client, dr.Session = r.ID, cl, session()
+
+			signature := uuid.Must(uuid.NewV4()).String()
+			require.NoError(t, p.CreateAuthorizeCodeSession(ctx, signature, r))
+			require.NoError(t, p.CreateOpenIDConnectSession(ctx, signature, r))
+			require.NoError(t, p.CreatePKCERequestSession(ctx, signature, r))
+			require.NoError(t, p.CreateDeviceAuthSession(ctx, signature, signature, dr))
+
+			require.NoError(t, reg.ConsentManager().RevokeConsentSessionByID(ctx, f.ConsentRequestID.String(), ""))
+
+			_, err := p.GetAuthorizeCodeSession(ctx, "", signature, session())
+			assert.ErrorIs(t, err, fosite.ErrNotFound)
+			_, err = p.GetOpenIDConnectSession(ctx, signature, r)
+			assert.Error(t, err)
+			_, err = p.GetPKCERequestSession(ctx, "", signature, session())
+			assert.ErrorIs(t, err, fosite.ErrNotFound)
+			_, err = p.GetDeviceCodeSession(ctx, signature, session())
+			assert.ErrorIs(t, err, fosite.ErrNotFound)
+		})
+
+		require.NoError(t, p.FlushExpiredTokenPartitions(ctx, time.Now()))
+		_, err = p.GetAccessTokenSession(ctx, before, &oauth2.Session{})
+		require.NoError(t, err, "partitions are only dropped once all of their rows expired")
+
+		require.NoError(t, p.FlushInactiveAccessTokens(ctx, time.Now(), 100, 10), "batched deletion works on partitioned tables")
+
+		require.NoError(t, p.FlushExpiredFlowPartitions(ctx, time.Now()))
+		require.NoError(t, p.FlushInactiveLoginConsentRequests(ctx, time.Now(), 100, 10), "batched deletion works on the partitioned flow table")
+		newConsentFlow(false)
+	})
+}
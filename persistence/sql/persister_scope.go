@@ -0,0 +1,104 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/hydra/v2/oauth2/scope"
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+)
+
+var _ scope.Manager = (*Persister)(nil)
+
+// CreateScope implements scope.Manager
+func (p *Persister) CreateScope(ctx context.Context, s *scope.Scope) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CreateScope")
+	defer otelx.End(span, &err)
+
+	s.ID = uuid.Must(uuid.NewV4())
+	s.CreatedAt = time.Now().UTC().Round(time.Second)
+	s.UpdatedAt = s.CreatedAt
+	return sqlcon.HandleError(p.CreateWithNetwork(ctx, s))
+}
+
+// GetScope implements scope.Manager
+func (p *Persister) GetScope(ctx context.Context, name string) (_ *scope.Scope, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetScope")
+	defer otelx.End(span, &err)
+
+	var s scope.Scope
+	if err := p.QueryWithNetwork(ctx).Where("name = ?", name).First(&s); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return &s, nil
+}
+
+// UpdateScope implements scope.Manager
+func (p *Persister) UpdateScope(ctx context.Context, s *scope.Scope) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.UpdateScope")
+	defer otelx.End(span, &err)
+
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		previous, err := p.GetScope(ctx, s.Name)
+		if err != nil {
+			return err
+		}
+
+		s.ID = previous.ID
+		s.CreatedAt = previous.CreatedAt
+		s.UpdatedAt = time.Now().UTC().Round(time.Second)
+		if _, err := p.UpdateWithNetwork(ctx, s); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		return nil
+	})
+}
+
+// DeleteScope implements scope.Manager
+func (p *Persister) DeleteScope(ctx context.Context, name string) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteScope")
+	defer otelx.End(span, &err)
+
+	count, err := p.Connection(ctx).RawQuery("DELETE FROM hydra_oauth2_scope WHERE name = ? AND nid = ?", name, p.NetworkID(ctx)).ExecWithCount()
+	if err != nil {
+		return sqlcon.HandleError(err)
+	} else if count == 0 {
+		return sqlcon.HandleError(sqlcon.ErrNoRows())
+	}
+	return nil
+}
+
+// ListScopes implements scope.Manager
+func (p *Persister) ListScopes(ctx context.Context) (_ []scope.Scope, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ListScopes")
+	defer otelx.End(span, &err)
+
+	var ss []scope.Scope
+	if err := p.QueryWithNetwork(ctx).Order("name ASC").All(&ss); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return ss, nil
+}
+
+// GetScopes implements scope.Manager
+func (p *Persister) GetScopes(ctx context.Context, names []string) (_ []scope.Scope, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetScopes")
+	defer otelx.End(span, &err)
+
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	var ss []scope.Scope
+	if err := p.QueryWithNetwork(ctx).Where("name IN (?)", names).Order("name ASC").All(&ss); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return ss, nil
+}
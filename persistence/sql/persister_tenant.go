@@ -0,0 +1,98 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/hydra/v2/tenant"
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/networkx"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+)
+
+var _ tenant.Manager = (*Persister)(nil)
+
+// Tenants are not scoped by network, because they are resolved before the
+// network of a request is known.
+
+// CreateTenant implements tenant.Manager
+func (p *Persister) CreateTenant(ctx context.Context, t *tenant.Tenant) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CreateTenant")
+	defer otelx.End(span, &err)
+
+	t.CreatedAt = time.Now().UTC().Round(time.Second)
+	t.UpdatedAt = t.CreatedAt
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		if err := c.Create(&networkx.Network{ID: t.ID}); err != nil {
+			return sqlcon.HandleError(err)
+		}
+		return sqlcon.HandleError(c.Create(t))
+	})
+}
+
+// GetTenant implements tenant.Manager
+func (p *Persister) GetTenant(ctx context.Context, id uuid.UUID) (_ *tenant.Tenant, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetTenant")
+	defer otelx.End(span, &err)
+
+	var t tenant.Tenant
+	if err := p.Connection(ctx).Where("id = ?", id).First(&t); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return &t, nil
+}
+
+// UpdateTenant implements tenant.Manager
+func (p *Persister) UpdateTenant(ctx context.Context, t *tenant.Tenant) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.UpdateTenant")
+	defer otelx.End(span, &err)
+
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		previous, err := p.GetTenant(ctx, t.ID)
+		if err != nil {
+			return err
+		}
+
+		t.CreatedAt = previous.CreatedAt
+		t.UpdatedAt = time.Now().UTC().Round(time.Second)
+		return sqlcon.HandleError(c.Update(t))
+	})
+}
+
+// DeleteTenant implements tenant.Manager
+func (p *Persister) DeleteTenant(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteTenant")
+	defer otelx.End(span, &err)
+
+	return p.Transaction(ctx, func(ctx context.Context, c *pop.Connection) error {
+		count, err := c.RawQuery("DELETE FROM hydra_tenant WHERE id = ?", id).ExecWithCount()
+		if err != nil {
+			return sqlcon.HandleError(err)
+		} else if count == 0 {
+			return sqlcon.HandleError(sqlcon.ErrNoRows())
+		}
+
+		// All tables of a network reference it with ON DELETE CASCADE, so this
+		// removes the tenant's data. Otherwise, the tenant could not be created
+		// again with the same ID.
+		return sqlcon.HandleError(c.RawQuery("DELETE FROM networks WHERE id = ?", id).Exec())
+	})
+}
+
+// ListTenants implements tenant.Manager
+func (p *Persister) ListTenants(ctx context.Context) (_ []tenant.Tenant, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ListTenants")
+	defer otelx.End(span, &err)
+
+	var ts []tenant.Tenant
+	if err := p.Connection(ctx).Order("name ASC").All(&ts); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+	return ts, nil
+}
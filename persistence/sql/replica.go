@@ -0,0 +1,170 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/popx"
+)
+
+// replicaHealthCheckInterval is how often the read replicas are pinged.
+const replicaHealthCheckInterval = 5 * time.Second
+
+type (
+	// ReadReplica is a connection to a read replica of the primary database.
+	ReadReplica struct {
+		// Name identifies the replica in logs and traces. It must not contain
+		// credentials.
+		Name    string
+		c       *pop.Connection
+		healthy atomic.Bool
+	}
+	readReplicas struct {
+		replicas []*ReadReplica
+		next     atomic.Uint64
+		watching atomic.Bool
+	}
+	primaryPin struct {
+		pinned atomic.Bool
+	}
+	primaryPinContextKey int
+)
+
+const primaryPinKey primaryPinContextKey = 0
+
+func NewReadReplica(name string, c *pop.Connection) *ReadReplica {
+	return &ReadReplica{Name: name, c: c}
+}
+
+// WithReadReplicas returns a copy of the persister which serves selected read
+// paths from the given replicas. Transactions and all writes always use the
+// primary.
+func (p BasePersister) WithReadReplicas(replicas []*ReadReplica) *BasePersister {
+	p.replicas = &readReplicas{replicas: replicas}
+	return &p
+}
+
+// WithPrimaryPin returns a context which can be pinned to the primary. Once a
+// write is made with the context, all following reads made with it go to the
+// primary as well, so that they observe the write. Without it, reads can only
+// be pinned by starting a transaction.
+func WithPrimaryPin(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(primaryPinKey).(*primaryPin); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, primaryPinKey, new(primaryPin))
+}
+
+// PrimaryPinMiddleware pins reads to the primary for the rest of the request
+// once the request made a write.
+func PrimaryPinMiddleware(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	next(w, r.WithContext(WithPrimaryPin(r.Context())))
+}
+
+// PinPrimary makes all following reads with the context go to the primary.
+func (p *BasePersister) PinPrimary(ctx context.Context) {
+	if pin, ok := ctx.Value(primaryPinKey).(*primaryPin); ok {
+		pin.pinned.Store(true)
+	}
+}
+
+// ReadConnection returns a connection to a healthy read replica. It returns
+// the primary if no replica is configured or healthy, if the context is in a
+// transaction, or if the context is pinned to the primary. The second return
+// value reports whether a replica was chosen.
+func (p *BasePersister) ReadConnection(ctx context.Context) (*pop.Connection, bool) {
+	if p.replicas == nil || len(p.replicas.replicas) == 0 || popx.InTransaction(ctx) {
+		return p.Connection(ctx), false
+	}
+	if pin, ok := ctx.Value(primaryPinKey).(*primaryPin); ok && pin.pinned.Load() {
+		return p.Connection(ctx), false
+	}
+
+	n := len(p.replicas.replicas)
+	start := p.replicas.next.Add(1)
+	for i := range n {
+		r := p.replicas.replicas[(start+uint64(i))%uint64(n)]
+		if r.healthy.Load() {
+			trace.SpanFromContext(ctx).SetAttributes(attribute.String("db.replica", r.Name))
+			return r.c.WithContext(ctx), true
+		}
+	}
+	return p.Connection(ctx), false
+}
+
+// ReadReplicaWithNetwork runs f with a query scoped to the network of the
+// context, made against a read replica if one is available. If f does not
+// find a row on the replica, which may be caused by replication lag, it is
+// retried against the primary.
+func (p *BasePersister) ReadReplicaWithNetwork(ctx context.Context, f func(q func() *pop.Query) error) error {
+	c, replica := p.ReadConnection(ctx)
+	err := f(func() *pop.Query { return c.Where("nid = ?", p.NetworkID(ctx)) })
+	if replica && errors.Is(err, sql.ErrNoRows) {
+		return f(func() *pop.Query { return p.QueryWithNetwork(ctx) })
+	}
+	return err
+}
+
+// CheckReadReplicas pings all read replicas and routes reads only to those
+// which respond.
+func (p *BasePersister) CheckReadReplicas(ctx context.Context) {
+	if p.replicas == nil {
+		return
+	}
+	for _, r := range p.replicas.replicas {
+		p.checkReadReplica(ctx, r)
+	}
+}
+
+func (p *BasePersister) checkReadReplica(ctx context.Context, r *ReadReplica) {
+	var err error
+	ctx, span := p.d.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CheckReadReplica",
+		trace.WithAttributes(attribute.String("db.replica", r.Name)))
+	defer otelx.End(span, &err)
+
+	ctx, cancel := context.WithTimeout(ctx, replicaHealthCheckInterval)
+	defer cancel()
+
+	err = errors.WithStack(r.c.Store.SQLDB().PingContext(ctx))
+	if healthy := err == nil; r.healthy.Swap(healthy) != healthy {
+		if healthy {
+			p.d.Logger().WithField("replica", r.Name).Info("Read replica is healthy, routing reads to it.")
+		} else {
+			p.d.Logger().WithError(err).WithField("replica", r.Name).Warn("Read replica is unhealthy, routing its reads to the primary.")
+		}
+	}
+}
+
+// WatchReadReplicas checks the health of the read replicas periodically until
+// the context is canceled. It returns immediately if no replicas are
+// configured or if the replicas are already being watched.
+func (p *BasePersister) WatchReadReplicas(ctx context.Context) {
+	if p.replicas == nil || len(p.replicas.replicas) == 0 || !p.replicas.watching.CompareAndSwap(false, true) {
+		return
+	}
+	defer p.replicas.watching.Store(false)
+
+	ticker := time.NewTicker(replicaHealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.CheckReadReplicas(ctx)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	persistencesql "github.com/ory/hydra/v2/persistence/sql"
+	"github.com/ory/pop/v6"
+	"github.com/ory/x/dbal"
+)
+
+func TestReadReplicas(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	reg := testhelpers.NewRegistryMemory(t)
+	replica := testhelpers.NewRegistryMemory(t).Persister().Connection(ctx)
+
+	down, err := pop.NewConnection(&pop.ConnectionDetails{URL: dbal.NewSQLiteTestDatabase(t)})
+	require.NoError(t, err)
+	require.NoError(t, down.Open())
+	require.NoError(t, down.Close())
+
+	t.Run("case=without replicas reads go to the primary", func(t *testing.T) {
+		_, ok := reg.BasePersister().ReadConnection(ctx)
+		assert.False(t, ok)
+	})
+
+	t.Run("case=reads go to healthy replicas", func(t *testing.T) {
+		base := reg.BasePersister().WithReadReplicas([]*persistencesql.ReadReplica{
+			persistencesql.NewReadReplica("down", down),
+			persistencesql.NewReadReplica("up", replica),
+		})
+
+		_, ok := base.ReadConnection(ctx)
+		assert.False(t, ok, "replicas are unhealthy until they were checked")
+
+		base.CheckReadReplicas(ctx)
+		for range 4 {
+			_, ok = base.ReadConnection(ctx)
+			assert.True(t, ok)
+		}
+
+		require.NoError(t, base.Transaction(ctx, func(ctx context.Context, _ *pop.Connection) error {
+			_, ok := base.ReadConnection(ctx)
+			assert.False(t, ok, "transactions use the primary")
+			return nil
+		}))
+	})
+
+	t.Run("case=reads are pinned to the primary after a write", func(t *testing.T) {
+		base := reg.BasePersister().WithReadReplicas([]*persistencesql.ReadReplica{
+			persistencesql.NewReadReplica("up", replica),
+		})
+		base.CheckReadReplicas(ctx)
+		p := persistencesql.NewPersister(base, reg)
+
+		ctx := persistencesql.WithPrimaryPin(ctx)
+		_, ok := base.ReadConnection(ctx)
+		assert.True(t, ok)
+
+		require.NoError(t, p.CreateClient(ctx, &client.Client{}))
+		_, ok = base.ReadConnection(ctx)
+		assert.False(t, ok)
+	})
+
+	t.Run("case=rows not found on a replica are looked up on the primary", func(t *testing.T) {
+		base := reg.BasePersister().WithReadReplicas([]*persistencesql.ReadReplica{
+			persistencesql.NewReadReplica("up", replica),
+		})
+		base.CheckReadReplicas(ctx)
+		p := persistencesql.NewPersister(base, reg)
+
+		c := &client.Client{}
+		require.NoError(t, reg.Persister().CreateClient(ctx, c))
+
+		actual, err := p.GetConcreteClient(ctx, c.ID)
+		require.NoError(t, err)
+		assert.Equal(t, c.ID, actual.ID)
+
+		_, err = p.GetConcreteClient(ctx, "does-not-exist")
+		require.Error(t, err)
+	})
+}
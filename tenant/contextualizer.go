@@ -0,0 +1,65 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ory/x/configx"
+	"github.com/ory/x/contextx"
+)
+
+type (
+	// Contextualizer serves the network and configuration of the tenant
+	// resolved for a request. Contexts without a tenant are handled by the
+	// wrapped contextualizer.
+	Contextualizer struct {
+		contextx.Contextualizer
+	}
+
+	resolved struct {
+		tenant *Tenant
+		config *configx.Provider
+	}
+	contextKey int
+)
+
+const tenantContextKey contextKey = 1
+
+var _ contextx.Contextualizer = (*Contextualizer)(nil)
+
+func NewContextualizer(c contextx.Contextualizer) *Contextualizer {
+	return &Contextualizer{Contextualizer: c}
+}
+
+func (c *Contextualizer) Network(ctx context.Context, network uuid.UUID) uuid.UUID {
+	if r, ok := ctx.Value(tenantContextKey).(*resolved); ok {
+		return r.tenant.ID
+	}
+	return c.Contextualizer.Network(ctx, network)
+}
+
+func (c *Contextualizer) Config(ctx context.Context, config *configx.Provider) *configx.Provider {
+	if r, ok := ctx.Value(tenantContextKey).(*resolved); ok {
+		return r.config
+	}
+	return c.Contextualizer.Config(ctx, config)
+}
+
+// NewContext returns a context which is served by the tenant using the given
+// configuration.
+func NewContext(ctx context.Context, t *Tenant, config *configx.Provider) context.Context {
+	return context.WithValue(ctx, tenantContextKey, &resolved{tenant: t, config: config})
+}
+
+// FromContext returns the tenant the context is served by.
+func FromContext(ctx context.Context) (*Tenant, bool) {
+	r, ok := ctx.Value(tenantContextKey).(*resolved)
+	if !ok {
+		return nil, false
+	}
+	return r.tenant, true
+}
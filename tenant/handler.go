@@ -0,0 +1,339 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/contextx"
+	"github.com/ory/x/httprouterx"
+	"github.com/ory/x/urlx"
+)
+
+const (
+	TenantsHandlerPath = "/tenants"
+)
+
+type Handler struct {
+	r InternalRegistry
+}
+
+func NewHandler(r InternalRegistry) *Handler {
+	return &Handler{r: r}
+}
+
+func (h *Handler) SetRoutes(admin *httprouterx.RouterAdmin) {
+	admin.GET(TenantsHandlerPath, h.listTenants)
+	admin.POST(TenantsHandlerPath, h.createTenant)
+	admin.GET(TenantsHandlerPath+"/{id}", h.getTenant)
+	admin.PUT(TenantsHandlerPath+"/{id}", h.setTenant)
+	admin.DELETE(TenantsHandlerPath+"/{id}", h.deleteTenant)
+}
+
+// Create Tenant Request
+//
+// swagger:parameters createTenant
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type createTenant struct {
+	// in: body
+	// required: true
+	Body Tenant
+}
+
+// swagger:route POST /admin/tenants tenant createTenant
+//
+// # Create Tenant
+//
+// Creates a tenant and its network. Once multi-tenant mode is enabled, requests to the tenant's hosts
+// or path prefix are served by the tenant, using its own clients, sessions, tokens and signing keys.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  201: tenant
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) createTenant(w http.ResponseWriter, r *http.Request) {
+	var t Tenant
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to decode the request body: %s", err)))
+		return
+	}
+
+	t.ID = uuid.Must(uuid.NewV4())
+	if err := h.validate(r.Context(), &t); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if err := h.r.TenantManager().CreateTenant(r.Context(), &t); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	h.refresh(r.Context())
+
+	h.r.Writer().WriteCreated(w, r, urlx.MustJoin("/admin", TenantsHandlerPath, url.PathEscape(t.ID.String())), &t)
+}
+
+// Set Tenant Request
+//
+// swagger:parameters setTenant
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type setTenant struct {
+	// The id of the tenant
+	//
+	// in: path
+	// required: true
+	ID string `json:"id"`
+
+	// in: body
+	// required: true
+	Body Tenant
+}
+
+// swagger:route PUT /admin/tenants/{id} tenant setTenant
+//
+// # Set Tenant
+//
+// Replaces a tenant's name, hosts, path prefix and configuration overrides.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: tenant
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) setTenant(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse parameter id: %v", err)))
+		return
+	}
+
+	var t Tenant
+	if err := json.NewDecoder(r.Body).Decode(&t); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to decode the request body: %s", err)))
+		return
+	}
+
+	t.ID = id
+	if err := h.validate(r.Context(), &t); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if err := h.r.TenantManager().UpdateTenant(r.Context(), &t); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	h.refresh(r.Context())
+
+	h.r.Writer().Write(w, r, &t)
+}
+
+// Get Tenant Request
+//
+// swagger:parameters getTenant
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type getTenant struct {
+	// The id of the tenant
+	//
+	// in: path
+	// required: true
+	ID string `json:"id"`
+}
+
+// swagger:route GET /admin/tenants/{id} tenant getTenant
+//
+// # Get Tenant
+//
+// Returns a tenant.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: tenant
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) getTenant(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse parameter id: %v", err)))
+		return
+	}
+
+	t, err := h.r.TenantManager().GetTenant(r.Context(), id)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, t)
+}
+
+// Tenants
+//
+// swagger:model tenants
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type tenants []Tenant
+
+// swagger:route GET /admin/tenants tenant listTenants
+//
+// # List Tenants
+//
+// Lists all tenants.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: tenants
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-medium
+func (h *Handler) listTenants(w http.ResponseWriter, r *http.Request) {
+	ts, err := h.r.TenantManager().ListTenants(r.Context())
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	if ts == nil {
+		ts = []Tenant{}
+	}
+
+	h.r.Writer().Write(w, r, ts)
+}
+
+// Delete Tenant Request
+//
+// swagger:parameters deleteTenant
+//
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type deleteTenant struct {
+	// The id of the tenant
+	//
+	// in: path
+	// required: true
+	ID string `json:"id"`
+}
+
+// swagger:route DELETE /admin/tenants/{id} tenant deleteTenant
+//
+// # Delete Tenant
+//
+// Deletes a tenant and the data of its network, such as its clients, sessions, tokens and signing keys. Requests to
+// its hosts or path prefix are no longer served by the tenant.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  204: emptyResponse
+//	  default: genericError
+//
+//	Extensions:
+//	  x-ory-ratelimit-bucket: hydra-admin-low
+func (h *Handler) deleteTenant(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(r.PathValue("id"))
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest().WithReasonf("Unable to parse parameter id: %v", err)))
+		return
+	}
+
+	if err := h.r.TenantManager().DeleteTenant(r.Context(), id); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	h.refresh(r.Context())
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validate checks the tenant and makes sure that its hosts and path prefix are
+// not used by another tenant.
+func (h *Handler) validate(ctx context.Context, t *Tenant) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	if _, err := t.NewConfig(ctx, h.r.Config().Source(contextx.RootContext)); err != nil {
+		return err
+	}
+
+	others, err := h.r.TenantManager().ListTenants(ctx)
+	if err != nil {
+		return err
+	}
+	for _, o := range others {
+		if o.ID == t.ID {
+			continue
+		}
+		if t.PathPrefix != "" && o.PathPrefix == t.PathPrefix {
+			return errors.WithStack(herodot.ErrConflict().WithReasonf("Path prefix '%s' is already used by tenant '%s'.", t.PathPrefix, o.Name))
+		}
+		for _, host := range t.Hosts {
+			if slices.Contains(o.Hosts, host) {
+				return errors.WithStack(herodot.ErrConflict().WithReasonf("Host '%s' is already used by tenant '%s'.", host, o.Name))
+			}
+		}
+	}
+	return nil
+}
+
+// refresh applies a change to the tenants on this node immediately. Other nodes
+// apply it when they reload the tenants.
+func (h *Handler) refresh(ctx context.Context) {
+	if !h.r.Config().MultitenancyEnabled(ctx) {
+		return
+	}
+	if err := h.r.TenantResolver().Refresh(ctx); err != nil {
+		h.r.Logger().WithError(err).Warn("Unable to reload the tenants.")
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	hydra "github.com/ory/hydra-client-go/v2"
+	"github.com/ory/hydra/v2/client"
+	"github.com/ory/hydra/v2/driver"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/internal/testhelpers"
+	"github.com/ory/hydra/v2/tenant"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/httprouterx"
+	"github.com/ory/x/sqlcon"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	for _, resolveBy := range []string{"host", "path"} {
+		t.Run("resolve_by="+resolveBy, func(t *testing.T) {
+			t.Parallel()
+
+			reg := testhelpers.NewRegistryMemory(t, driver.WithConfigOptions(configx.WithValues(map[string]any{
+				config.KeyMultitenancyEnabled:   true,
+				config.KeyMultitenancyResolveBy: resolveBy,
+			})))
+
+			router := httprouterx.NewRouterAdminWithPrefix()
+			tenant.NewHandler(reg).SetRoutes(router)
+			ts := httptest.NewServer(router)
+			t.Cleanup(ts.Close)
+
+			c := hydra.NewAPIClient(hydra.NewConfiguration())
+			c.GetConfig().Servers = hydra.ServerConfigurations{{URL: ts.URL}}
+
+			created, _, err := c.TenantAPI.CreateTenant(t.Context()).Tenant(hydra.Tenant{
+				Name:       new("acme"),
+				Hosts:      []string{"auth.acme.com"},
+				PathPrefix: new("/acme"),
+				Config: map[string]interface{}{
+					config.KeyIssuerURL: "https://auth.acme.com/",
+				},
+			}).Execute()
+			require.NoError(t, err)
+			require.NotEmpty(t, created.Id)
+
+			_, res, err := c.TenantAPI.CreateTenant(t.Context()).Tenant(hydra.Tenant{
+				Name:  new("other"),
+				Hosts: []string{"auth.acme.com"},
+			}).Execute()
+			require.Error(t, err)
+			assert.Equal(t, http.StatusConflict, res.StatusCode)
+
+			_, res, err = c.TenantAPI.CreateTenant(t.Context()).Tenant(hydra.Tenant{
+				Name:   new("other"),
+				Config: map[string]interface{}{config.KeyDSN: "memory"},
+			}).Execute()
+			require.Error(t, err)
+			assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+			var (
+				issuer, path string
+				network      string
+				tenantCtx    context.Context
+			)
+			served := func(w http.ResponseWriter, r *http.Request) {
+				issuer = reg.Config().IssuerURL(r.Context()).String()
+				network = reg.Networker().NetworkID(r.Context()).String()
+				path = r.URL.Path
+				tenantCtx = r.Context()
+			}
+
+			req := httptest.NewRequest("GET", "http://auth.acme.com/acme/.well-known/openid-configuration", nil)
+			reg.TenantResolver().Middleware(httptest.NewRecorder(), req, served)
+			assert.Equal(t, "https://auth.acme.com/", issuer)
+			assert.Equal(t, *created.Id, network)
+			if resolveBy == "path" {
+				assert.Equal(t, "/.well-known/openid-configuration", path)
+			} else {
+				assert.Equal(t, "/acme/.well-known/openid-configuration", path)
+			}
+
+			req = httptest.NewRequest("GET", "http://auth.example.com/.well-known/openid-configuration", nil)
+			reg.TenantResolver().Middleware(httptest.NewRecorder(), req, served)
+			assert.NotEqual(t, "https://auth.acme.com/", issuer)
+			assert.NotEqual(t, *created.Id, network)
+
+			list, _, err := c.TenantAPI.ListTenants(t.Context()).Execute()
+			require.NoError(t, err)
+			require.Len(t, list, 1)
+
+			req = httptest.NewRequest("GET", "http://auth.acme.com/acme/.well-known/openid-configuration", nil)
+			reg.TenantResolver().Middleware(httptest.NewRecorder(), req, served)
+			cl := &client.Client{Name: "acme-client"}
+			require.NoError(t, reg.ClientManager().CreateClient(tenantCtx, cl))
+
+			_, err = c.TenantAPI.DeleteTenant(t.Context(), *created.Id).Execute()
+			require.NoError(t, err)
+
+			// The data of the tenant's network is deleted with the tenant.
+			_, err = reg.ClientManager().GetConcreteClient(tenantCtx, cl.GetID())
+			assert.ErrorIs(t, err, sqlcon.ErrNoRows())
+
+			req = httptest.NewRequest("GET", "http://auth.acme.com/acme/.well-known/openid-configuration", nil)
+			reg.TenantResolver().Middleware(httptest.NewRecorder(), req, served)
+			assert.NotEqual(t, *created.Id, network)
+		})
+	}
+}
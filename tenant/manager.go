@@ -0,0 +1,20 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+)
+
+type Manager interface {
+	// CreateTenant creates the tenant and its network.
+	CreateTenant(ctx context.Context, t *Tenant) error
+	GetTenant(ctx context.Context, id uuid.UUID) (*Tenant, error)
+	UpdateTenant(ctx context.Context, t *Tenant) error
+	// DeleteTenant deletes the tenant and all data of its network.
+	DeleteTenant(ctx context.Context, id uuid.UUID) error
+	ListTenants(ctx context.Context) ([]Tenant, error)
+}
@@ -0,0 +1,22 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/x/httpx"
+	"github.com/ory/x/logrusx"
+)
+
+type InternalRegistry interface {
+	httpx.WriterProvider
+	logrusx.Provider
+	config.Provider
+	Registry
+}
+
+type Registry interface {
+	TenantManager() Manager
+	TenantResolver() *Resolver
+}
@@ -0,0 +1,206 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/x/contextx"
+	"github.com/ory/x/httpx"
+	"github.com/ory/x/logrusx"
+)
+
+type (
+	// Resolver resolves the tenant of a request.
+	//
+	// Tenants are kept in memory and reloaded from the database periodically,
+	// see Watch. Each tenant's configuration is derived when the tenants are
+	// loaded, so that serving a request does not need to build it.
+	Resolver struct {
+		d resolverDependencies
+
+		mu       sync.RWMutex
+		loaded   bool
+		tenants  []*resolved
+		watching atomic.Bool
+	}
+	resolverDependencies interface {
+		config.Provider
+		logrusx.Provider
+		httpx.WriterProvider
+		TenantManager() Manager
+	}
+)
+
+func NewResolver(d resolverDependencies) *Resolver {
+	return &Resolver{d: d}
+}
+
+// Refresh reloads the tenants from the database.
+func (r *Resolver) Refresh(ctx context.Context) error {
+	tenants, err := r.d.TenantManager().ListTenants(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The tenant's overrides are applied to the configuration of the
+	// deployment, never to the configuration of another tenant.
+	base := r.d.Config().Source(contextx.RootContext)
+
+	next := make([]*resolved, len(tenants))
+	for i := range tenants {
+		t := &tenants[i]
+		next[i] = &resolved{tenant: t}
+		if next[i].config, err = t.NewConfig(ctx, base); err != nil {
+			r.d.Logger().WithError(err).WithField("tenant", t.Name).Error("Unable to apply the tenant's configuration overrides, requests to this tenant will fail.")
+		}
+	}
+
+	// Longer path prefixes are more specific and are matched first.
+	slices.SortFunc(next, func(a, b *resolved) int {
+		return cmp.Compare(len(b.tenant.PathPrefix), len(a.tenant.PathPrefix))
+	})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tenants, r.loaded = next, true
+	return nil
+}
+
+// Watch reloads the tenants periodically until the context is canceled. It
+// returns immediately if multi-tenant mode is disabled or if the resolver is
+// already being watched.
+func (r *Resolver) Watch(ctx context.Context) {
+	if !r.d.Config().MultitenancyEnabled(ctx) || !r.watching.CompareAndSwap(false, true) {
+		return
+	}
+	defer r.watching.Store(false)
+
+	ticker := time.NewTicker(r.d.Config().MultitenancyRefreshInterval(ctx))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Refresh(ctx); err != nil {
+				r.d.Logger().WithError(err).Warn("Unable to reload the tenants, changes to tenants may not be applied yet.")
+			}
+		}
+	}
+}
+
+// Contexts returns a context for each tenant, which is served by the tenant
+// like requests resolved to it. Tenants with an invalid configuration are
+// skipped. The tenants are reloaded first, unless Watch keeps them up to date.
+func (r *Resolver) Contexts(ctx context.Context) ([]context.Context, error) {
+	r.mu.RLock()
+	fresh := r.loaded && r.watching.Load()
+	r.mu.RUnlock()
+	if !fresh {
+		if err := r.Refresh(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	contexts := make([]context.Context, 0, len(r.tenants))
+	for _, t := range r.tenants {
+		if t.config != nil {
+			contexts = append(contexts, context.WithValue(ctx, tenantContextKey, t))
+		}
+	}
+	return contexts, nil
+}
+
+// Resolve returns the tenant of the request and the request path without the
+// tenant's path prefix. It returns nil if the request does not belong to a
+// tenant.
+func (r *Resolver) Resolve(ctx context.Context, req *http.Request) (*Tenant, string, error) {
+	t, path, err := r.resolve(ctx, req)
+	if err != nil || t == nil {
+		return nil, path, err
+	}
+	return t.tenant, path, nil
+}
+
+func (r *Resolver) resolve(ctx context.Context, req *http.Request) (*resolved, string, error) {
+	r.mu.RLock()
+	loaded := r.loaded
+	r.mu.RUnlock()
+	if !loaded {
+		if err := r.Refresh(ctx); err != nil {
+			return nil, req.URL.Path, err
+		}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	byPath := r.d.Config().MultitenancyResolveBy(ctx) == "path"
+	for _, t := range r.tenants {
+		if !byPath {
+			if t.tenant.matchesHost(req.Host) {
+				return t, req.URL.Path, nil
+			}
+			continue
+		}
+
+		prefix := t.tenant.PathPrefix
+		if prefix == "" {
+			continue
+		}
+		if req.URL.Path == prefix {
+			return t, "/", nil
+		} else if rest, ok := strings.CutPrefix(req.URL.Path, prefix+"/"); ok {
+			return t, "/" + rest, nil
+		}
+	}
+	return nil, req.URL.Path, nil
+}
+
+// Middleware serves the request by its tenant. Requests which do not belong to
+// a tenant are served by the default network. If tenants are resolved by path,
+// the tenant's path prefix is removed before the request is routed.
+func (r *Resolver) Middleware(w http.ResponseWriter, req *http.Request, next http.HandlerFunc) {
+	ctx := req.Context()
+	if !r.d.Config().MultitenancyEnabled(ctx) {
+		next(w, req)
+		return
+	}
+
+	t, path, err := r.resolve(ctx, req)
+	if err != nil {
+		r.d.Writer().WriteError(w, req, err)
+		return
+	} else if t == nil {
+		next(w, req)
+		return
+	} else if t.config == nil {
+		r.d.Writer().WriteError(w, req, errors.WithStack(herodot.ErrInternalServerError().WithReasonf("The configuration of tenant '%s' is invalid.", t.tenant.Name)))
+		return
+	}
+
+	req = req.WithContext(context.WithValue(ctx, tenantContextKey, t))
+	if path != req.URL.Path {
+		u := *req.URL
+		u.Path, u.RawPath = path, ""
+		req.URL = &u
+	}
+	next(w, req)
+}
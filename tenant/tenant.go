@@ -0,0 +1,159 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/hydra/v2/driver/config"
+	"github.com/ory/hydra/v2/spec"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/sqlxx"
+)
+
+// Tenant
+//
+// A tenant is served by the same deployment as all other tenants, but has its
+// own network. Its clients, sessions, tokens and signing keys are isolated
+// from those of other tenants.
+//
+// swagger:model tenant
+type Tenant struct {
+	// ID is the tenant's identifier. It is also the identifier of the tenant's network.
+	//
+	// read only
+	ID uuid.UUID `json:"id" db:"id"`
+
+	// Name is a unique, human readable name of the tenant.
+	//
+	// example: acme
+	Name string `json:"name" db:"name"`
+
+	// Hosts are the hosts the tenant is served on if tenants are resolved by host.
+	//
+	// example: ["auth.acme.com"]
+	Hosts sqlxx.StringSliceJSONFormat `json:"hosts" db:"hosts"`
+
+	// PathPrefix is the path prefix the tenant is served on if tenants are resolved by path.
+	//
+	// example: /acme
+	PathPrefix string `json:"path_prefix" db:"path_prefix"`
+
+	// Config overrides the configuration for this tenant. Keys are configuration paths,
+	// for example `urls.self.issuer` or `ttl.access_token`. Only the `urls.self.issuer`,
+	// `urls.self.public`, `urls.login`, `urls.consent`, `urls.logout`, `urls.error`,
+	// `urls.registration`, `urls.post_logout_redirect`, `urls.device.verification`,
+	// `urls.device.success` and `ttl.*` keys can be overridden.
+	//
+	// example: {"urls.self.issuer": "https://auth.acme.com/", "urls.login": "https://login.acme.com/login"}
+	Config sqlxx.MapStringInterface `json:"config" db:"config"`
+
+	// CreatedAt is the time the tenant was created.
+	//
+	// read only
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+
+	// UpdatedAt is the time the tenant was last updated.
+	//
+	// read only
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+func (Tenant) TableName() string {
+	return "hydra_tenant"
+}
+
+// overridableKeys are the configuration keys a tenant can override in
+// addition to all keys below "ttl.".
+var overridableKeys = []string{
+	config.KeyIssuerURL,
+	config.KeyPublicURL,
+	config.KeyLoginURL,
+	config.KeyConsentURL,
+	config.KeyLogoutURL,
+	config.KeyErrorURL,
+	config.KeyRegistrationURL,
+	config.KeyLogoutRedirectURL,
+	config.KeyDeviceVerificationURL,
+	config.KeyDeviceDoneURL,
+}
+
+func isOverridable(key string) bool {
+	return slices.Contains(overridableKeys, key) || strings.HasPrefix(key, "ttl.")
+}
+
+// Validate normalizes the tenant and checks that it can be served.
+func (t *Tenant) Validate() error {
+	if t.Name == "" {
+		return errors.WithStack(herodot.ErrBadRequest().WithReason("Field 'name' is required."))
+	}
+
+	if t.Hosts == nil {
+		t.Hosts = sqlxx.StringSliceJSONFormat{}
+	}
+	if t.Config == nil {
+		t.Config = sqlxx.MapStringInterface{}
+	}
+
+	for i, h := range t.Hosts {
+		h = strings.ToLower(strings.TrimSpace(h))
+		if h == "" || strings.ContainsAny(h, "/:") {
+			return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Host '%s' must be a host name without scheme, port or path.", t.Hosts[i]))
+		}
+		t.Hosts[i] = h
+	}
+
+	if t.PathPrefix != "" {
+		t.PathPrefix = "/" + strings.Trim(t.PathPrefix, "/")
+		if t.PathPrefix == "/" || strings.ContainsAny(t.PathPrefix, "?#") {
+			return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Path prefix '%s' must be a non-empty path.", t.PathPrefix))
+		}
+		if first, _, _ := strings.Cut(t.PathPrefix[1:], "/"); slices.Contains(reservedPathSegments, first) {
+			return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Path prefix '%s' conflicts with the routes of Ory Hydra.", t.PathPrefix))
+		}
+	}
+
+	for key := range t.Config {
+		if !isOverridable(key) {
+			return errors.WithStack(herodot.ErrBadRequest().WithReasonf("Configuration key '%s' can not be overridden per tenant.", key))
+		}
+	}
+
+	return nil
+}
+
+// reservedPathSegments are the first path segments of the routes served by
+// Ory Hydra. Path prefixes starting with them would shadow these routes.
+var reservedPathSegments = []string{"admin", "oauth2", "userinfo", ".well-known", "health", "version", "metrics", "credentials", "clients", "keys", "trust", "tenants"}
+
+// NewConfig returns the configuration of the tenant, which is the given
+// configuration with the tenant's overrides applied.
+func (t *Tenant) NewConfig(ctx context.Context, base *configx.Provider) (*configx.Provider, error) {
+	c, err := configx.New(ctx, spec.ConfigValidationSchema,
+		configx.DisableEnvLoading(),
+		configx.WithValues(base.All()),
+		configx.WithValues(t.Config),
+	)
+	if err != nil {
+		return nil, errors.WithStack(herodot.ErrBadRequest().WithReasonf("The configuration overrides of tenant '%s' are invalid: %s", t.Name, err).WithWrap(err))
+	}
+	return c, nil
+}
+
+// matchesHost reports whether the tenant is served on the host, which may
+// include a port.
+func (t *Tenant) matchesHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return slices.Contains(t.Hosts, strings.ToLower(host))
+}
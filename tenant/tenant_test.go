@@ -0,0 +1,49 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/x/sqlxx"
+)
+
+func TestTenantValidate(t *testing.T) {
+	for k, tc := range []struct {
+		d   string
+		in  Tenant
+		err bool
+	}{
+		{d: "name is required", in: Tenant{}, err: true},
+		{d: "host without port", in: Tenant{Name: "a", Hosts: []string{"auth.acme.com:443"}}, err: true},
+		{d: "host without scheme", in: Tenant{Name: "a", Hosts: []string{"https://auth.acme.com"}}, err: true},
+		{d: "root path prefix", in: Tenant{Name: "a", PathPrefix: "/"}, err: true},
+		{d: "reserved path prefix", in: Tenant{Name: "a", PathPrefix: "/oauth2/acme"}, err: true},
+		{d: "non-overridable key", in: Tenant{Name: "a", Config: sqlxx.MapStringInterface{"dsn": "memory"}}, err: true},
+		{d: "overridable keys", in: Tenant{Name: "a", Config: sqlxx.MapStringInterface{"urls.self.issuer": "https://auth.acme.com/", "ttl.access_token": "1h"}}},
+		{d: "valid", in: Tenant{Name: "a", Hosts: []string{" Auth.Acme.com "}, PathPrefix: "acme/"}},
+	} {
+		t.Run(tc.d, func(t *testing.T) {
+			err := tc.in.Validate()
+			if tc.err {
+				require.Error(t, err, "%d", k)
+				return
+			}
+			require.NoError(t, err, "%d", k)
+		})
+	}
+
+	t.Run("case=normalizes", func(t *testing.T) {
+		in := Tenant{Name: "a", Hosts: []string{" Auth.Acme.com "}, PathPrefix: "acme/"}
+		require.NoError(t, in.Validate())
+		assert.Equal(t, sqlxx.StringSliceJSONFormat{"auth.acme.com"}, in.Hosts)
+		assert.Equal(t, "/acme", in.PathPrefix)
+		assert.NotNil(t, in.Config)
+		assert.True(t, in.matchesHost("AUTH.acme.com:4444"))
+		assert.False(t, in.matchesHost("login.acme.com"))
+	})
+}
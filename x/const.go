@@ -4,6 +4,7 @@
 package x
 
 const (
-	OpenIDConnectKeyName = "hydra.openid.id-token"
-	OAuth2JWTKeyName     = "hydra.jwt.access-token"
+	OpenIDConnectKeyName       = "hydra.openid.id-token"
+	OAuth2JWTKeyName           = "hydra.jwt.access-token"
+	OAuth2IntrospectionKeyName = "hydra.jwt.introspection"
 )
@@ -24,6 +24,9 @@ const (
 
 	DeviceUserCodeAccepted semconv.Event = "OAuth2DeviceUserCodeAccepted"
 
+	// DeviceUserCodeRejected will be emitted when an administrator rejects a device authorization request.
+	DeviceUserCodeRejected semconv.Event = "OAuth2DeviceUserCodeRejected"
+
 	// ConsentAccepted will be emitted when the consent UI accepts a consent request.
 	ConsentAccepted semconv.Event = "OAuth2ConsentAccepted"
 
@@ -33,6 +36,9 @@ const (
 	// ConsentRevoked will be emitted when the user revokes a consent request.
 	ConsentRevoked semconv.Event = "OAuth2ConsentRevoked"
 
+	// SubjectErased will be emitted when all data of a subject is erased.
+	SubjectErased semconv.Event = "OAuth2SubjectErased"
+
 	// ClientCreated will be emitted when a client is created.
 	ClientCreated semconv.Event = "OAuth2ClientCreated"
 
@@ -71,6 +77,8 @@ const (
 	attributeKeyOAuth2RefreshTokenSignature = "OAuth2RefreshTokenSignature" //nolint:gosec
 	attributeKeyOAuth2AccessTokenSignature  = "OAuth2AccessTokenSignature"  //nolint:gosec
 	attributeKeyErrorReason                 = "ErrorReason"
+	attributeKeyOAuth2ErasureID             = "OAuth2ErasureID"
+	attributeKeyOAuth2SubjectHMAC           = "OAuth2SubjectHMAC"
 )
 
 // WithTokenFormat emits the token format as part of the event.
@@ -119,6 +127,15 @@ func WithConsentRequestID(id string) trace.EventOption {
 	return trace.WithAttributes(ConsentRequestID(id))
 }
 
+// WithSubjectErasure emits the erasure ID and the HMAC of the erased subject
+// as part of the event. The subject itself is not emitted.
+func WithSubjectErasure(id, subjectHMAC string) trace.EventOption {
+	return trace.WithAttributes(
+		otelattr.String(attributeKeyOAuth2ErasureID, id),
+		otelattr.String(attributeKeyOAuth2SubjectHMAC, subjectHMAC),
+	)
+}
+
 // WithRequest emits the subject and client ID from the fosite request as part of the event.
 func WithRequest(request fosite.Requester) trace.EventOption {
 	var attributes []otelattr.KeyValue
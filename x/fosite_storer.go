@@ -14,8 +14,16 @@ import (
 	"github.com/ory/hydra/v2/fosite/handler/rfc7523"
 	"github.com/ory/hydra/v2/fosite/handler/rfc8628"
 	"github.com/ory/hydra/v2/fosite/handler/verifiable"
+	keysetpagination "github.com/ory/x/pagination/keysetpagination_v2"
 )
 
+// TokenRevocation is an entry of the revocation feed. It records that the
+// tokens of a request were revoked or removed.
+type TokenRevocation struct {
+	RequestID string
+	RevokedAt time.Time
+}
+
 type FositeStorer interface {
 	fosite.ClientManager
 	oauth2.AuthorizeCodeStorage
@@ -25,6 +33,8 @@ type FositeStorer interface {
 	openid.OpenIDConnectRequestStorage
 	pkce.PKCERequestStorage
 	rfc7523.RFC7523KeyStorage
+	rfc7523.RFC7523RemoteKeyStorage
+	rfc7523.RFC7523AssertionRuleStorage
 	rfc8628.DeviceAuthStorage
 	verifiable.NonceManager
 	oauth2.ResourceOwnerPasswordCredentialsGrantStorage
@@ -43,6 +53,22 @@ type FositeStorer interface {
 
 	FlushInactiveRefreshTokens(ctx context.Context, notAfter time.Time, limit int, batchSize int) error
 
+	// FlushExpiredTokenPartitions creates upcoming partitions of partitioned
+	// token tables and drops those partitions whose rows are all older than
+	// 'notAfter' and their table's lifespan. It does nothing if the token
+	// tables are not partitioned.
+	FlushExpiredTokenPartitions(ctx context.Context, notAfter time.Time) error
+
+	// FlushExpiredFlowPartitions creates upcoming partitions of the partitioned
+	// flow table and drops those partitions whose flows are all older than
+	// 'notAfter' and the lifespan of their tokens. It does nothing if the flow
+	// table is not partitioned.
+	FlushExpiredFlowPartitions(ctx context.Context, notAfter time.Time) error
+
+	// ListTokenRevocations returns up to limit entries of the revocation feed
+	// which were recorded at or after since, oldest first.
+	ListTokenRevocations(ctx context.Context, since time.Time, limit int) ([]TokenRevocation, error)
+
 	// DeleteOpenIDConnectSession deletes an OpenID Connect session.
 	// This is duplicated from Ory Fosite to help against deprecation linting errors.
 	// DeleteOpenIDConnectSession(ctx context.Context, authorizeCode string) error
@@ -51,4 +77,16 @@ type FositeStorer interface {
 	GetUserCodeSession(context.Context, string, fosite.Session) (fosite.DeviceRequester, error)
 	GetDeviceCodeSessionByRequestID(ctx context.Context, requestID string, requester fosite.Session) (fosite.DeviceRequester, string, error)
 	UpdateDeviceCodeSessionBySignature(ctx context.Context, requestID string, requester fosite.DeviceRequester) error
+
+	// GetDeviceAuthRequest returns the device authorization request of the
+	// user code signature, regardless of the state of the user code.
+	GetDeviceAuthRequest(ctx context.Context, userCodeSignature string, session fosite.Session) (fosite.DeviceRequester, error)
+
+	// RejectDeviceAuthRequest marks the unused user code as rejected, so that
+	// the polling device receives an access_denied error.
+	RejectDeviceAuthRequest(ctx context.Context, userCodeSignature string) error
+
+	// ListPendingDeviceAuthRequests lists the unexpired device authorization
+	// requests of the client whose user code was not used yet, oldest first.
+	ListPendingDeviceAuthRequests(ctx context.Context, clientID string, pageOpts ...keysetpagination.Option) ([]fosite.DeviceRequester, *keysetpagination.Paginator, error)
 }
@@ -0,0 +1,39 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import "sync"
+
+// MetricLabelOther is reported by BoundedLabel for values seen after its
+// limit has been reached.
+const MetricLabelOther = "other"
+
+// BoundedLabel limits the number of distinct values of a metric label which
+// is derived from data such as client IDs, so that the number of time series
+// does not grow without bounds.
+type BoundedLabel struct {
+	mu    sync.Mutex
+	limit int
+	seen  map[string]struct{}
+}
+
+func NewBoundedLabel(limit int) *BoundedLabel {
+	return &BoundedLabel{limit: limit, seen: make(map[string]struct{}, limit)}
+}
+
+// Value returns v if it has been seen before or the limit has not been
+// reached yet, and MetricLabelOther otherwise.
+func (l *BoundedLabel) Value(v string) string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.seen[v]; ok {
+		return v
+	}
+	if len(l.seen) >= l.limit {
+		return MetricLabelOther
+	}
+	l.seen[v] = struct{}{}
+	return v
+}
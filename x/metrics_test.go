@@ -0,0 +1,18 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoundedLabel(t *testing.T) {
+	l := NewBoundedLabel(2)
+	assert.Equal(t, "a", l.Value("a"))
+	assert.Equal(t, "b", l.Value("b"))
+	assert.Equal(t, MetricLabelOther, l.Value("c"))
+	assert.Equal(t, "a", l.Value("a"), "values seen before the limit was reached are kept")
+}
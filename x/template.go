@@ -0,0 +1,132 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"time"
+
+	"github.com/dgraph-io/ristretto/v2"
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+
+	"github.com/ory/hydra/v2/fosite/i18n"
+	"github.com/ory/x/fetcher"
+)
+
+// templateCacheTTL is how long remote templates are cached.
+const templateCacheTTL = 5 * time.Minute
+
+// templateFuncs are available in all templates loaded by a TemplateLoader.
+// The translate function returns the message ID unless the template was
+// localized for a request.
+var templateFuncs = template.FuncMap{
+	"translate": func(id string, _ ...any) string { return id },
+}
+
+// TemplateLoader loads the Go HTML templates which override built-in pages.
+type TemplateLoader struct {
+	cache  *ristretto.Cache[[]byte, []byte]
+	parsed *ristretto.Cache[string, *template.Template]
+}
+
+// NewTemplateLoader returns a TemplateLoader with an empty cache.
+func NewTemplateLoader() *TemplateLoader {
+	cache, _ := ristretto.NewCache(&ristretto.Config[[]byte, []byte]{
+		NumCounters: 10_000,
+		MaxCost:     16 << 20, // 16 MiB
+		BufferItems: 64,
+	})
+	parsed, _ := ristretto.NewCache(&ristretto.Config[string, *template.Template]{
+		NumCounters: 10_000,
+		MaxCost:     1_000,
+		BufferItems: 64,
+	})
+	return &TemplateLoader{cache: cache, parsed: parsed}
+}
+
+// Parse parses a built-in template. Built-in templates are cached until the
+// cache evicts them.
+func (l *TemplateLoader) Parse(name, text string) (*template.Template, error) {
+	key := name + "\x00" + text
+	if t, ok := l.parsed.Get(key); ok {
+		return t, nil
+	}
+
+	t, err := l.parse(name, text)
+	if err != nil {
+		return nil, err
+	}
+
+	l.parsed.Set(key, t, 1)
+	return t, nil
+}
+
+func (l *TemplateLoader) parse(name, text string) (*template.Template, error) {
+	t, err := template.New(name).Funcs(templateFuncs).Parse(text)
+	return t, errors.WithStack(err)
+}
+
+// Load fetches and parses the template at the given file, base64, http or
+// https URL. Templates are cached for a few minutes.
+func (l *TemplateLoader) Load(ctx context.Context, client *retryablehttp.Client, name, location string) (*template.Template, error) {
+	key := name + "\x00" + location
+	if t, ok := l.parsed.Get(key); ok {
+		return t, nil
+	}
+
+	text, err := fetcher.NewFetcher(
+		fetcher.WithClient(client),
+		fetcher.WithCache(l.cache, templateCacheTTL),
+		fetcher.WithAllowedSchemes("file", "base64", "http", "https"),
+	).FetchBytes(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := l.parse(name, string(text))
+	if err != nil {
+		return nil, err
+	}
+
+	l.parsed.SetWithTTL(key, t, 1, templateCacheTTL)
+	return t, nil
+}
+
+type templateRequestKey struct{}
+
+// WithTemplateRequest returns a context carrying the request, so that
+// templates which are loaded without access to the request can still be
+// localized for it.
+func WithTemplateRequest(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, templateRequestKey{}, r)
+}
+
+// TemplateRequestFromContext returns the request set by WithTemplateRequest.
+func TemplateRequestFromContext(ctx context.Context) (*http.Request, bool) {
+	r, ok := ctx.Value(templateRequestKey{}).(*http.Request)
+	return r, ok
+}
+
+// LocalizeTemplate returns a copy of the template whose translate function
+// looks up messages in the catalog using the language of the request. The
+// message ID is used if the catalog has no translation. The returned language
+// has no extensions, so it can be used as the `lang` attribute of the page.
+func LocalizeTemplate(t *template.Template, c i18n.MessageCatalog, r *http.Request) (*template.Template, language.Tag, error) {
+	base, script, region := i18n.GetLangFromRequest(c, r).Raw()
+	tag, _ := language.Compose(base, script, region)
+	localized, err := t.Clone()
+	if err != nil {
+		return nil, tag, errors.WithStack(err)
+	}
+
+	return localized.Funcs(template.FuncMap{
+		"translate": func(id string, v ...any) string {
+			return i18n.GetMessage(c, id, tag, v...)
+		},
+	}), tag, nil
+}
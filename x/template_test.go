@@ -0,0 +1,52 @@
+// Copyright © 2026 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateLoader(t *testing.T) {
+	l := NewTemplateLoader()
+	location := "base64://" + base64.StdEncoding.EncodeToString([]byte(`<p>{{ translate "hello" }}</p>`))
+
+	first, err := l.Load(t.Context(), retryablehttp.NewClient(), "page", location)
+	require.NoError(t, err)
+	l.parsed.Wait()
+
+	second, err := l.Load(t.Context(), retryablehttp.NewClient(), "page", location)
+	require.NoError(t, err)
+	assert.Same(t, first, second, "the parsed template should be cached")
+
+	other, err := l.Load(t.Context(), retryablehttp.NewClient(), "other", location)
+	require.NoError(t, err)
+	assert.NotSame(t, first, other)
+
+	_, err = l.Load(t.Context(), retryablehttp.NewClient(), "page", "base64://"+base64.StdEncoding.EncodeToString([]byte(`{{ .Unclosed`)))
+	assert.Error(t, err)
+}
+
+func TestTemplateLoaderParse(t *testing.T) {
+	l := NewTemplateLoader()
+
+	first, err := l.Parse("page", `<p>{{ translate "hello" }}</p>`)
+	require.NoError(t, err)
+	l.parsed.Wait()
+
+	second, err := l.Parse("page", `<p>{{ translate "hello" }}</p>`)
+	require.NoError(t, err)
+	assert.Same(t, first, second, "the parsed template should be cached")
+
+	other, err := l.Parse("page", `<p>{{ translate "other" }}</p>`)
+	require.NoError(t, err)
+	assert.NotSame(t, first, other)
+
+	_, err = l.Parse("page", `{{ .Unclosed`)
+	assert.Error(t, err)
+}